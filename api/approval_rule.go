@@ -0,0 +1,74 @@
+package api
+
+import "github.com/xanzy/go-gitlab"
+
+var ListProjectApprovalRules = func(client *gitlab.Client, projectID interface{}) ([]*gitlab.ProjectApprovalRule, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	rules, _, err := client.Projects.GetProjectApprovalRules(projectID)
+	if err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+var CreateProjectApprovalRule = func(client *gitlab.Client, projectID interface{}, opts *gitlab.CreateProjectLevelRuleOptions) (*gitlab.ProjectApprovalRule, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	rule, _, err := client.Projects.CreateProjectApprovalRule(projectID, opts)
+	if err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+var UpdateProjectApprovalRule = func(client *gitlab.Client, projectID interface{}, ruleID int, opts *gitlab.UpdateProjectLevelRuleOptions) (*gitlab.ProjectApprovalRule, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	rule, _, err := client.Projects.UpdateProjectApprovalRule(projectID, ruleID, opts)
+	if err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+var DeleteProjectApprovalRule = func(client *gitlab.Client, projectID interface{}, ruleID int) error {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	_, err := client.Projects.DeleteProjectApprovalRule(projectID, ruleID)
+	return err
+}
+
+var CreateMRApprovalRule = func(client *gitlab.Client, projectID interface{}, mrID int, opts *gitlab.CreateMergeRequestApprovalRuleOptions) (*gitlab.MergeRequestApprovalRule, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	rule, _, err := client.MergeRequestApprovals.CreateApprovalRule(projectID, mrID, opts)
+	if err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+var UpdateMRApprovalRule = func(client *gitlab.Client, projectID interface{}, mrID int, ruleID int, opts *gitlab.UpdateMergeRequestApprovalRuleOptions) (*gitlab.MergeRequestApprovalRule, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	rule, _, err := client.MergeRequestApprovals.UpdateApprovalRule(projectID, mrID, ruleID, opts)
+	if err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+var DeleteMRApprovalRule = func(client *gitlab.Client, projectID interface{}, mrID int, ruleID int) error {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	_, err := client.MergeRequestApprovals.DeleteApprovalRule(projectID, mrID, ruleID)
+	return err
+}
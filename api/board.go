@@ -1,6 +1,38 @@
 package api
 
-import "github.com/xanzy/go-gitlab"
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/xanzy/go-gitlab"
+)
+
+// The go-gitlab version in use only supports label lists on issue boards and does not
+// decode the board scope, so requests involving them are built manually.
+
+// BoardList represents a label, assignee or milestone list of an issue board
+type BoardList struct {
+	ID        int               `json:"id"`
+	Label     *gitlab.Label     `json:"label"`
+	Assignee  *gitlab.BasicUser `json:"assignee"`
+	Milestone *gitlab.Milestone `json:"milestone"`
+	Position  int               `json:"position"`
+	ListType  string            `json:"list_type"`
+}
+
+// IssueBoard represents an issue board along with the labels it is scoped to
+type IssueBoard struct {
+	gitlab.IssueBoard
+	Labels []*gitlab.Label `json:"labels"`
+}
+
+// CreateBoardListOptions represents the available CreateBoardList() options.
+// Exactly one of the options must be set.
+type CreateBoardListOptions struct {
+	LabelID     *int `url:"label_id,omitempty" json:"label_id,omitempty"`
+	AssigneeID  *int `url:"assignee_id,omitempty" json:"assignee_id,omitempty"`
+	MilestoneID *int `url:"milestone_id,omitempty" json:"milestone_id,omitempty"`
+}
 
 var CreateIssueBoard = func(client *gitlab.Client, projectID interface{}, opts *gitlab.CreateIssueBoardOptions) (*gitlab.IssueBoard, error) {
 	if client == nil {
@@ -26,14 +58,49 @@ var ListIssueBoards = func(client *gitlab.Client, projectID interface{}, opts *g
 	return boards, nil
 }
 
-var GetIssueBoardLists = func(client *gitlab.Client, projectID interface{}, boardID int, opts *gitlab.GetIssueBoardListsOptions) ([]*gitlab.BoardList, error) {
+var GetIssueBoard = func(client *gitlab.Client, projectID interface{}, boardID int) (*IssueBoard, error) {
 	if client == nil {
 		client = apiClient.Lab()
 	}
-	boardLists, _, err := client.Boards.GetIssueBoardLists(projectID, boardID, opts)
+	board := &IssueBoard{}
+	err := doRequest(client, http.MethodGet, fmt.Sprintf("projects/%s/boards/%d", escapeID(projectID), boardID), nil, board)
 	if err != nil {
 		return nil, err
 	}
+	return board, nil
+}
 
-	return boardLists, nil
+var ListBoardLists = func(client *gitlab.Client, projectID interface{}, boardID int) ([]*BoardList, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	var lists []*BoardList
+	err := doRequest(client, http.MethodGet, fmt.Sprintf("projects/%s/boards/%d/lists", escapeID(projectID), boardID), nil, &lists)
+	if err != nil {
+		return nil, err
+	}
+	return lists, nil
+}
+
+var CreateBoardList = func(client *gitlab.Client, projectID interface{}, boardID int, opts *CreateBoardListOptions) (*BoardList, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	list := &BoardList{}
+	err := doRequest(client, http.MethodPost, fmt.Sprintf("projects/%s/boards/%d/lists", escapeID(projectID), boardID), opts, list)
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+var DeleteBoardList = func(client *gitlab.Client, projectID interface{}, boardID, listID int) error {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	_, err := client.Boards.DeleteIssueBoardList(projectID, boardID, listID)
+	if err != nil {
+		return err
+	}
+	return nil
 }
@@ -13,3 +13,67 @@ var CreateBranch = func(client *gitlab.Client, projectID interface{}, opts *gitl
 
 	return branch, nil
 }
+
+var ListBranches = func(client *gitlab.Client, projectID interface{}, opts *gitlab.ListBranchesOptions) ([]*gitlab.Branch, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	if opts.PerPage == 0 {
+		opts.PerPage = DefaultListLimit
+	}
+
+	branches, _, err := client.Branches.ListBranches(projectID, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return branches, nil
+}
+
+var GetBranch = func(client *gitlab.Client, projectID interface{}, branch string) (*gitlab.Branch, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	b, _, err := client.Branches.GetBranch(projectID, branch)
+	if err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+var DeleteBranch = func(client *gitlab.Client, projectID interface{}, branch string) error {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	_, err := client.Branches.DeleteBranch(projectID, branch)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var CompareBranches = func(client *gitlab.Client, projectID interface{}, opts *gitlab.CompareOptions) (*gitlab.Compare, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	compare, _, err := client.Repositories.Compare(projectID, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return compare, nil
+}
+
+var GetProtectedBranch = func(client *gitlab.Client, projectID interface{}, branch string) (*gitlab.ProtectedBranch, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	protected, _, err := client.ProtectedBranches.GetProtectedBranch(projectID, branch)
+	if err != nil {
+		return nil, err
+	}
+	return protected, nil
+}
@@ -17,3 +17,27 @@ var GetCommitStatuses = func(client *gitlab.Client, pid interface{}, sha string)
 	}
 	return statuses, nil
 }
+
+var CherryPickCommit = func(client *gitlab.Client, pid interface{}, sha string, opt *gitlab.CherryPickCommitOptions) (*gitlab.Commit, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	commit, _, err := client.Commits.CherryPickCommit(pid, sha, opt)
+	if err != nil {
+		return nil, err
+	}
+	return commit, nil
+}
+
+var RevertCommit = func(client *gitlab.Client, pid interface{}, sha string, opt *gitlab.RevertCommitOptions) (*gitlab.Commit, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	commit, _, err := client.Commits.RevertCommit(pid, sha, opt)
+	if err != nil {
+		return nil, err
+	}
+	return commit, nil
+}
@@ -0,0 +1,50 @@
+package api
+
+import "github.com/xanzy/go-gitlab"
+
+var ListProjectDeployKeys = func(client *gitlab.Client, projectID interface{}, opts *gitlab.ListProjectDeployKeysOptions) ([]*gitlab.DeployKey, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	if opts.PerPage == 0 {
+		opts.PerPage = DefaultListLimit
+	}
+	keys, _, err := client.DeployKeys.ListProjectDeployKeys(projectID, opts)
+	if err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+var AddDeployKey = func(client *gitlab.Client, projectID interface{}, opts *gitlab.AddDeployKeyOptions) (*gitlab.DeployKey, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	key, _, err := client.DeployKeys.AddDeployKey(projectID, opts)
+	if err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+var EnableDeployKey = func(client *gitlab.Client, projectID interface{}, keyID int) (*gitlab.DeployKey, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	key, _, err := client.DeployKeys.EnableDeployKey(projectID, keyID)
+	if err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+var DeleteDeployKey = func(client *gitlab.Client, projectID interface{}, keyID int) error {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	_, err := client.DeployKeys.DeleteDeployKey(projectID, keyID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package api
+
+import "github.com/xanzy/go-gitlab"
+
+var ListProjectDeployTokens = func(client *gitlab.Client, projectID interface{}, opts *gitlab.ListProjectDeployTokensOptions) ([]*gitlab.DeployToken, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	if opts.PerPage == 0 {
+		opts.PerPage = DefaultListLimit
+	}
+	tokens, _, err := client.DeployTokens.ListProjectDeployTokens(projectID, opts)
+	if err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
+
+var CreateProjectDeployToken = func(client *gitlab.Client, projectID interface{}, opts *gitlab.CreateProjectDeployTokenOptions) (*gitlab.DeployToken, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	token, _, err := client.DeployTokens.CreateProjectDeployToken(projectID, opts)
+	if err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+var DeleteProjectDeployToken = func(client *gitlab.Client, projectID interface{}, tokenID int) error {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	_, err := client.DeployTokens.DeleteProjectDeployToken(projectID, tokenID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+var ListGroupDeployTokens = func(client *gitlab.Client, groupID interface{}, opts *gitlab.ListGroupDeployTokensOptions) ([]*gitlab.DeployToken, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	if opts.PerPage == 0 {
+		opts.PerPage = DefaultListLimit
+	}
+	tokens, _, err := client.DeployTokens.ListGroupDeployTokens(groupID, opts)
+	if err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
+
+var CreateGroupDeployToken = func(client *gitlab.Client, groupID interface{}, opts *gitlab.CreateGroupDeployTokenOptions) (*gitlab.DeployToken, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	token, _, err := client.DeployTokens.CreateGroupDeployToken(groupID, opts)
+	if err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+var DeleteGroupDeployToken = func(client *gitlab.Client, groupID interface{}, tokenID int) error {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	_, err := client.DeployTokens.DeleteGroupDeployToken(groupID, tokenID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/xanzy/go-gitlab"
+)
+
+type graphQLRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables,omitempty"`
+}
+
+type graphQLResponse struct {
+	Data   interface{} `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// graphQL runs a GraphQL query against the instance the client is configured for
+// and decodes the data of the response into v
+func graphQL(client *gitlab.Client, query string, variables map[string]interface{}, v interface{}) error {
+	// the GraphQL endpoint lives next to the REST API, at /api/graphql
+	toGraphQL := func(req *retryablehttp.Request) error {
+		req.URL.Path = strings.TrimSuffix(client.BaseURL().Path, "/v4/") + "/graphql"
+		req.URL.RawPath = ""
+		return nil
+	}
+
+	req, err := client.NewRequest(http.MethodPost, "graphql", &graphQLRequest{
+		Query:     query,
+		Variables: variables,
+	}, []gitlab.RequestOptionFunc{toGraphQL})
+	if err != nil {
+		return err
+	}
+
+	resp := &graphQLResponse{Data: v}
+	if _, err := client.Do(req, resp); err != nil {
+		return err
+	}
+	if len(resp.Errors) > 0 {
+		messages := make([]string, 0, len(resp.Errors))
+		for _, e := range resp.Errors {
+			messages = append(messages, e.Message)
+		}
+		return errors.New("GraphQL: " + strings.Join(messages, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package api
+
+import "github.com/xanzy/go-gitlab"
+
+var ListGroups = func(client *gitlab.Client, opts *gitlab.ListGroupsOptions) ([]*gitlab.Group, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	if opts.PerPage == 0 {
+		opts.PerPage = DefaultListLimit
+	}
+	groups, _, err := client.Groups.ListGroups(opts)
+	if err != nil {
+		return nil, err
+	}
+	return groups, nil
+}
+
+var ListSubgroups = func(client *gitlab.Client, groupID interface{}, opts *gitlab.ListSubgroupsOptions) ([]*gitlab.Group, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	if opts.PerPage == 0 {
+		opts.PerPage = DefaultListLimit
+	}
+	groups, _, err := client.Groups.ListSubgroups(groupID, opts)
+	if err != nil {
+		return nil, err
+	}
+	return groups, nil
+}
+
+var ListDescendantGroups = func(client *gitlab.Client, groupID interface{}, opts *gitlab.ListDescendantGroupsOptions) ([]*gitlab.Group, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	if opts.PerPage == 0 {
+		opts.PerPage = DefaultListLimit
+	}
+	groups, _, err := client.Groups.ListDescendantGroups(groupID, opts)
+	if err != nil {
+		return nil, err
+	}
+	return groups, nil
+}
+
+var CreateGroup = func(client *gitlab.Client, opts *gitlab.CreateGroupOptions) (*gitlab.Group, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	group, _, err := client.Groups.CreateGroup(opts)
+	if err != nil {
+		return nil, err
+	}
+	return group, nil
+}
+
+var DeleteGroup = func(client *gitlab.Client, groupID interface{}) error {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	_, err := client.Groups.DeleteGroup(groupID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
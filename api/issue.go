@@ -2,7 +2,10 @@
 package api
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
+	"net/http"
 
 	"github.com/xanzy/go-gitlab"
 )
@@ -218,3 +221,85 @@ var AddIssueTimeSpent = func(client *gitlab.Client, projectID interface{}, issue
 
 	return timeStats, nil
 }
+
+var ResetIssueTimeEstimate = func(client *gitlab.Client, projectID interface{}, issueIDD int) (*gitlab.TimeStats, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	timeStats, _, err := client.Issues.ResetTimeEstimate(projectID, issueIDD)
+	if err != nil {
+		return nil, err
+	}
+
+	return timeStats, nil
+}
+
+var ResetIssueTimeSpent = func(client *gitlab.Client, projectID interface{}, issueIDD int) (*gitlab.TimeStats, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	timeStats, _, err := client.Issues.ResetSpentTime(projectID, issueIDD)
+	if err != nil {
+		return nil, err
+	}
+
+	return timeStats, nil
+}
+
+// LinkedIssue is an issue related to another issue, as returned when listing the links of an issue
+type LinkedIssue struct {
+	*gitlab.Issue
+	// LinkType is relates_to, blocks or is_blocked_by, relative to the issue the links were listed for
+	LinkType string
+}
+
+// UnmarshalJSON decodes the issue and its link type, which gitlab.Issue does not hold
+func (l *LinkedIssue) UnmarshalJSON(data []byte) error {
+	var link struct {
+		LinkType string `json:"link_type"`
+	}
+	if err := json.Unmarshal(data, &link); err != nil {
+		return err
+	}
+	l.LinkType = link.LinkType
+	l.Issue = new(gitlab.Issue)
+	return json.Unmarshal(data, l.Issue)
+}
+
+// ListIssueLinks lists the issues linked to an issue along with the type of each link
+var ListIssueLinks = func(client *gitlab.Client, projectID interface{}, issueID int) ([]*LinkedIssue, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	var links []*LinkedIssue
+	err := doRequest(client, http.MethodGet, fmt.Sprintf("projects/%s/issues/%d/links", escapeID(projectID), issueID), nil, &links)
+	if err != nil {
+		return nil, err
+	}
+	return links, nil
+}
+
+var DeleteIssueLink = func(client *gitlab.Client, projectID interface{}, issueID, issueLinkID int) error {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	_, _, err := client.IssueLinks.DeleteIssueLink(projectID, issueID, issueLinkID)
+	return err
+}
+
+var MoveIssue = func(client *gitlab.Client, projectID interface{}, issueID int, toProjectID int) (*gitlab.Issue, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	issue, _, err := client.Issues.MoveIssue(projectID, issueID, &gitlab.MoveIssueOptions{
+		ToProjectID: gitlab.Int(toProjectID),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return issue, nil
+}
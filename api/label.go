@@ -1,6 +1,11 @@
 package api
 
-import "github.com/xanzy/go-gitlab"
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/xanzy/go-gitlab"
+)
 
 var CreateLabel = func(client *gitlab.Client, projectID interface{}, opts *gitlab.CreateLabelOptions) (*gitlab.Label, error) {
 	if client == nil {
@@ -28,3 +33,154 @@ var ListLabels = func(client *gitlab.Client, projectID interface{}, opts *gitlab
 	}
 	return label, nil
 }
+
+// UpdateLabelOptions represents the available UpdateLabel() options.
+// Unlike gitlab.UpdateLabelOptions it includes the label priority.
+type UpdateLabelOptions struct {
+	NewName     *string `url:"new_name,omitempty" json:"new_name,omitempty"`
+	Color       *string `url:"color,omitempty" json:"color,omitempty"`
+	Description *string `url:"description,omitempty" json:"description,omitempty"`
+	Priority    *int    `url:"priority,omitempty" json:"priority,omitempty"`
+}
+
+var UpdateLabel = func(client *gitlab.Client, projectID interface{}, name string, opts *UpdateLabelOptions) (*gitlab.Label, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	label := &gitlab.Label{}
+	err := doRequest(client, http.MethodPut, fmt.Sprintf("projects/%s/labels/%s", escapeID(projectID), escapeID(name)), opts, label)
+	if err != nil {
+		return nil, err
+	}
+	return label, nil
+}
+
+var DeleteLabel = func(client *gitlab.Client, projectID interface{}, name string) error {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	_, err := client.Labels.DeleteLabel(projectID, &gitlab.DeleteLabelOptions{Name: gitlab.String(name)})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+var SubscribeToLabel = func(client *gitlab.Client, projectID interface{}, name string) error {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	_, resp, err := client.Labels.SubscribeToLabel(projectID, escapeID(name))
+	if err != nil && !isNotModified(resp) {
+		return err
+	}
+	return nil
+}
+
+var UnsubscribeFromLabel = func(client *gitlab.Client, projectID interface{}, name string) error {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	resp, err := client.Labels.UnsubscribeFromLabel(projectID, escapeID(name))
+	if err != nil && !isNotModified(resp) {
+		return err
+	}
+	return nil
+}
+
+var PromoteLabel = func(client *gitlab.Client, projectID interface{}, name string) error {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	_, err := client.Labels.PromoteLabel(projectID, escapeID(name))
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+var ListGroupLabels = func(client *gitlab.Client, groupID interface{}, opts *gitlab.ListGroupLabelsOptions) ([]*gitlab.GroupLabel, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	if opts.PerPage == 0 {
+		opts.PerPage = DefaultListLimit
+	}
+	labels, _, err := client.GroupLabels.ListGroupLabels(groupID, opts)
+	if err != nil {
+		return nil, err
+	}
+	return labels, nil
+}
+
+var CreateGroupLabel = func(client *gitlab.Client, groupID interface{}, opts *gitlab.CreateGroupLabelOptions) (*gitlab.GroupLabel, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	label, _, err := client.GroupLabels.CreateGroupLabel(groupID, opts)
+	if err != nil {
+		return nil, err
+	}
+	return label, nil
+}
+
+var UpdateGroupLabel = func(client *gitlab.Client, groupID interface{}, name string, opts *UpdateLabelOptions) (*gitlab.GroupLabel, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	label := &gitlab.GroupLabel{}
+	err := doRequest(client, http.MethodPut, fmt.Sprintf("groups/%s/labels/%s", escapeID(groupID), escapeID(name)), opts, label)
+	if err != nil {
+		return nil, err
+	}
+	return label, nil
+}
+
+var DeleteGroupLabel = func(client *gitlab.Client, groupID interface{}, name string) error {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	_, err := client.GroupLabels.DeleteGroupLabel(groupID, &gitlab.DeleteGroupLabelOptions{Name: gitlab.String(name)})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+var SubscribeToGroupLabel = func(client *gitlab.Client, groupID interface{}, name string) error {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	_, resp, err := client.GroupLabels.SubscribeToGroupLabel(groupID, escapeID(name))
+	if err != nil && !isNotModified(resp) {
+		return err
+	}
+	return nil
+}
+
+var UnsubscribeFromGroupLabel = func(client *gitlab.Client, groupID interface{}, name string) error {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	resp, err := client.GroupLabels.UnsubscribeFromGroupLabel(groupID, escapeID(name))
+	if err != nil && !isNotModified(resp) {
+		return err
+	}
+	return nil
+}
+
+// isNotModified reports whether the request had no effect, e.g. when subscribing to a label twice
+func isNotModified(resp *gitlab.Response) bool {
+	return resp != nil && resp.StatusCode == http.StatusNotModified
+}
+
+var GetLabel = func(client *gitlab.Client, projectID interface{}, name string) (*gitlab.Label, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	label, _, err := client.Labels.GetLabel(projectID, escapeID(name))
+	if err != nil {
+		return nil, err
+	}
+	return label, nil
+}
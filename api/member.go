@@ -0,0 +1,139 @@
+package api
+
+import "github.com/xanzy/go-gitlab"
+
+var AddProjectMember = func(client *gitlab.Client, projectID interface{}, opts *gitlab.AddProjectMemberOptions) (*gitlab.ProjectMember, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	member, _, err := client.ProjectMembers.AddProjectMember(projectID, opts)
+	if err != nil {
+		return nil, err
+	}
+	return member, nil
+}
+
+var EditProjectMember = func(client *gitlab.Client, projectID interface{}, userID int, opts *gitlab.EditProjectMemberOptions) (*gitlab.ProjectMember, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	member, _, err := client.ProjectMembers.EditProjectMember(projectID, userID, opts)
+	if err != nil {
+		return nil, err
+	}
+	return member, nil
+}
+
+var DeleteProjectMember = func(client *gitlab.Client, projectID interface{}, userID int) error {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	_, err := client.ProjectMembers.DeleteProjectMember(projectID, userID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+var ListGroupMembers = func(client *gitlab.Client, groupID interface{}, opts *gitlab.ListGroupMembersOptions) ([]*gitlab.GroupMember, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	if opts.PerPage == 0 {
+		opts.PerPage = DefaultListLimit
+	}
+
+	members, _, err := client.Groups.ListAllGroupMembers(groupID, opts)
+	if err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+var ListDirectGroupMembers = func(client *gitlab.Client, groupID interface{}, opts *gitlab.ListGroupMembersOptions) ([]*gitlab.GroupMember, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	if opts.PerPage == 0 {
+		opts.PerPage = DefaultListLimit
+	}
+
+	members, _, err := client.Groups.ListGroupMembers(groupID, opts)
+	if err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+var ListDirectProjectMembers = func(client *gitlab.Client, projectID interface{}, opts *gitlab.ListProjectMembersOptions) ([]*gitlab.ProjectMember, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	if opts.PerPage == 0 {
+		opts.PerPage = DefaultListLimit
+	}
+
+	members, _, err := client.ProjectMembers.ListProjectMembers(projectID, opts)
+	if err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+var AddGroupMember = func(client *gitlab.Client, groupID interface{}, opts *gitlab.AddGroupMemberOptions) (*gitlab.GroupMember, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	member, _, err := client.GroupMembers.AddGroupMember(groupID, opts)
+	if err != nil {
+		return nil, err
+	}
+	return member, nil
+}
+
+var EditGroupMember = func(client *gitlab.Client, groupID interface{}, userID int, opts *gitlab.EditGroupMemberOptions) (*gitlab.GroupMember, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	member, _, err := client.GroupMembers.EditGroupMember(groupID, userID, opts)
+	if err != nil {
+		return nil, err
+	}
+	return member, nil
+}
+
+var RemoveGroupMember = func(client *gitlab.Client, groupID interface{}, userID int) error {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	_, err := client.GroupMembers.RemoveGroupMember(groupID, userID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+var InviteProjectMembers = func(client *gitlab.Client, projectID interface{}, opts *gitlab.InvitesOptions) (*gitlab.InvitesResult, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	result, _, err := client.Invites.ProjectInvites(projectID, opts)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+var InviteGroupMembers = func(client *gitlab.Client, groupID interface{}, opts *gitlab.InvitesOptions) (*gitlab.InvitesResult, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	result, _, err := client.Invites.GroupInvites(groupID, opts)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -28,6 +28,18 @@ var GetMRApprovalState = func(client *gitlab.Client, projectID interface{}, mrID
 	return mrApprovals, nil
 }
 
+var GetMRApprovals = func(client *gitlab.Client, projectID interface{}, mrID int, opts ...gitlab.RequestOptionFunc) (*gitlab.MergeRequestApprovals, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	mrApprovals, _, err := client.MergeRequestApprovals.GetConfiguration(projectID, mrID, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return mrApprovals, nil
+}
+
 var GetMR = func(client *gitlab.Client, projectID interface{}, mrID int, opts *gitlab.GetMergeRequestsOptions) (*gitlab.MergeRequest, error) {
 	if client == nil {
 		client = apiClient.Lab()
@@ -220,6 +232,23 @@ var ListMRNotes = func(client *gitlab.Client, projectID interface{}, mrID int, o
 	return notes, nil
 }
 
+var GetMRCommits = func(client *gitlab.Client, projectID interface{}, mrID int, opts *gitlab.GetMergeRequestCommitsOptions) ([]*gitlab.Commit, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	if opts.PerPage == 0 {
+		opts.PerPage = DefaultListLimit
+	}
+
+	commits, _, err := client.MergeRequests.GetMergeRequestCommits(projectID, mrID, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return commits, nil
+}
+
 var RebaseMR = func(client *gitlab.Client, projectID interface{}, mrID int) error {
 	if client == nil {
 		client = apiClient.Lab()
@@ -284,3 +313,55 @@ var MRTodo = func(client *gitlab.Client, projectID interface{}, mrID int, opts g
 
 	return mr, nil
 }
+
+var SetMRTimeEstimate = func(client *gitlab.Client, projectID interface{}, mrID int, opts *gitlab.SetTimeEstimateOptions) (*gitlab.TimeStats, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	timeStats, _, err := client.MergeRequests.SetTimeEstimate(projectID, mrID, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return timeStats, nil
+}
+
+var AddMRTimeSpent = func(client *gitlab.Client, projectID interface{}, mrID int, opts *gitlab.AddSpentTimeOptions) (*gitlab.TimeStats, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	timeStats, _, err := client.MergeRequests.AddSpentTime(projectID, mrID, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return timeStats, nil
+}
+
+var ResetMRTimeEstimate = func(client *gitlab.Client, projectID interface{}, mrID int) (*gitlab.TimeStats, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	timeStats, _, err := client.MergeRequests.ResetTimeEstimate(projectID, mrID)
+	if err != nil {
+		return nil, err
+	}
+
+	return timeStats, nil
+}
+
+var ResetMRTimeSpent = func(client *gitlab.Client, projectID interface{}, mrID int) (*gitlab.TimeStats, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	timeStats, _, err := client.MergeRequests.ResetSpentTime(projectID, mrID)
+	if err != nil {
+		return nil, err
+	}
+
+	return timeStats, nil
+}
@@ -0,0 +1,89 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/xanzy/go-gitlab"
+)
+
+// MergeTrain is a merge request queued on the merge train of its target branch
+type MergeTrain struct {
+	ID           int                     `json:"id"`
+	MergeRequest *MergeTrainMergeRequest `json:"merge_request"`
+	User         *gitlab.BasicUser       `json:"user"`
+	Pipeline     *gitlab.PipelineInfo    `json:"pipeline"`
+	CreatedAt    *time.Time              `json:"created_at"`
+	UpdatedAt    *time.Time              `json:"updated_at"`
+	TargetBranch string                  `json:"target_branch"`
+	Status       string                  `json:"status"`
+	MergedAt     *time.Time              `json:"merged_at"`
+	Duration     int                     `json:"duration"`
+}
+
+// MergeTrainMergeRequest is the merge request of a merge train entry
+type MergeTrainMergeRequest struct {
+	ID        int    `json:"id"`
+	IID       int    `json:"iid"`
+	ProjectID int    `json:"project_id"`
+	Title     string `json:"title"`
+	State     string `json:"state"`
+	WebURL    string `json:"web_url"`
+}
+
+// ListMergeTrainsOptions represents the available ListMergeTrains() options.
+type ListMergeTrainsOptions struct {
+	gitlab.ListOptions
+	Scope *string `url:"scope,omitempty" json:"scope,omitempty"`
+	Sort  *string `url:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// AddMRToMergeTrainOptions represents the available AddMRToMergeTrain() options.
+type AddMRToMergeTrainOptions struct {
+	WhenPipelineSucceeds *bool   `url:"when_pipeline_succeeds,omitempty" json:"when_pipeline_succeeds,omitempty"`
+	SHA                  *string `url:"sha,omitempty" json:"sha,omitempty"`
+	Squash               *bool   `url:"squash,omitempty" json:"squash,omitempty"`
+}
+
+var ListMergeTrains = func(client *gitlab.Client, projectID interface{}, opts *ListMergeTrainsOptions) ([]*MergeTrain, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	if opts.PerPage == 0 {
+		opts.PerPage = DefaultListLimit
+	}
+	var trains []*MergeTrain
+	err := doRequest(client, http.MethodGet, fmt.Sprintf("projects/%s/merge_trains", escapeID(projectID)), opts, &trains)
+	if err != nil {
+		return nil, err
+	}
+	return trains, nil
+}
+
+// AddMRToMergeTrain adds the merge request to the merge train of its target branch and
+// returns the merge train
+var AddMRToMergeTrain = func(client *gitlab.Client, projectID interface{}, mrID int, opts *AddMRToMergeTrainOptions) ([]*MergeTrain, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	var trains []*MergeTrain
+	err := doRequest(client, http.MethodPost, fmt.Sprintf("projects/%s/merge_trains/merge_requests/%d", escapeID(projectID), mrID), opts, &trains)
+	if err != nil {
+		return nil, err
+	}
+	return trains, nil
+}
+
+// RemoveMRFromMergeTrain takes the merge request off its merge train by cancelling its
+// automatic merge, as the "Remove from merge train" button does
+var RemoveMRFromMergeTrain = func(client *gitlab.Client, projectID interface{}, mrID int) (*gitlab.MergeRequest, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	mr, _, err := client.MergeRequests.CancelMergeWhenPipelineSucceeds(projectID, mrID)
+	if err != nil {
+		return nil, err
+	}
+	return mr, nil
+}
@@ -83,3 +83,14 @@ var ListProjectMembers = func(client *gitlab.Client, projectID interface{}, opts
 	}
 	return members, nil
 }
+
+var TransferProject = func(client *gitlab.Client, projectID interface{}, opts *gitlab.TransferProjectOptions) (*gitlab.Project, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	project, _, err := client.Projects.TransferProject(projectID, opts)
+	if err != nil {
+		return nil, err
+	}
+	return project, nil
+}
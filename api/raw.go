@@ -0,0 +1,26 @@
+package api
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/xanzy/go-gitlab"
+)
+
+// escapeID escapes a project or group ID or path for use in a request path, the same
+// way go-gitlab does
+func escapeID(id interface{}) string {
+	return strings.ReplaceAll(url.PathEscape(fmt.Sprint(id)), ".", "%2E")
+}
+
+// doRequest sends a request to an endpoint which the go-gitlab version in use does not
+// cover, decoding the response into v
+func doRequest(client *gitlab.Client, method, path string, opt interface{}, v interface{}) error {
+	req, err := client.NewRequest(method, path, opt, nil)
+	if err != nil {
+		return err
+	}
+	_, err = client.Do(req, v)
+	return err
+}
@@ -0,0 +1,109 @@
+package api
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/xanzy/go-gitlab"
+)
+
+// Time logs can only be listed through the GraphQL API.
+
+// Timelog is a single entry of time spent on an issue or merge request
+type Timelog struct {
+	SpentAt time.Time `json:"spentAt"`
+	// TimeSpent is in seconds and negative when time was subtracted
+	TimeSpent int `json:"timeSpent"`
+	User      struct {
+		Username string `json:"username"`
+	} `json:"user"`
+	Issue        *TimelogIssuable `json:"issue"`
+	MergeRequest *TimelogIssuable `json:"mergeRequest"`
+}
+
+// TimelogIssuable is the issue or merge request a time log was added to
+type TimelogIssuable struct {
+	IID       string `json:"iid"`
+	Title     string `json:"title"`
+	Reference string `json:"reference"`
+	Milestone *struct {
+		Title string `json:"title"`
+	} `json:"milestone"`
+}
+
+// ListTimelogsOptions represents the available ListTimelogs() options
+type ListTimelogsOptions struct {
+	// Group lists the time logs of the group and its subgroups instead of a project
+	Group     bool
+	StartDate time.Time
+	EndDate   time.Time
+}
+
+const timelogsQuery = `
+query($fullPath: ID!, $startDate: Time, $endDate: Time, $after: String, $full: Boolean) {
+  namespace: %s(fullPath: $fullPath) {
+    timelogs(startDate: $startDate, endDate: $endDate, first: 100, after: $after) {
+      pageInfo { hasNextPage endCursor }
+      nodes {
+        spentAt
+        timeSpent
+        user { username }
+        issue { iid title reference(full: $full) milestone { title } }
+        mergeRequest { iid title reference(full: $full) milestone { title } }
+      }
+    }
+  }
+}`
+
+// ListTimelogs lists the time logged between the start and end dates in a project, or in a group
+// when opts.Group is set. Both dates are inclusive.
+var ListTimelogs = func(client *gitlab.Client, fullPath string, opts *ListTimelogsOptions) ([]*Timelog, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	namespace := "project"
+	if opts.Group {
+		namespace = "group"
+	}
+	query := fmt.Sprintf(timelogsQuery, namespace)
+
+	variables := map[string]interface{}{
+		"fullPath": fullPath,
+		"full":     opts.Group,
+	}
+	if !opts.StartDate.IsZero() {
+		variables["startDate"] = opts.StartDate.Format("2006-01-02")
+	}
+	if !opts.EndDate.IsZero() {
+		variables["endDate"] = opts.EndDate.Format("2006-01-02")
+	}
+
+	var timelogs []*Timelog
+	for {
+		var data struct {
+			Namespace *struct {
+				Timelogs struct {
+					PageInfo struct {
+						HasNextPage bool   `json:"hasNextPage"`
+						EndCursor   string `json:"endCursor"`
+					} `json:"pageInfo"`
+					Nodes []*Timelog `json:"nodes"`
+				} `json:"timelogs"`
+			} `json:"namespace"`
+		}
+		if err := graphQL(client, query, variables, &data); err != nil {
+			return nil, err
+		}
+		if data.Namespace == nil {
+			return nil, fmt.Errorf("%s %q not found", namespace, fullPath)
+		}
+
+		timelogs = append(timelogs, data.Namespace.Timelogs.Nodes...)
+		if !data.Namespace.Timelogs.PageInfo.HasNextPage {
+			break
+		}
+		variables["after"] = data.Namespace.Timelogs.PageInfo.EndCursor
+	}
+	return timelogs, nil
+}
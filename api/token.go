@@ -0,0 +1,199 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/xanzy/go-gitlab"
+)
+
+// The personal access token listing, revocation and rotation endpoints and the group access token
+// endpoints are not covered by the go-gitlab version in use, so these requests are built manually.
+
+// ListPersonalAccessTokensOptions represents the available ListPersonalAccessTokens() options
+type ListPersonalAccessTokensOptions struct {
+	gitlab.ListOptions
+	UserID *int    `url:"user_id,omitempty" json:"user_id,omitempty"`
+	State  *string `url:"state,omitempty" json:"state,omitempty"`
+}
+
+// RotateAccessTokenOptions represents the available options for rotating personal, project and group access tokens
+type RotateAccessTokenOptions struct {
+	ExpiresAt *gitlab.ISOTime `url:"expires_at,omitempty" json:"expires_at,omitempty"`
+}
+
+// GroupAccessToken represents a GitLab group access token
+type GroupAccessToken struct {
+	ID          int                     `json:"id"`
+	UserID      int                     `json:"user_id"`
+	Name        string                  `json:"name"`
+	Scopes      []string                `json:"scopes"`
+	CreatedAt   *time.Time              `json:"created_at"`
+	ExpiresAt   *gitlab.ISOTime         `json:"expires_at"`
+	Active      bool                    `json:"active"`
+	Revoked     bool                    `json:"revoked"`
+	Token       string                  `json:"token"`
+	AccessLevel gitlab.AccessLevelValue `json:"access_level"`
+}
+
+// CreateGroupAccessTokenOptions represents the available CreateGroupAccessToken() options
+type CreateGroupAccessTokenOptions struct {
+	Name        *string                  `url:"name,omitempty" json:"name,omitempty"`
+	Scopes      []string                 `url:"scopes,omitempty" json:"scopes,omitempty"`
+	AccessLevel *gitlab.AccessLevelValue `url:"access_level,omitempty" json:"access_level,omitempty"`
+	ExpiresAt   *gitlab.ISOTime          `url:"expires_at,omitempty" json:"expires_at,omitempty"`
+}
+
+var ListPersonalAccessTokens = func(client *gitlab.Client, opts *ListPersonalAccessTokensOptions) ([]*gitlab.PersonalAccessToken, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	if opts.PerPage == 0 {
+		opts.PerPage = DefaultListLimit
+	}
+	var tokens []*gitlab.PersonalAccessToken
+	err := doRequest(client, http.MethodGet, "personal_access_tokens", opts, &tokens)
+	if err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
+
+// GetCurrentAccessToken returns the token used to authenticate the client.
+// It works for personal, project and group access tokens.
+var GetCurrentAccessToken = func(client *gitlab.Client) (*gitlab.PersonalAccessToken, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	token := new(gitlab.PersonalAccessToken)
+	err := doRequest(client, http.MethodGet, "personal_access_tokens/self", nil, token)
+	if err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+var CreatePersonalAccessToken = func(client *gitlab.Client, userID int, opts *gitlab.CreatePersonalAccessTokenOptions) (*gitlab.PersonalAccessToken, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	token, _, err := client.Users.CreatePersonalAccessToken(userID, opts)
+	if err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+var RevokePersonalAccessToken = func(client *gitlab.Client, tokenID int) error {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	return doRequest(client, http.MethodDelete, fmt.Sprintf("personal_access_tokens/%d", tokenID), nil, nil)
+}
+
+var RotatePersonalAccessToken = func(client *gitlab.Client, tokenID int, opts *RotateAccessTokenOptions) (*gitlab.PersonalAccessToken, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	token := new(gitlab.PersonalAccessToken)
+	err := doRequest(client, http.MethodPost, fmt.Sprintf("personal_access_tokens/%d/rotate", tokenID), opts, token)
+	if err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+var ListProjectAccessTokens = func(client *gitlab.Client, projectID interface{}, opts *gitlab.ListProjectAccessTokensOptions) ([]*gitlab.ProjectAccessToken, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	if opts.PerPage == 0 {
+		opts.PerPage = DefaultListLimit
+	}
+	tokens, _, err := client.ProjectAccessTokens.ListProjectAccessTokens(projectID, opts)
+	if err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
+
+var CreateProjectAccessToken = func(client *gitlab.Client, projectID interface{}, opts *gitlab.CreateProjectAccessTokenOptions) (*gitlab.ProjectAccessToken, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	token, _, err := client.ProjectAccessTokens.CreateProjectAccessToken(projectID, opts)
+	if err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+var RevokeProjectAccessToken = func(client *gitlab.Client, projectID interface{}, tokenID int) error {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	_, err := client.ProjectAccessTokens.DeleteProjectAccessToken(projectID, tokenID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+var RotateProjectAccessToken = func(client *gitlab.Client, projectID interface{}, tokenID int, opts *RotateAccessTokenOptions) (*gitlab.ProjectAccessToken, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	token := new(gitlab.ProjectAccessToken)
+	err := doRequest(client, http.MethodPost, fmt.Sprintf("projects/%s/access_tokens/%d/rotate", escapeID(projectID), tokenID), opts, token)
+	if err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+var ListGroupAccessTokens = func(client *gitlab.Client, groupID interface{}, opts *gitlab.ListOptions) ([]*GroupAccessToken, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	if opts.PerPage == 0 {
+		opts.PerPage = DefaultListLimit
+	}
+	var tokens []*GroupAccessToken
+	err := doRequest(client, http.MethodGet, fmt.Sprintf("groups/%s/access_tokens", escapeID(groupID)), opts, &tokens)
+	if err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
+
+var CreateGroupAccessToken = func(client *gitlab.Client, groupID interface{}, opts *CreateGroupAccessTokenOptions) (*GroupAccessToken, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	token := new(GroupAccessToken)
+	err := doRequest(client, http.MethodPost, fmt.Sprintf("groups/%s/access_tokens", escapeID(groupID)), opts, token)
+	if err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+var RevokeGroupAccessToken = func(client *gitlab.Client, groupID interface{}, tokenID int) error {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	return doRequest(client, http.MethodDelete, fmt.Sprintf("groups/%s/access_tokens/%d", escapeID(groupID), tokenID), nil, nil)
+}
+
+var RotateGroupAccessToken = func(client *gitlab.Client, groupID interface{}, tokenID int, opts *RotateAccessTokenOptions) (*GroupAccessToken, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	token := new(GroupAccessToken)
+	err := doRequest(client, http.MethodPost, fmt.Sprintf("groups/%s/access_tokens/%d/rotate", escapeID(groupID), tokenID), opts, token)
+	if err != nil {
+		return nil, err
+	}
+	return token, nil
+}
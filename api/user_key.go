@@ -0,0 +1,104 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/xanzy/go-gitlab"
+)
+
+// The GPG key endpoints and the usage type of SSH keys are not covered by the go-gitlab version in use,
+// so these requests are built manually.
+
+// SSH key usage types
+const (
+	SSHKeyUsageAuth           = "auth"
+	SSHKeyUsageSigning        = "signing"
+	SSHKeyUsageAuthAndSigning = "auth_and_signing"
+)
+
+// SSHKey represents a GitLab SSH key including its usage type
+type SSHKey struct {
+	ID        int             `json:"id"`
+	Title     string          `json:"title"`
+	Key       string          `json:"key"`
+	CreatedAt *time.Time      `json:"created_at"`
+	ExpiresAt *gitlab.ISOTime `json:"expires_at"`
+	UsageType string          `json:"usage_type"`
+}
+
+// AddSSHKeyOptions represents the available AddSSHKey() options
+type AddSSHKeyOptions struct {
+	Title     *string         `url:"title,omitempty" json:"title,omitempty"`
+	Key       *string         `url:"key,omitempty" json:"key,omitempty"`
+	ExpiresAt *gitlab.ISOTime `url:"expires_at,omitempty" json:"expires_at,omitempty"`
+	UsageType *string         `url:"usage_type,omitempty" json:"usage_type,omitempty"`
+}
+
+// GPGKey represents a GitLab GPG key
+type GPGKey struct {
+	ID        int        `json:"id"`
+	Key       string     `json:"key"`
+	CreatedAt *time.Time `json:"created_at"`
+}
+
+// AddGPGKeyOptions represents the available AddGPGKey() options
+type AddGPGKeyOptions struct {
+	Key *string `url:"key,omitempty" json:"key,omitempty"`
+}
+
+var AddSSHKey = func(client *gitlab.Client, opts *AddSSHKeyOptions) (*SSHKey, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	key := &SSHKey{}
+	err := doRequest(client, http.MethodPost, "user/keys", opts, key)
+	if err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+var ListGPGKeys = func(client *gitlab.Client) ([]*GPGKey, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	var keys []*GPGKey
+	err := doRequest(client, http.MethodGet, "user/gpg_keys", nil, &keys)
+	if err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+var GetGPGKey = func(client *gitlab.Client, keyID int) (*GPGKey, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	key := &GPGKey{}
+	err := doRequest(client, http.MethodGet, fmt.Sprintf("user/gpg_keys/%d", keyID), nil, key)
+	if err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+var AddGPGKey = func(client *gitlab.Client, opts *AddGPGKeyOptions) (*GPGKey, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	key := &GPGKey{}
+	err := doRequest(client, http.MethodPost, "user/gpg_keys", opts, key)
+	if err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+var DeleteGPGKey = func(client *gitlab.Client, keyID int) error {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	return doRequest(client, http.MethodDelete, fmt.Sprintf("user/gpg_keys/%d", keyID), nil, nil)
+}
@@ -2,17 +2,22 @@ package status
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/profclems/glab/pkg/iostreams"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/profclems/glab/api"
 	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/commands/token/tokenutils"
 	"github.com/profclems/glab/internal/config"
 	"github.com/profclems/glab/pkg/glinstance"
 	"github.com/spf13/cobra"
 )
 
+// tokenExpiryWarningPeriod is how long before the expiry of the active token a warning is shown
+const tokenExpiryWarningPeriod = 14 * 24 * time.Hour
+
 type StatusOpts struct {
 	Hostname  string
 	ShowToken bool
@@ -84,6 +89,7 @@ func statusRun(opts *StatusOpts) error {
 			statusInfo[instance] = append(statusInfo[instance], fmt.Sprintf(x, ys...))
 		}
 
+		var tokenExpiresAt *time.Time
 		token, tokenSource, _ := cfg.GetWithSource(instance, "token", false)
 		apiClient, err := api.NewClientWithCfg(instance, cfg, false)
 		if opts.HttpClientOverride != nil {
@@ -95,6 +101,12 @@ func statusRun(opts *StatusOpts) error {
 				addMsg("%s %s: api call failed: %s", c.FailedIcon(), instance, err)
 			} else {
 				addMsg("%s Logged in to %s as %s (%s)", c.GreenCheck(), instance, c.Bold(user.Username), tokenSource)
+
+				// the endpoint is not available on older instances or for OAuth tokens, so errors are ignored
+				if currentToken, err := api.GetCurrentAccessToken(apiClient.Lab()); err == nil && currentToken.ExpiresAt != nil {
+					expiresAt := time.Time(*currentToken.ExpiresAt)
+					tokenExpiresAt = &expiresAt
+				}
 			}
 		} else {
 			addMsg("%s %s: failed to initialize api client: %s", c.FailedIcon(), instance, err)
@@ -124,6 +136,10 @@ func statusRun(opts *StatusOpts) error {
 			if !api.IsValidToken(token) {
 				addMsg("%s Invalid token provided", c.WarnIcon())
 			}
+			if tokenExpiresAt != nil && time.Until(*tokenExpiresAt) < tokenExpiryWarningPeriod {
+				addMsg("%s Token expires on %s (%s). Rotate it with `%s` and log in again with the new token",
+					c.WarnIcon(), tokenExpiresAt.Format("2006-01-02"), tokenutils.ExpiryState(c, *tokenExpiresAt, time.Now()), c.Bold("glab token rotate"))
+			}
 		} else {
 			addMsg("%s No token provided", c.FailedIcon())
 		}
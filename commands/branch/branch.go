@@ -0,0 +1,33 @@
+package branch
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	branchCompareCmd "github.com/profclems/glab/commands/branch/compare"
+	branchCreateCmd "github.com/profclems/glab/commands/branch/create"
+	branchDeleteCmd "github.com/profclems/glab/commands/branch/delete"
+	branchListCmd "github.com/profclems/glab/commands/branch/list"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdBranch(f *cmdutils.Factory) *cobra.Command {
+	var branchCmd = &cobra.Command{
+		Use:   "branch <command> [flags]",
+		Short: `Manage branches on remote`,
+		Long:  ``,
+		Example: heredoc.Doc(`
+			$ glab branch list
+			$ glab branch create feature-x --ref main
+			$ glab branch delete --merged --dry-run
+			$ glab branch compare main feature-x
+		`),
+	}
+
+	cmdutils.EnableRepoOverride(branchCmd, f)
+
+	branchCmd.AddCommand(branchListCmd.NewCmdList(f, nil))
+	branchCmd.AddCommand(branchCreateCmd.NewCmdCreate(f, nil))
+	branchCmd.AddCommand(branchDeleteCmd.NewCmdDelete(f, nil))
+	branchCmd.AddCommand(branchCompareCmd.NewCmdCompare(f, nil))
+	return branchCmd
+}
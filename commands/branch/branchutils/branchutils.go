@@ -0,0 +1,30 @@
+package branchutils
+
+import (
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/git"
+	"github.com/xanzy/go-gitlab"
+)
+
+// DefaultBranch returns the default branch of the repository.
+// The local git remote is queried first and the project settings on GitLab
+// are used when the repository has no matching remote, e.g. when the repo is overridden with -R.
+func DefaultBranch(apiClient *gitlab.Client, repo glrepo.Interface, remotesFunc func() (glrepo.Remotes, error)) (string, error) {
+	if remotesFunc != nil {
+		if remotes, err := remotesFunc(); err == nil {
+			if repoRemote, err := remotes.FindByRepo(repo.RepoOwner(), repo.RepoName()); err == nil {
+				if branch, err := git.GetDefaultBranch(repoRemote.Name); err == nil && branch != "" {
+					return branch, nil
+				}
+			}
+		}
+	}
+
+	project, err := api.GetProject(apiClient, repo.FullName())
+	if err != nil {
+		return "", cmdutils.WrapError(err, "failed to get default branch")
+	}
+	return project.DefaultBranch, nil
+}
@@ -0,0 +1,156 @@
+package compare
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/branch/branchutils"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/profclems/glab/pkg/tableprinter"
+	"github.com/profclems/glab/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type CompareOpts struct {
+	HTTPClient func() (*gitlab.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+	Remotes    func() (glrepo.Remotes, error)
+
+	From string
+	To   string
+
+	Straight bool
+	ShowDiff bool
+}
+
+func NewCmdCompare(f *cmdutils.Factory, runE func(*CompareOpts) error) *cobra.Command {
+	opts := &CompareOpts{
+		IO: f.IO,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "compare [<from>] <to> [flags]",
+		Short: `Compare two branches, tags or commits`,
+		Long: heredoc.Doc(`
+			Show the commits and changed files in <to> that are not in <from>.
+
+			When only one argument is given, it is compared against the default branch.
+		`),
+		Example: heredoc.Doc(`
+			$ glab branch compare feature-x
+			$ glab branch compare main feature-x
+			$ glab branch compare v1.0.0 v1.1.0 --diff
+		`),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.HTTPClient = f.HttpClient
+			opts.BaseRepo = f.BaseRepo
+			opts.Remotes = f.Remotes
+
+			if len(args) == 2 {
+				opts.From, opts.To = args[0], args[1]
+			} else {
+				opts.To = args[0]
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return compareRun(opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.Straight, "straight", "", false, "Compare directly (from..to) instead of using the merge base (from...to)")
+	cmd.Flags().BoolVarP(&opts.ShowDiff, "diff", "d", false, "Show the full diff of the changed files")
+
+	return cmd
+}
+
+func compareRun(opts *CompareOpts) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	if opts.From == "" {
+		opts.From, err = branchutils.DefaultBranch(apiClient, repo, opts.Remotes)
+		if err != nil {
+			return err
+		}
+	}
+
+	compare, err := api.CompareBranches(apiClient, repo.FullName(), &gitlab.CompareOptions{
+		From:     gitlab.String(opts.From),
+		To:       gitlab.String(opts.To),
+		Straight: gitlab.Bool(opts.Straight),
+	})
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to compare branches")
+	}
+
+	c := opts.IO.Color()
+	out := opts.IO.StdOut
+
+	if compare.CompareSameRef {
+		fmt.Fprintf(out, "%s and %s point to the same commit\n", opts.From, opts.To)
+		return nil
+	}
+
+	fmt.Fprintf(out, "Comparing %s with %s on %s\n", c.Bold(opts.From), c.Bold(opts.To), repo.FullName())
+	if compare.CompareTimeout {
+		fmt.Fprintf(out, "%s The comparison timed out; results may be incomplete\n", c.WarnIcon())
+	}
+
+	fmt.Fprintf(out, "\n%s\n", utils.Pluralize(len(compare.Commits), "commit"))
+	commits := tableprinter.NewTablePrinter()
+	for _, commit := range compare.Commits {
+		commits.AddRow(c.Yellow(commit.ShortID), commit.Title, c.Gray(commit.AuthorName))
+	}
+	fmt.Fprint(out, commits.Render())
+
+	fmt.Fprintf(out, "\n%s changed\n", utils.Pluralize(len(compare.Diffs), "file"))
+	files := tableprinter.NewTablePrinter()
+	for _, diff := range compare.Diffs {
+		files.AddRow(diffStatus(c, diff), diffPath(diff))
+	}
+	fmt.Fprint(out, files.Render())
+
+	if opts.ShowDiff {
+		for _, diff := range compare.Diffs {
+			fmt.Fprintf(out, "\n%s\n%s", c.Bold(diffPath(diff)), diff.Diff)
+		}
+	}
+
+	return nil
+}
+
+func diffStatus(c *iostreams.ColorPalette, diff *gitlab.Diff) string {
+	switch {
+	case diff.NewFile:
+		return c.Green("added")
+	case diff.DeletedFile:
+		return c.Red("deleted")
+	case diff.RenamedFile:
+		return c.Blue("renamed")
+	default:
+		return c.Yellow("modified")
+	}
+}
+
+func diffPath(diff *gitlab.Diff) string {
+	if diff.RenamedFile {
+		return diff.OldPath + " → " + diff.NewPath
+	}
+	return diff.NewPath
+}
@@ -0,0 +1,99 @@
+package create
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/branch/branchutils"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type CreateOpts struct {
+	HTTPClient func() (*gitlab.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+	Remotes    func() (glrepo.Remotes, error)
+
+	Name string
+	Ref  string
+}
+
+func NewCmdCreate(f *cmdutils.Factory, runE func(*CreateOpts) error) *cobra.Command {
+	opts := &CreateOpts{
+		IO: f.IO,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "create <name> [flags]",
+		Short:   `Create a new branch on remote`,
+		Long:    ``,
+		Aliases: []string{"new"},
+		Example: heredoc.Doc(`
+			$ glab branch create feature-x
+			$ glab branch create feature-x --ref v1.2.0
+			$ glab branch new hotfix -r 3a70132
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.HTTPClient = f.HttpClient
+			opts.BaseRepo = f.BaseRepo
+			opts.Remotes = f.Remotes
+			opts.Name = args[0]
+
+			if opts.Name == "" {
+				return &cmdutils.FlagError{Err: errors.New("branch name cannot be empty")}
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return createRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Ref, "ref", "r", "", "Branch name, tag or commit SHA to create the branch from (default: the default branch)")
+
+	return cmd
+}
+
+func createRun(opts *CreateOpts) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	if opts.Ref == "" {
+		opts.Ref, err = branchutils.DefaultBranch(apiClient, repo, opts.Remotes)
+		if err != nil {
+			return err
+		}
+	}
+
+	branch, err := api.CreateBranch(apiClient, repo.FullName(), &gitlab.CreateBranchOptions{
+		Branch: gitlab.String(opts.Name),
+		Ref:    gitlab.String(opts.Ref),
+	})
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to create branch")
+	}
+
+	c := opts.IO.Color()
+	fmt.Fprintf(opts.IO.StdOut, "%s Created branch %s from %s on %s\n", c.GreenCheck(), branch.Name, opts.Ref, repo.FullName())
+	if branch.WebURL != "" {
+		fmt.Fprintln(opts.IO.StdOut, branch.WebURL)
+	}
+
+	return nil
+}
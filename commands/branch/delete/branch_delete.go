@@ -0,0 +1,178 @@
+package delete
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/branch/branchutils"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/profclems/glab/pkg/prompt"
+	"github.com/profclems/glab/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type DeleteOpts struct {
+	HTTPClient func() (*gitlab.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+	Remotes    func() (glrepo.Remotes, error)
+
+	Branches []string
+
+	Merged      bool
+	DryRun      bool
+	ForceDelete bool
+}
+
+func NewCmdDelete(f *cmdutils.Factory, runE func(*DeleteOpts) error) *cobra.Command {
+	opts := &DeleteOpts{
+		IO: f.IO,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "delete [<name>...] [flags]",
+		Short: `Delete branches on remote`,
+		Long: heredoc.Doc(`
+			Delete one or more branches on the remote repository.
+
+			With --merged, all branches already merged into the default branch are deleted.
+			Protected branches and the default branch are never deleted.
+		`),
+		Aliases: []string{"del", "rm"},
+		Example: heredoc.Doc(`
+			$ glab branch delete feature-x
+			$ glab branch delete feature-x feature-y -y
+
+			# preview which merged branches would be deleted
+			$ glab branch delete --merged --dry-run
+
+			$ glab branch delete --merged
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.HTTPClient = f.HttpClient
+			opts.BaseRepo = f.BaseRepo
+			opts.Remotes = f.Remotes
+			opts.Branches = args
+
+			if opts.Merged && len(args) > 0 {
+				return &cmdutils.FlagError{Err: errors.New("branch names cannot be specified with --merged")}
+			}
+			if !opts.Merged && len(args) == 0 {
+				return &cmdutils.FlagError{Err: errors.New("specify at least one branch to delete or use --merged")}
+			}
+			if !opts.DryRun && !opts.ForceDelete && !opts.IO.PromptEnabled() {
+				return &cmdutils.FlagError{Err: errors.New("--yes or -y flag is required when not running interactively")}
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return deleteRun(opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.Merged, "merged", "m", false, "Delete all branches merged into the default branch")
+	cmd.Flags().BoolVarP(&opts.DryRun, "dry-run", "", false, "Show the branches that would be deleted without deleting them")
+	cmd.Flags().BoolVarP(&opts.ForceDelete, "yes", "y", false, "Skip the confirmation prompt")
+
+	return cmd
+}
+
+func deleteRun(opts *DeleteOpts) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	c := opts.IO.Color()
+
+	branches := opts.Branches
+	if opts.Merged {
+		defaultBranch, err := branchutils.DefaultBranch(apiClient, repo, opts.Remotes)
+		if err != nil {
+			return err
+		}
+
+		branches, err = mergedBranches(apiClient, repo, defaultBranch)
+		if err != nil {
+			return err
+		}
+
+		if len(branches) == 0 {
+			fmt.Fprintf(opts.IO.StdOut, "No branches merged into %s on %s\n", defaultBranch, repo.FullName())
+			return nil
+		}
+
+		fmt.Fprintf(opts.IO.StdOut, "Found %s merged into %s on %s\n",
+			utils.Pluralize(len(branches), "branch"), defaultBranch, repo.FullName())
+	}
+
+	if opts.DryRun {
+		for _, branch := range branches {
+			fmt.Fprintf(opts.IO.StdOut, "%s Would delete branch %s\n", c.DotWarnIcon(), branch)
+		}
+		return nil
+	}
+
+	if !opts.ForceDelete && opts.IO.PromptEnabled() {
+		for _, branch := range branches {
+			opts.IO.Logf("- %s\n", branch)
+		}
+		err = prompt.Confirm(&opts.ForceDelete, fmt.Sprintf("Delete %s on %s?", utils.Pluralize(len(branches), "branch"), repo.FullName()), false)
+		if err != nil {
+			return cmdutils.WrapError(err, "could not prompt")
+		}
+	}
+
+	if !opts.ForceDelete {
+		return cmdutils.CancelError()
+	}
+
+	for _, branch := range branches {
+		if err := api.DeleteBranch(apiClient, repo.FullName(), branch); err != nil {
+			return cmdutils.WrapError(err, fmt.Sprintf("failed to delete branch %s", branch))
+		}
+		fmt.Fprintf(opts.IO.StdOut, "%s Deleted branch %s\n", c.RedCheck(), branch)
+	}
+
+	return nil
+}
+
+// mergedBranches returns the names of all branches merged into the default branch,
+// excluding protected branches and the default branch itself
+func mergedBranches(apiClient *gitlab.Client, repo glrepo.Interface, defaultBranch string) ([]string, error) {
+	l := &gitlab.ListBranchesOptions{}
+	l.PerPage = 100
+
+	var names []string
+	for l.Page = 1; ; l.Page++ {
+		branches, err := api.ListBranches(apiClient, repo.FullName(), l)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, branch := range branches {
+			if !branch.Merged || branch.Protected || branch.Default || branch.Name == defaultBranch {
+				continue
+			}
+			names = append(names, branch.Name)
+		}
+
+		if len(branches) < l.PerPage {
+			break
+		}
+	}
+
+	return names, nil
+}
@@ -0,0 +1,190 @@
+package delete
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/google/shlex"
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/httpmock"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/xanzy/go-gitlab"
+)
+
+func Test_NewCmdDelete(t *testing.T) {
+	tests := []struct {
+		name     string
+		cli      string
+		isTTY    bool
+		wants    DeleteOpts
+		wantsErr bool
+	}{
+		{
+			name:  "single branch",
+			cli:   "feature-x",
+			isTTY: true,
+			wants: DeleteOpts{Branches: []string{"feature-x"}},
+		},
+		{
+			name:  "multiple branches non-interactive",
+			cli:   "feature-x feature-y -y",
+			wants: DeleteOpts{Branches: []string{"feature-x", "feature-y"}, ForceDelete: true},
+		},
+		{
+			name:  "merged dry run",
+			cli:   "--merged --dry-run",
+			wants: DeleteOpts{Branches: []string{}, Merged: true, DryRun: true},
+		},
+		{
+			name:     "merged with branch names",
+			cli:      "--merged feature-x",
+			isTTY:    true,
+			wantsErr: true,
+		},
+		{
+			name:     "no branches",
+			cli:      "",
+			isTTY:    true,
+			wantsErr: true,
+		},
+		{
+			name:     "non-interactive without --yes",
+			cli:      "feature-x",
+			wantsErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			io.IsaTTY = tt.isTTY
+			io.IsInTTY = tt.isTTY
+			io.IsErrTTY = tt.isTTY
+			f := &cmdutils.Factory{
+				IO: io,
+			}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts *DeleteOpts
+			cmd := NewCmdDelete(f, func(opts *DeleteOpts) error {
+				gotOpts = opts
+				return nil
+			})
+
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wants.Branches, gotOpts.Branches)
+			assert.Equal(t, tt.wants.Merged, gotOpts.Merged)
+			assert.Equal(t, tt.wants.DryRun, gotOpts.DryRun)
+			assert.Equal(t, tt.wants.ForceDelete, gotOpts.ForceDelete)
+		})
+	}
+}
+
+func Test_deleteRun(t *testing.T) {
+	var httpClient = func(reg *httpmock.Mocker) func() (*gitlab.Client, error) {
+		return func() (*gitlab.Client, error) {
+			a, _ := api.TestClient(&http.Client{Transport: reg}, "", "gitlab.com", false)
+			return a.Lab(), nil
+		}
+	}
+	var baseRepo = func() (glrepo.Interface, error) {
+		return glrepo.FromFullName("owner/repo")
+	}
+
+	const branchesResponse = `[
+		{"name": "main", "merged": false, "protected": true, "default": true},
+		{"name": "feature-merged", "merged": true, "protected": false, "default": false},
+		{"name": "release-1.0", "merged": true, "protected": true, "default": false},
+		{"name": "feature-wip", "merged": false, "protected": false, "default": false}
+	]`
+
+	t.Run("delete named branches", func(t *testing.T) {
+		reg := &httpmock.Mocker{}
+		defer reg.Verify(t)
+		reg.RegisterResponder("DELETE", "/api/v4/projects/owner/repo/repository/branches/feature-x",
+			httpmock.NewStringResponse(204, ""))
+		reg.RegisterResponder("DELETE", "/api/v4/projects/owner/repo/repository/branches/feature-y",
+			httpmock.NewStringResponse(204, ""))
+
+		opts := &DeleteOpts{
+			HTTPClient:  httpClient(reg),
+			BaseRepo:    baseRepo,
+			Branches:    []string{"feature-x", "feature-y"},
+			ForceDelete: true,
+		}
+		_, _ = opts.HTTPClient()
+
+		io, _, stdout, _ := iostreams.Test()
+		opts.IO = io
+
+		err := deleteRun(opts)
+		assert.NoError(t, err)
+		assert.Equal(t, "✓ Deleted branch feature-x\n✓ Deleted branch feature-y\n", stdout.String())
+	})
+
+	t.Run("merged dry run", func(t *testing.T) {
+		reg := &httpmock.Mocker{}
+		defer reg.Verify(t)
+		reg.RegisterResponder("GET", "/api/v4/projects/owner/repo",
+			httpmock.NewStringResponse(200, `{"id": 1, "default_branch": "main"}`))
+		reg.RegisterResponder("GET", "/api/v4/projects/owner/repo/repository/branches",
+			httpmock.NewStringResponse(200, branchesResponse))
+
+		opts := &DeleteOpts{
+			HTTPClient: httpClient(reg),
+			BaseRepo:   baseRepo,
+			Merged:     true,
+			DryRun:     true,
+		}
+		_, _ = opts.HTTPClient()
+
+		io, _, stdout, _ := iostreams.Test()
+		opts.IO = io
+
+		err := deleteRun(opts)
+		assert.NoError(t, err)
+		assert.Equal(t, "Found 1 branch merged into main on owner/repo\n• Would delete branch feature-merged\n", stdout.String())
+	})
+
+	t.Run("merged", func(t *testing.T) {
+		reg := &httpmock.Mocker{}
+		defer reg.Verify(t)
+		reg.RegisterResponder("GET", "/api/v4/projects/owner/repo",
+			httpmock.NewStringResponse(200, `{"id": 1, "default_branch": "main"}`))
+		reg.RegisterResponder("GET", "/api/v4/projects/owner/repo/repository/branches",
+			httpmock.NewStringResponse(200, branchesResponse))
+		reg.RegisterResponder("DELETE", "/api/v4/projects/owner/repo/repository/branches/feature-merged",
+			httpmock.NewStringResponse(204, ""))
+
+		opts := &DeleteOpts{
+			HTTPClient:  httpClient(reg),
+			BaseRepo:    baseRepo,
+			Merged:      true,
+			ForceDelete: true,
+		}
+		_, _ = opts.HTTPClient()
+
+		io, _, stdout, _ := iostreams.Test()
+		opts.IO = io
+
+		err := deleteRun(opts)
+		assert.NoError(t, err)
+		assert.Equal(t, "Found 1 branch merged into main on owner/repo\n✓ Deleted branch feature-merged\n", stdout.String())
+	})
+}
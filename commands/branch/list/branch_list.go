@@ -0,0 +1,136 @@
+package list
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/profclems/glab/pkg/tableprinter"
+	"github.com/profclems/glab/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type ListOpts struct {
+	HTTPClient func() (*gitlab.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+
+	Search  string
+	Page    int
+	PerPage int
+}
+
+func NewCmdList(f *cmdutils.Factory, runE func(*ListOpts) error) *cobra.Command {
+	opts := &ListOpts{
+		IO: f.IO,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list [flags]",
+		Short:   `List branches of a repository`,
+		Long:    ``,
+		Aliases: []string{"ls"},
+		Example: heredoc.Doc(`
+			$ glab branch list
+			$ glab branch ls --search feature
+			$ glab branch list -R owner/repository
+		`),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.HTTPClient = f.HttpClient
+			opts.BaseRepo = f.BaseRepo
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return listRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Search, "search", "s", "", "Only list branches whose names contain the search string")
+	cmd.Flags().IntVarP(&opts.Page, "page", "p", 1, "Page number")
+	cmd.Flags().IntVarP(&opts.PerPage, "per-page", "P", 30, "Number of items to list per page")
+
+	return cmd
+}
+
+func listRun(opts *ListOpts) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	l := &gitlab.ListBranchesOptions{}
+	l.Page = opts.Page
+	l.PerPage = opts.PerPage
+	if opts.Search != "" {
+		l.Search = gitlab.String(opts.Search)
+	}
+
+	branches, err := api.ListBranches(apiClient, repo.FullName(), l)
+	if err != nil {
+		return err
+	}
+
+	if len(branches) == 0 {
+		fmt.Fprintf(opts.IO.StdOut, "No branches found on %s\n", repo.FullName())
+		return nil
+	}
+
+	fmt.Fprintf(opts.IO.StdOut, "Showing %s on %s (Page %d)\n\n%s\n",
+		utils.Pluralize(len(branches), "branch"), repo.FullName(), opts.Page, DisplayBranches(opts.IO, branches))
+	return nil
+}
+
+// DisplayBranches renders a table of branches with their state and latest commit
+func DisplayBranches(streams *iostreams.IOStreams, branches []*gitlab.Branch) string {
+	c := streams.Color()
+	table := tableprinter.NewTablePrinter()
+	isTTY := streams.IsOutputTTY()
+
+	for _, branch := range branches {
+		var flags []string
+		if branch.Default {
+			flags = append(flags, "default")
+		}
+		if branch.Protected {
+			flags = append(flags, "protected")
+		}
+		if branch.Merged {
+			flags = append(flags, "merged")
+		}
+
+		name := branch.Name
+		if branch.Default {
+			name = c.Green(name)
+		}
+
+		var sha, commitTitle, updated string
+		if branch.Commit != nil {
+			sha = branch.Commit.ShortID
+			commitTitle = branch.Commit.Title
+			if branch.Commit.CommittedDate != nil {
+				if isTTY {
+					updated = utils.TimeToPrettyTimeAgo(*branch.Commit.CommittedDate)
+				} else {
+					updated = branch.Commit.CommittedDate.String()
+				}
+			}
+		}
+
+		table.AddRow(name, c.Gray(strings.Join(flags, ",")), sha, commitTitle, c.Gray(updated))
+	}
+
+	return table.Render()
+}
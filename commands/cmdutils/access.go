@@ -0,0 +1,60 @@
+package cmdutils
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xanzy/go-gitlab"
+)
+
+// ParseAccessLevel converts an access level name (e.g. "developer") or
+// its numeric value (e.g. "30") to a gitlab.AccessLevelValue
+func ParseAccessLevel(level string) (gitlab.AccessLevelValue, error) {
+	level = strings.ToLower(strings.TrimSpace(level))
+	if n, err := strconv.Atoi(level); err == nil {
+		if _, ok := GroupMemberLevel[n]; ok {
+			return gitlab.AccessLevelValue(n), nil
+		}
+	}
+	for value, name := range GroupMemberLevel {
+		if name == level || strings.ReplaceAll(name, " ", "-") == level || strings.ReplaceAll(name, " ", "_") == level {
+			return gitlab.AccessLevelValue(value), nil
+		}
+	}
+	return 0, fmt.Errorf("invalid access level %q. Valid values are %s", level, strings.Join(AccessLevelNames(), ", "))
+}
+
+// AccessLevelNames returns the names of the valid access levels ordered by their value
+func AccessLevelNames() []string {
+	values := make([]int, 0, len(GroupMemberLevel))
+	for value := range GroupMemberLevel {
+		values = append(values, value)
+	}
+	sort.Ints(values)
+
+	names := make([]string, 0, len(values))
+	for _, value := range values {
+		names = append(names, strings.ReplaceAll(GroupMemberLevel[value], " ", "-"))
+	}
+	return names
+}
+
+// AccessLevelName returns the human readable name of an access level
+func AccessLevelName(level gitlab.AccessLevelValue) string {
+	if name, ok := GroupMemberLevel[int(level)]; ok {
+		return name
+	}
+	return strconv.Itoa(int(level))
+}
+
+// ParseExpiresAt validates an expiry date in the YYYY-MM-DD format
+func ParseExpiresAt(date string) (time.Time, error) {
+	t, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return t, fmt.Errorf("invalid expiry date %q: must be in the YYYY-MM-DD format", date)
+	}
+	return t, nil
+}
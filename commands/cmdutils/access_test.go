@@ -0,0 +1,48 @@
+package cmdutils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xanzy/go-gitlab"
+)
+
+func Test_ParseAccessLevel(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    gitlab.AccessLevelValue
+		wantErr bool
+	}{
+		{input: "developer", want: gitlab.DeveloperPermissions},
+		{input: "Maintainer", want: gitlab.MaintainerPermissions},
+		{input: "minimal-access", want: gitlab.MinimalAccessPermissions},
+		{input: "minimal_access", want: gitlab.MinimalAccessPermissions},
+		{input: "20", want: gitlab.ReporterPermissions},
+		{input: "25", wantErr: true},
+		{input: "admin", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseAccessLevel(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_AccessLevelNames(t *testing.T) {
+	assert.Equal(t, []string{"no-access", "minimal-access", "guest", "reporter", "developer", "maintainer", "owner"}, AccessLevelNames())
+}
+
+func Test_ParseExpiresAt(t *testing.T) {
+	_, err := ParseExpiresAt("2022-12-31")
+	assert.NoError(t, err)
+
+	_, err = ParseExpiresAt("31/12/2022")
+	assert.Error(t, err)
+}
@@ -0,0 +1,282 @@
+package cmdutils
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/profclems/glab/pkg/prompt"
+	"github.com/profclems/glab/pkg/utils"
+	"github.com/spf13/pflag"
+	"github.com/xanzy/go-gitlab"
+)
+
+// BulkChanges holds the changes the issue and merge request bulk-update commands apply to each item
+type BulkChanges struct {
+	AddLabels    []string
+	RemoveLabels []string
+	Milestone    string
+	Unmilestone  bool
+	Assignees    []string
+	Unassign     bool
+	Close        bool
+	Reopen       bool
+
+	assignments *UserAssignments
+	milestoneID *int
+	assigneeIDs []int
+}
+
+// AddFlags registers the flags which set the changes
+func (c *BulkChanges) AddFlags(fs *pflag.FlagSet) {
+	fs.StringSliceVar(&c.AddLabels, "add-label", []string{}, "Add labels")
+	fs.StringSliceVar(&c.RemoveLabels, "remove-label", []string{}, "Remove labels")
+	fs.StringVar(&c.Milestone, "set-milestone", "", "Set the milestone by title or ID")
+	fs.BoolVar(&c.Unmilestone, "unset-milestone", false, "Remove the milestone")
+	fs.StringSliceVar(&c.Assignees, "set-assignee", []string{}, "Assign users. Prefix with '+' to add to or '-' to remove from the current assignees")
+	fs.BoolVar(&c.Unassign, "unassign", false, "Unassign all users")
+	fs.BoolVar(&c.Close, "close", false, "Close the items")
+	fs.BoolVar(&c.Reopen, "reopen", false, "Reopen the items")
+}
+
+// Validate checks that at least one change is requested and that the changes do not conflict
+func (c *BulkChanges) Validate() error {
+	if c.Milestone != "" && c.Unmilestone {
+		return errors.New("--set-milestone and --unset-milestone are mutually exclusive")
+	}
+	if len(c.Assignees) > 0 && c.Unassign {
+		return errors.New("--set-assignee and --unassign are mutually exclusive")
+	}
+	if c.Close && c.Reopen {
+		return errors.New("--close and --reopen are mutually exclusive")
+	}
+	if m := utils.CommonElementsInStringSlice(c.AddLabels, c.RemoveLabels); len(m) != 0 {
+		return fmt.Errorf("%s %q present in both --add-label and --remove-label", utils.Pluralize(len(m), "label"), strings.Join(m, " "))
+	}
+
+	if len(c.Assignees) > 0 {
+		c.assignments = ParseAssignees(c.Assignees)
+		if err := c.assignments.VerifyAssignees(); err != nil {
+			return fmt.Errorf("--set-assignee: %w", err)
+		}
+	}
+
+	if len(c.AddLabels) == 0 && len(c.RemoveLabels) == 0 && c.Milestone == "" && !c.Unmilestone &&
+		len(c.Assignees) == 0 && !c.Unassign && !c.Close && !c.Reopen {
+		return errors.New("no changes specified. Use --add-label, --remove-label, --set-milestone, --unset-milestone, --set-assignee, --unassign, --close or --reopen")
+	}
+	return nil
+}
+
+// Describe returns a human readable description of each change
+func (c *BulkChanges) Describe() []string {
+	var changes []string
+	if len(c.AddLabels) > 0 {
+		changes = append(changes, "add labels "+strings.Join(c.AddLabels, ", "))
+	}
+	if len(c.RemoveLabels) > 0 {
+		changes = append(changes, "remove labels "+strings.Join(c.RemoveLabels, ", "))
+	}
+	if c.Milestone != "" {
+		changes = append(changes, fmt.Sprintf("set milestone to %q", c.Milestone))
+	}
+	if c.Unmilestone {
+		changes = append(changes, "remove milestone")
+	}
+	if c.assignments != nil {
+		if len(c.assignments.ToReplace) > 0 {
+			changes = append(changes, "assign to "+strings.Join(c.assignments.ToReplace, ", "))
+		}
+		if len(c.assignments.ToAdd) > 0 {
+			changes = append(changes, "add assignees "+strings.Join(c.assignments.ToAdd, ", "))
+		}
+		if len(c.assignments.ToRemove) > 0 {
+			changes = append(changes, "remove assignees "+strings.Join(c.assignments.ToRemove, ", "))
+		}
+	}
+	if c.Unassign {
+		changes = append(changes, "unassign all users")
+	}
+	if c.Close {
+		changes = append(changes, "close")
+	}
+	if c.Reopen {
+		changes = append(changes, "reopen")
+	}
+	return changes
+}
+
+// StateEvent returns the state event for the close and reopen changes
+func (c *BulkChanges) StateEvent() *string {
+	switch {
+	case c.Close:
+		return gitlab.String("close")
+	case c.Reopen:
+		return gitlab.String("reopen")
+	}
+	return nil
+}
+
+// Resolve looks up the milestone and the users to assign so they are not
+// fetched again for every item
+func (c *BulkChanges) Resolve(client *gitlab.Client, repo glrepo.Interface) error {
+	if c.Milestone != "" {
+		id, err := ParseMilestone(client, repo, c.Milestone)
+		if err != nil {
+			return err
+		}
+		c.milestoneID = gitlab.Int(id)
+	} else if c.Unmilestone {
+		c.milestoneID = gitlab.Int(0)
+	}
+
+	if c.assignments != nil && len(c.assignments.ToReplace) > 0 {
+		ids, _, err := c.assignments.UsersFromReplaces(client, nil)
+		if err != nil {
+			return err
+		}
+		c.assigneeIDs = ids
+	}
+	return nil
+}
+
+// itemAssigneeIDs returns the IDs of the users to assign to an item with the given current
+// assignees, or nil when the assignees are not changed
+func (c *BulkChanges) itemAssigneeIDs(client *gitlab.Client, issueAssignees []*gitlab.IssueAssignee, mergeRequestAssignees []*gitlab.BasicUser) ([]int, error) {
+	if c.Unassign {
+		// 0 unassigns all users
+		return []int{0}, nil
+	}
+	if c.assignments == nil {
+		return nil, nil
+	}
+	if len(c.assignments.ToReplace) > 0 {
+		return c.assigneeIDs, nil
+	}
+	ids, _, err := c.assignments.UsersFromAddRemove(issueAssignees, mergeRequestAssignees, client, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return []int{0}, nil
+	}
+	return ids, nil
+}
+
+// IssueOptions returns the options to update the issue with
+func (c *BulkChanges) IssueOptions(client *gitlab.Client, issue *gitlab.Issue) (*gitlab.UpdateIssueOptions, error) {
+	ids, err := c.itemAssigneeIDs(client, issue.Assignees, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &gitlab.UpdateIssueOptions{
+		AddLabels:    c.AddLabels,
+		RemoveLabels: c.RemoveLabels,
+		MilestoneID:  c.milestoneID,
+		AssigneeIDs:  ids,
+		StateEvent:   c.StateEvent(),
+	}, nil
+}
+
+// MergeRequestOptions returns the options to update the merge request with
+func (c *BulkChanges) MergeRequestOptions(client *gitlab.Client, mr *gitlab.MergeRequest) (*gitlab.UpdateMergeRequestOptions, error) {
+	ids, err := c.itemAssigneeIDs(client, nil, mr.Assignees)
+	if err != nil {
+		return nil, err
+	}
+	return &gitlab.UpdateMergeRequestOptions{
+		AddLabels:    c.AddLabels,
+		RemoveLabels: c.RemoveLabels,
+		MilestoneID:  c.milestoneID,
+		AssigneeIDs:  ids,
+		StateEvent:   c.StateEvent(),
+	}, nil
+}
+
+// BulkItem is an issue or merge request selected for a bulk update
+type BulkItem struct {
+	IID   int
+	Title string
+	// Ref is the IID prefixed with # for issues or ! for merge requests
+	Ref string
+}
+
+// ReadIIDs reads issue or merge request IDs, one per line. Only the first field of each line
+// is used and a leading # or ! is ignored so the output of the list commands can be used.
+// Lines which do not start with an ID are skipped.
+func ReadIIDs(r io.Reader) ([]int, error) {
+	var iids []int
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		iid, err := strconv.Atoi(strings.TrimLeft(fields[0], "#!"))
+		if err != nil || iid <= 0 {
+			continue
+		}
+		if !utils.PresentInIntSlice(iids, iid) {
+			iids = append(iids, iid)
+		}
+	}
+	return iids, scanner.Err()
+}
+
+// RunBulkUpdate shows the items and the changes which are going to be made, asks for
+// confirmation unless confirmed is true, then calls update for every item. Items which
+// fail to update are reported and do not stop the remaining updates.
+func RunBulkUpdate(streams *iostreams.IOStreams, kind string, items []BulkItem, changes []string, dryRun, confirmed bool, update func(BulkItem) error) error {
+	c := streams.Color()
+	if len(items) == 0 {
+		fmt.Fprintf(streams.StdErr, "No %ss match\n", kind)
+		return nil
+	}
+
+	fmt.Fprintf(streams.StdOut, "%s will be updated:\n", utils.Pluralize(len(items), kind))
+	for _, item := range items {
+		fmt.Fprintf(streams.StdOut, "  %s %s\n", c.Green(item.Ref), item.Title)
+	}
+	fmt.Fprintln(streams.StdOut, "\nChanges:")
+	for _, change := range changes {
+		fmt.Fprintf(streams.StdOut, "  - %s\n", change)
+	}
+
+	if dryRun {
+		fmt.Fprintln(streams.StdOut, "\nDry run: nothing was updated")
+		return nil
+	}
+
+	if !confirmed {
+		fmt.Fprintln(streams.StdOut)
+		err := prompt.Confirm(&confirmed, fmt.Sprintf("Update %s?", utils.Pluralize(len(items), kind)), false)
+		if err != nil {
+			return WrapError(err, "could not prompt")
+		}
+		if !confirmed {
+			return CancelError()
+		}
+	}
+
+	fmt.Fprintln(streams.StdOut)
+	failed := 0
+	for _, item := range items {
+		if err := update(item); err != nil {
+			failed++
+			fmt.Fprintf(streams.StdErr, "%s Failed to update %s: %s\n", c.FailedIcon(), item.Ref, err)
+			continue
+		}
+		fmt.Fprintf(streams.StdOut, "%s Updated %s\n", c.GreenCheck(), item.Ref)
+	}
+
+	if failed > 0 {
+		fmt.Fprintf(streams.StdErr, "%s of %s failed to update\n", utils.Pluralize(failed, kind), utils.Pluralize(len(items), kind))
+		return SilentError
+	}
+	return nil
+}
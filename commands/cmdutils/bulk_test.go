@@ -0,0 +1,116 @@
+package cmdutils
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ReadIIDs(t *testing.T) {
+	input := strings.Join([]string{
+		"Showing 3 open issues in owner/repo that match your search (Page 1)",
+		"",
+		"#12\towner/repo#12\tFirst issue\t(bug)\tabout 1 day ago",
+		"!15 Second",
+		"18",
+		"#12 duplicate",
+		"-3",
+	}, "\n")
+
+	iids, err := ReadIIDs(strings.NewReader(input))
+	require.NoError(t, err)
+	assert.Equal(t, []int{12, 15, 18}, iids)
+}
+
+func Test_BulkChanges_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		changes BulkChanges
+		wantErr string
+	}{
+		{
+			name:    "no changes",
+			wantErr: "no changes specified",
+		},
+		{
+			name:    "close and reopen",
+			changes: BulkChanges{Close: true, Reopen: true},
+			wantErr: "--close and --reopen are mutually exclusive",
+		},
+		{
+			name:    "same label added and removed",
+			changes: BulkChanges{AddLabels: []string{"bug"}, RemoveLabels: []string{"bug"}},
+			wantErr: `label "bug" present in both --add-label and --remove-label`,
+		},
+		{
+			name:    "assign and unassign",
+			changes: BulkChanges{Assignees: []string{"john"}, Unassign: true},
+			wantErr: "--set-assignee and --unassign are mutually exclusive",
+		},
+		{
+			name:    "valid",
+			changes: BulkChanges{AddLabels: []string{"bug"}, Assignees: []string{"+john"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.changes.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func Test_BulkChanges_Describe(t *testing.T) {
+	changes := BulkChanges{AddLabels: []string{"bug", "p1"}, Unmilestone: true, Close: true}
+	assert.Equal(t, []string{"add labels bug, p1", "remove milestone", "close"}, changes.Describe())
+	assert.Equal(t, "close", *changes.StateEvent())
+	assert.Nil(t, (&BulkChanges{}).StateEvent())
+}
+
+func Test_RunBulkUpdate(t *testing.T) {
+	items := []BulkItem{
+		{IID: 12, Title: "First", Ref: "#12"},
+		{IID: 15, Title: "Second", Ref: "#15"},
+	}
+
+	t.Run("dry run", func(t *testing.T) {
+		io, _, stdout, _ := iostreams.Test()
+		err := RunBulkUpdate(io, "issue", items, []string{"close"}, true, false, func(BulkItem) error {
+			t.Fatal("nothing should be updated in a dry run")
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "2 issues will be updated:\n  #12 First\n  #15 Second\n\nChanges:\n  - close\n\nDry run: nothing was updated\n", stdout.String())
+	})
+
+	t.Run("partial failure", func(t *testing.T) {
+		io, _, stdout, stderr := iostreams.Test()
+		var updated []int
+		err := RunBulkUpdate(io, "issue", items, []string{"close"}, false, true, func(item BulkItem) error {
+			if item.IID == 15 {
+				return errors.New("forbidden")
+			}
+			updated = append(updated, item.IID)
+			return nil
+		})
+		assert.Equal(t, SilentError, err)
+		assert.Equal(t, []int{12}, updated)
+		assert.Contains(t, stdout.String(), "✓ Updated #12\n")
+		assert.Equal(t, "x Failed to update #15: forbidden\n1 issue of 2 issues failed to update\n", stderr.String())
+	})
+
+	t.Run("no items", func(t *testing.T) {
+		io, _, _, stderr := iostreams.Test()
+		require.NoError(t, RunBulkUpdate(io, "merge request", nil, nil, false, true, nil))
+		assert.Equal(t, "No merge requests match\n", stderr.String())
+	})
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/xanzy/go-gitlab"
 
 	"github.com/AlecAivazis/survey/v2"
+	"github.com/MakeNowJust/heredoc"
 	"github.com/profclems/glab/pkg/prompt"
 	"github.com/profclems/glab/pkg/surveyext"
 
@@ -536,6 +537,33 @@ func (ua *UserAssignments) UsersFromAddRemove(
 	return assignedIDs, actions, nil
 }
 
+// PrintTransferWarning warns that transferring the repositories to the target namespace
+// may not be undone
+func PrintTransferWarning(streams *iostreams.IOStreams, repos []string, target string) {
+	c := streams.Color()
+	label := "Source repository"
+	if len(repos) > 1 {
+		label = "Source repositories"
+	}
+	colored := make([]string, len(repos))
+	for i, repo := range repos {
+		colored[i] = c.Yellow(repo)
+	}
+	fmt.Fprintf(streams.StdOut, heredoc.Doc(`
+		🔴 Danger 🔴
+
+		The operation you are about to perform is potentially irreversible.
+		You will lose control of the repository you are transferring in case you do not
+		have access to the target namespace. In addition, you won't be able to transfer
+		the repository back to the original namespace unless you have administrative access
+		to the target namespace.
+
+		%s: %s
+		Target namespace: %s
+
+	`), label, strings.Join(colored, ", "), c.Yellow(target))
+}
+
 func ConfirmTransfer() error {
 	const (
 		performTransferLabel = "Confirm repository transfer"
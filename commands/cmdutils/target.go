@@ -0,0 +1,68 @@
+package cmdutils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/utils"
+)
+
+// Target is the project or group whose resources, e.g. members or labels, a command manages.
+// Group takes precedence over Repo when set.
+type Target struct {
+	Repo  glrepo.Interface
+	Group string
+}
+
+func (t Target) String() string {
+	if t.Group != "" {
+		return t.Group
+	}
+	return t.Repo.FullName()
+}
+
+// ResolveTarget returns the group when one is given, otherwise the base repository
+func ResolveTarget(group string, baseRepo func() (glrepo.Interface, error)) (Target, error) {
+	if group != "" {
+		return Target{Group: group}, nil
+	}
+	repo, err := baseRepo()
+	if err != nil {
+		return Target{}, err
+	}
+	return Target{Repo: repo}, nil
+}
+
+// FindByIDOrName returns the index of the item with the given ID or, failing that, of the
+// only item with the given name. kind names the items in errors, e.g. "deploy token".
+func FindByIDOrName(kind string, owner fmt.Stringer, idOrName string, ids []int, names []string) (int, error) {
+	if id, err := strconv.Atoi(idOrName); err == nil {
+		for i := range ids {
+			if ids[i] == id {
+				return i, nil
+			}
+		}
+	}
+
+	var found []int
+	for i := range names {
+		if names[i] == idOrName {
+			found = append(found, i)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return -1, fmt.Errorf("no %s %q found on %s", kind, idOrName, owner)
+	case 1:
+		return found[0], nil
+	default:
+		matching := make([]string, 0, len(found))
+		for _, i := range found {
+			matching = append(matching, strconv.Itoa(ids[i]))
+		}
+		return -1, fmt.Errorf("%s are named %q on %s. Specify one by ID instead: %s",
+			utils.Pluralize(len(found), kind), idOrName, owner, strings.Join(matching, ", "))
+	}
+}
@@ -0,0 +1,47 @@
+package cmdutils
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ResolveTarget(t *testing.T) {
+	baseRepo := func() (glrepo.Interface, error) {
+		return glrepo.New("owner", "repo"), nil
+	}
+
+	target, err := ResolveTarget("mygroup", func() (glrepo.Interface, error) {
+		return nil, errors.New("not in a git repository")
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "mygroup", target.String())
+
+	target, err = ResolveTarget("", baseRepo)
+	require.NoError(t, err)
+	assert.Equal(t, "owner/repo", target.String())
+}
+
+func Test_FindByIDOrName(t *testing.T) {
+	target := Target{Group: "mygroup"}
+	ids := []int{1, 3, 4, 5}
+	names := []string{"ci", "deploy", "deploy", "3"}
+
+	i, err := FindByIDOrName("deploy token", target, "ci", ids, names)
+	require.NoError(t, err)
+	assert.Equal(t, 0, i)
+
+	// IDs take precedence over names
+	i, err = FindByIDOrName("deploy token", target, "3", ids, names)
+	require.NoError(t, err)
+	assert.Equal(t, 1, i)
+
+	_, err = FindByIDOrName("deploy token", target, "deploy", ids, names)
+	assert.EqualError(t, err, `2 deploy tokens are named "deploy" on mygroup. Specify one by ID instead: 3, 4`)
+
+	_, err = FindByIDOrName("deploy token", target, "2", ids, names)
+	assert.EqualError(t, err, `no deploy token "2" found on mygroup`)
+}
@@ -0,0 +1,193 @@
+package cmdutils
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/profclems/glab/pkg/prompt"
+	"github.com/profclems/glab/pkg/utils"
+	"gopkg.in/yaml.v3"
+)
+
+// Template is a description template with the defaults set in its front matter.
+//
+// The front matter is an optional YAML block at the start of the template, between "---" lines:
+//
+//	---
+//	title: "Bug: {{summary}}"
+//	labels: [bug]
+//	assignees: [alice]
+//	milestone: "1.0"
+//	confidential: false
+//	fields:
+//	  - name: version
+//	    prompt: Which version are you running?
+//	    required: true
+//	  - name: severity
+//	    options: [low, medium, high]
+//	---
+//
+// Placeholders such as {{version}} in the title and body are replaced by the field values.
+// Placeholders without a field in the front matter are asked for as optional text.
+type Template struct {
+	Title        string          `yaml:"title"`
+	Labels       []string        `yaml:"labels"`
+	Assignees    []string        `yaml:"assignees"`
+	Milestone    string          `yaml:"milestone"`
+	Confidential bool            `yaml:"confidential"`
+	Fields       []TemplateField `yaml:"fields"`
+
+	Body string `yaml:"-"`
+}
+
+// TemplateField is a value asked for when a template is used
+type TemplateField struct {
+	Name    string `yaml:"name"`
+	Prompt  string `yaml:"prompt"`
+	Default string `yaml:"default"`
+	// Options limits the value to one of the options
+	Options   []string `yaml:"options"`
+	Required  bool     `yaml:"required"`
+	Multiline bool     `yaml:"multiline"`
+}
+
+var templatePlaceholderRE = regexp.MustCompile(`{{\s*([A-Za-z0-9_.-]+)\s*}}`)
+
+// ParseTemplate splits the front matter from the body of a template
+func ParseTemplate(content string) (*Template, error) {
+	t := &Template{}
+	content = strings.TrimLeft(content, "\n")
+
+	if !strings.HasPrefix(content, "---\n") && !strings.HasPrefix(content, "---\r\n") {
+		t.Body = content
+		return t, nil
+	}
+
+	rest := content[strings.Index(content, "\n")+1:]
+	end := regexp.MustCompile(`(?m)^---\s*$`).FindStringIndex(rest)
+	if end == nil {
+		return nil, errors.New("front matter is not closed with ---")
+	}
+
+	if err := yaml.Unmarshal([]byte(rest[:end[0]]), t); err != nil {
+		return nil, fmt.Errorf("invalid front matter: %w", err)
+	}
+	for i, field := range t.Fields {
+		if field.Name == "" {
+			return nil, fmt.Errorf("invalid front matter: field %d has no name", i+1)
+		}
+		if field.Default != "" && len(field.Options) > 0 && !utils.PresentInStringSlice(field.Options, field.Default) {
+			return nil, fmt.Errorf("invalid front matter: default of field %q is not one of its options", field.Name)
+		}
+	}
+	t.Body = strings.TrimSpace(rest[end[1]:])
+	return t, nil
+}
+
+// Placeholders returns the fields used by the template: the fields of the front matter
+// followed by the placeholders which have no field, in order of appearance
+func (t *Template) Placeholders() []TemplateField {
+	fields := append([]TemplateField{}, t.Fields...)
+	seen := map[string]bool{}
+	for _, field := range fields {
+		seen[field.Name] = true
+	}
+	for _, m := range templatePlaceholderRE.FindAllStringSubmatch(t.Title+"\n"+t.Body, -1) {
+		if !seen[m[1]] {
+			seen[m[1]] = true
+			fields = append(fields, TemplateField{Name: m[1]})
+		}
+	}
+	return fields
+}
+
+// Render replaces the placeholders of the title and body with the values.
+// Placeholders without a value are left as they are.
+func (t *Template) Render(values map[string]string) (title, body string) {
+	replace := func(s string) string {
+		return templatePlaceholderRE.ReplaceAllStringFunc(s, func(placeholder string) string {
+			name := templatePlaceholderRE.FindStringSubmatch(placeholder)[1]
+			if value, ok := values[name]; ok {
+				return value
+			}
+			return placeholder
+		})
+	}
+	return replace(t.Title), replace(t.Body)
+}
+
+// ParseTemplateValues parses key=value pairs given on the command line
+func ParseTemplateValues(pairs []string) (map[string]string, error) {
+	values := map[string]string{}
+	for _, pair := range pairs {
+		i := strings.Index(pair, "=")
+		if i < 1 {
+			return nil, fmt.Errorf("invalid field %q. Use the name=value format", pair)
+		}
+		values[pair[:i]] = pair[i+1:]
+	}
+	return values, nil
+}
+
+// FillTemplateValues completes the values of the template fields. The fields without a value
+// are asked for when interactive is true, otherwise their default is used and an error is
+// returned for the required fields.
+func FillTemplateValues(t *Template, values map[string]string, interactive bool) error {
+	var missing []string
+	for _, field := range t.Placeholders() {
+		if value, ok := values[field.Name]; ok {
+			if len(field.Options) > 0 && !utils.PresentInStringSlice(field.Options, value) {
+				return fmt.Errorf("invalid value %q for field %q. Must be one of %s", value, field.Name, strings.Join(field.Options, ", "))
+			}
+			continue
+		}
+
+		if !interactive {
+			if field.Required && field.Default == "" {
+				missing = append(missing, field.Name)
+				continue
+			}
+			values[field.Name] = field.Default
+			continue
+		}
+
+		question := field.Prompt
+		if question == "" {
+			question = field.Name
+		}
+		var value string
+		var err error
+		switch {
+		case len(field.Options) > 0:
+			selectQs := []*survey.Question{{
+				Name: field.Name,
+				Prompt: &survey.Select{
+					Message: question,
+					Options: field.Options,
+					Default: field.Default,
+				},
+			}}
+			err = prompt.Ask(selectQs, &value)
+		case field.Multiline:
+			err = prompt.AskMultiline(&value, field.Name, question, field.Default)
+		default:
+			err = prompt.AskQuestionWithInput(&value, field.Name, question, field.Default, field.Required)
+		}
+		if err != nil {
+			return fmt.Errorf("could not prompt: %w", err)
+		}
+		values[field.Name] = value
+	}
+
+	if len(missing) > 0 {
+		noun := "field"
+		if len(missing) > 1 {
+			noun = "fields"
+		}
+		return fmt.Errorf("missing required template %s: %s. Set them with --field name=value", noun, strings.Join(missing, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package cmdutils
+
+import (
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const bugTemplate = `---
+title: "Bug: {{summary}}"
+labels: [bug, needs-triage]
+assignees: [alice]
+milestone: "1.0"
+confidential: true
+fields:
+  - name: version
+    prompt: Which version are you running?
+    required: true
+  - name: severity
+    options: [low, medium, high]
+    default: medium
+---
+
+## Version
+{{ version }}
+
+## Severity
+{{severity}}
+
+## Steps to reproduce
+{{steps}}
+`
+
+func Test_ParseTemplate(t *testing.T) {
+	tmpl, err := ParseTemplate(bugTemplate)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Bug: {{summary}}", tmpl.Title)
+	assert.Equal(t, []string{"bug", "needs-triage"}, tmpl.Labels)
+	assert.Equal(t, []string{"alice"}, tmpl.Assignees)
+	assert.Equal(t, "1.0", tmpl.Milestone)
+	assert.True(t, tmpl.Confidential)
+	assert.Equal(t, heredoc.Doc(`
+		## Version
+		{{ version }}
+
+		## Severity
+		{{severity}}
+
+		## Steps to reproduce
+		{{steps}}`), tmpl.Body)
+
+	var names []string
+	for _, field := range tmpl.Placeholders() {
+		names = append(names, field.Name)
+	}
+	assert.Equal(t, []string{"version", "severity", "summary", "steps"}, names)
+
+	t.Run("without front matter", func(t *testing.T) {
+		tmpl, err := ParseTemplate("## Summary\n---\n{{summary}}")
+		require.NoError(t, err)
+		assert.Equal(t, "## Summary\n---\n{{summary}}", tmpl.Body)
+		assert.Empty(t, tmpl.Labels)
+	})
+
+	t.Run("unclosed front matter", func(t *testing.T) {
+		_, err := ParseTemplate("---\nlabels: [bug]\n")
+		assert.EqualError(t, err, "front matter is not closed with ---")
+	})
+
+	t.Run("default not in options", func(t *testing.T) {
+		_, err := ParseTemplate("---\nfields:\n  - name: a\n    options: [x]\n    default: y\n---\n")
+		assert.EqualError(t, err, `invalid front matter: default of field "a" is not one of its options`)
+	})
+}
+
+func Test_FillTemplateValues(t *testing.T) {
+	tmpl, err := ParseTemplate(bugTemplate)
+	require.NoError(t, err)
+
+	values := map[string]string{}
+	err = FillTemplateValues(tmpl, values, false)
+	assert.EqualError(t, err, "missing required template field: version. Set them with --field name=value")
+
+	values, err = ParseTemplateValues([]string{"version=1.2.0", "summary=Crash on start"})
+	require.NoError(t, err)
+	require.NoError(t, FillTemplateValues(tmpl, values, false))
+
+	title, body := tmpl.Render(values)
+	assert.Equal(t, "Bug: Crash on start", title)
+	assert.Equal(t, heredoc.Doc(`
+		## Version
+		1.2.0
+
+		## Severity
+		medium
+
+		## Steps to reproduce
+		`), body)
+
+	values["severity"] = "critical"
+	err = FillTemplateValues(tmpl, values, false)
+	assert.EqualError(t, err, `invalid value "critical" for field "severity". Must be one of low, medium, high`)
+
+	_, err = ParseTemplateValues([]string{"=x"})
+	assert.EqualError(t, err, `invalid field "=x". Use the name=value format`)
+}
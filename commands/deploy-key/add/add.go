@@ -0,0 +1,111 @@
+package add
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/commands/ssh-key/sshkeyutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type AddOpts struct {
+	HTTPClient func() (*gitlab.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+
+	Title   string
+	CanPush bool
+
+	KeyFile string
+}
+
+func NewCmdAdd(f *cmdutils.Factory, runE func(*AddOpts) error) *cobra.Command {
+	opts := &AddOpts{
+		IO: f.IO,
+	}
+	cmd := &cobra.Command{
+		Use:   "add [key-file]",
+		Short: "Add a deploy key to a project",
+		Long: heredoc.Doc(`
+		Creates a new deploy key and enables it on the project.
+
+		Deploy keys are read-only unless --can-push is given.
+		The --title flag is always required
+		`),
+		Example: heredoc.Doc(`
+		# Read the public key from stdin and upload
+		$ cat deploy.pub | glab deploy-key add -t "ci runner"
+
+		# Read the public key from the specified key file and allow pushing
+		$ glab deploy-key add ~/.ssh/deploy.pub -t "release bot" --can-push
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.HTTPClient = f.HttpClient
+			opts.BaseRepo = f.BaseRepo
+
+			if len(args) == 0 {
+				if opts.IO.IsOutputTTY() && opts.IO.IsInTTY {
+					return &cmdutils.FlagError{Err: errors.New("missing key file")}
+				}
+				opts.KeyFile = "-"
+			} else {
+				opts.KeyFile = args[0]
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return addRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Title, "title", "t", "", "New deploy key's title")
+	cmd.Flags().BoolVarP(&opts.CanPush, "can-push", "", false, "Allow the deploy key to push to the repository")
+
+	_ = cmd.MarkFlagRequired("title")
+
+	return cmd
+}
+
+func addRun(opts *AddOpts) error {
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	key, err := sshkeyutils.ReadKeyFile(opts.IO, opts.KeyFile)
+	if err != nil {
+		return err
+	}
+
+	deployKey, err := api.AddDeployKey(httpClient, repo.FullName(), &gitlab.AddDeployKeyOptions{
+		Title:   gitlab.String(opts.Title),
+		Key:     gitlab.String(key),
+		CanPush: gitlab.Bool(opts.CanPush),
+	})
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to add deploy key")
+	}
+
+	if opts.IO.IsOutputTTY() {
+		cs := opts.IO.Color()
+		opts.IO.Logf("%s Deploy key %q (ID %d) added to %s\n", cs.GreenCheck(), deployKey.Title, deployKey.ID, repo.FullName())
+	} else {
+		fmt.Fprintln(opts.IO.StdOut, deployKey.ID)
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package add
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/httpmock"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xanzy/go-gitlab"
+)
+
+func Test_addRun(t *testing.T) {
+	reg := &httpmock.Mocker{}
+	defer reg.Verify(t)
+
+	var body map[string]interface{}
+	reg.RegisterResponder("POST", "/api/v4/projects/owner/repo/deploy_keys", func(req *http.Request) (*http.Response, error) {
+		b, err := ioutil.ReadAll(req.Body)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(b, &body))
+		return httpmock.NewStringResponse(201, `{"id": 13, "title": "ci runner", "can_push": true}`)(req)
+	})
+
+	io, stdin, stdout, _ := iostreams.Test()
+	stdin.WriteString("ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIGx0 ci@example.com\n")
+
+	opts := &AddOpts{
+		HTTPClient: func() (*gitlab.Client, error) {
+			a, _ := api.TestClient(&http.Client{Transport: reg}, "", "gitlab.com", false)
+			return a.Lab(), nil
+		},
+		BaseRepo: func() (glrepo.Interface, error) {
+			return glrepo.FromFullName("owner/repo")
+		},
+		IO:      io,
+		Title:   "ci runner",
+		CanPush: true,
+		KeyFile: "-",
+	}
+	_, _ = opts.HTTPClient()
+
+	err := addRun(opts)
+	require.NoError(t, err)
+
+	assert.Equal(t, "ci runner", body["title"])
+	assert.Equal(t, "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIGx0 ci@example.com\n", body["key"])
+	assert.Equal(t, true, body["can_push"])
+	assert.Equal(t, "13\n", stdout.String())
+}
@@ -0,0 +1,107 @@
+package delete
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/profclems/glab/pkg/prompt"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type DeleteOpts struct {
+	HTTPClient func() (*gitlab.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+
+	KeyID       int
+	ForceDelete bool
+}
+
+func NewCmdDelete(f *cmdutils.Factory, runE func(*DeleteOpts) error) *cobra.Command {
+	opts := &DeleteOpts{
+		IO: f.IO,
+	}
+	cmd := &cobra.Command{
+		Use:   "delete <key-id>",
+		Short: "Delete or disable a deploy key on a project",
+		Long: heredoc.Doc(`
+		Removes a deploy key from the project. The key stays enabled on other projects using it
+		and can be enabled again with "glab deploy-key enable".
+
+		GitLab deletes the key entirely when no other project uses it.
+		`),
+		Aliases: []string{"rm", "disable"},
+		Example: heredoc.Doc(`
+		$ glab deploy-key delete 13
+		$ glab deploy-key disable 13 --yes
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var err error
+
+			opts.HTTPClient = f.HttpClient
+			opts.BaseRepo = f.BaseRepo
+
+			opts.KeyID, err = strconv.Atoi(args[0])
+			if err != nil {
+				return &cmdutils.FlagError{Err: fmt.Errorf("invalid key ID %q", args[0])}
+			}
+
+			if !opts.ForceDelete && !opts.IO.PromptEnabled() {
+				return &cmdutils.FlagError{Err: errors.New("--yes or -y flag is required when not running interactively")}
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return deleteRun(opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.ForceDelete, "yes", "y", false, "Skip the confirmation prompt")
+
+	return cmd
+}
+
+func deleteRun(opts *DeleteOpts) error {
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	if !opts.ForceDelete && opts.IO.PromptEnabled() {
+		err = prompt.Confirm(&opts.ForceDelete, fmt.Sprintf("Delete deploy key %d from %s? It stays enabled on other projects using it", opts.KeyID, repo.FullName()), false)
+		if err != nil {
+			return cmdutils.WrapError(err, "could not prompt")
+		}
+	}
+
+	if !opts.ForceDelete {
+		return cmdutils.CancelError()
+	}
+
+	// the API has no separate endpoint to disable a key: removing it from the project
+	// disables it there and only deletes it when no other project uses it
+	err = api.DeleteDeployKey(httpClient, repo.FullName(), opts.KeyID)
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to delete deploy key")
+	}
+
+	cs := opts.IO.Color()
+	fmt.Fprintf(opts.IO.StdOut, "%s Deploy key %d deleted from %s\n", cs.RedCheck(), opts.KeyID, repo.FullName())
+
+	return nil
+}
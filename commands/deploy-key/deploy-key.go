@@ -0,0 +1,27 @@
+package deploykey
+
+import (
+	"github.com/profclems/glab/commands/cmdutils"
+	cmdAdd "github.com/profclems/glab/commands/deploy-key/add"
+	cmdDelete "github.com/profclems/glab/commands/deploy-key/delete"
+	cmdEnable "github.com/profclems/glab/commands/deploy-key/enable"
+	cmdList "github.com/profclems/glab/commands/deploy-key/list"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdDeployKey(f *cmdutils.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deploy-key <command>",
+		Short: "Manage deploy keys",
+		Long:  "Manage SSH deploy keys which give read-only or read-write access to a project's repository",
+	}
+
+	cmdutils.EnableRepoOverride(cmd, f)
+
+	cmd.AddCommand(cmdList.NewCmdList(f, nil))
+	cmd.AddCommand(cmdAdd.NewCmdAdd(f, nil))
+	cmd.AddCommand(cmdEnable.NewCmdEnable(f, nil))
+	cmd.AddCommand(cmdDelete.NewCmdDelete(f, nil))
+
+	return cmd
+}
@@ -0,0 +1,82 @@
+package enable
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type EnableOpts struct {
+	HTTPClient func() (*gitlab.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+
+	KeyID int
+}
+
+func NewCmdEnable(f *cmdutils.Factory, runE func(*EnableOpts) error) *cobra.Command {
+	opts := &EnableOpts{
+		IO: f.IO,
+	}
+	cmd := &cobra.Command{
+		Use:   "enable <key-id>",
+		Short: "Enable an existing deploy key on a project",
+		Long: heredoc.Doc(`
+		Enables a deploy key which is already in use by another project,
+		so the same key can access this project too.
+		`),
+		Example: heredoc.Doc(`
+		$ glab deploy-key enable 13
+		$ glab deploy-key enable 13 -R owner/other-repository
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var err error
+
+			opts.HTTPClient = f.HttpClient
+			opts.BaseRepo = f.BaseRepo
+
+			opts.KeyID, err = strconv.Atoi(args[0])
+			if err != nil {
+				return &cmdutils.FlagError{Err: fmt.Errorf("invalid key ID %q", args[0])}
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return enableRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func enableRun(opts *EnableOpts) error {
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	key, err := api.EnableDeployKey(httpClient, repo.FullName(), opts.KeyID)
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to enable deploy key")
+	}
+
+	cs := opts.IO.Color()
+	fmt.Fprintf(opts.IO.StdOut, "%s Deploy key %q (ID %d) enabled on %s\n", cs.GreenCheck(), key.Title, key.ID, repo.FullName())
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package list
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/profclems/glab/pkg/tableprinter"
+	"github.com/profclems/glab/pkg/text"
+	"github.com/profclems/glab/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type ListOpts struct {
+	HTTPClient func() (*gitlab.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+
+	Page    int
+	PerPage int
+}
+
+func NewCmdList(f *cmdutils.Factory, runE func(*ListOpts) error) *cobra.Command {
+	opts := &ListOpts{
+		IO: f.IO,
+	}
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List deploy keys of a project",
+		Long:    "Get a list of the deploy keys enabled on a project",
+		Aliases: []string{"ls"},
+		Example: heredoc.Doc(`
+		$ glab deploy-key list
+		$ glab deploy-key list -R owner/repository
+		`),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.HTTPClient = f.HttpClient
+			opts.BaseRepo = f.BaseRepo
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return listRun(opts)
+		},
+	}
+
+	cmd.Flags().IntVarP(&opts.Page, "page", "p", 1, "Page number")
+	cmd.Flags().IntVarP(&opts.PerPage, "per-page", "P", 30, "Number of items to list per page")
+
+	return cmd
+}
+
+func listRun(opts *ListOpts) error {
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	l := &gitlab.ListProjectDeployKeysOptions{
+		Page:    opts.Page,
+		PerPage: opts.PerPage,
+	}
+	keys, err := api.ListProjectDeployKeys(httpClient, repo.FullName(), l)
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to get deploy keys")
+	}
+
+	if len(keys) == 0 {
+		fmt.Fprintf(opts.IO.StdOut, "No deploy keys enabled on %s\n", repo.FullName())
+		return nil
+	}
+
+	cs := opts.IO.Color()
+	table := tableprinter.NewTablePrinter()
+	isTTy := opts.IO.IsOutputTTY()
+
+	for _, key := range keys {
+		access := "read-only"
+		if key.CanPush != nil && *key.CanPush {
+			access = cs.Yellow("read-write")
+		}
+
+		var createdAt string
+		if key.CreatedAt != nil {
+			createdAt = key.CreatedAt.String()
+			if isTTy {
+				createdAt = utils.TimeToPrettyTimeAgo(*key.CreatedAt)
+			}
+		}
+
+		keyText := key.Key
+		if isTTy {
+			keyText = text.Truncate(keyText, 40)
+		}
+		table.AddRow(key.ID, key.Title, access, keyText, cs.Gray(createdAt))
+	}
+
+	opts.IO.LogInfo(table.String())
+
+	return nil
+}
@@ -0,0 +1,128 @@
+package create
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/commands/token/tokenutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type CreateOpts struct {
+	HTTPClient func() (*gitlab.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+
+	Name      string
+	Group     string
+	Username  string
+	Scopes    []string
+	ExpiresAt string
+
+	expiresAt *time.Time
+}
+
+func NewCmdCreate(f *cmdutils.Factory, runE func(*CreateOpts) error) *cobra.Command {
+	opts := &CreateOpts{
+		IO: f.IO,
+	}
+	cmd := &cobra.Command{
+		Use:   "create <name>",
+		Short: "Create a deploy token for a project or group",
+		Long: heredoc.Doc(`
+		Creates a deploy token. By default the token can pull container images and packages.
+
+		The token is printed to stdout and cannot be retrieved again later.
+		The expiry can be a date in the YYYY-MM-DD format or a period from now such as 90d or 12w.
+		`),
+		Aliases: []string{"new"},
+		Example: heredoc.Doc(`
+		$ glab deploy-token create k8s-pull --expires-at 90d
+		$ glab deploy-token create mirror -s read_repository -u mirror-bot
+		$ glab deploy-token create registry-push -g mygroup -s read_registry -s write_registry
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.HTTPClient = f.HttpClient
+			opts.BaseRepo = f.BaseRepo
+			opts.Name = args[0]
+
+			expiresAt, err := tokenutils.ParseExpiresAt(opts.ExpiresAt, time.Now())
+			if err != nil {
+				return &cmdutils.FlagError{Err: err}
+			}
+			if expiresAt != nil {
+				t := time.Time(*expiresAt)
+				opts.expiresAt = &t
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return createRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Group, "group", "g", "", "Create the deploy token for a group instead of the project")
+	cmd.Flags().StringVarP(&opts.Username, "username", "u", "", "Username of the deploy token. Defaults to gitlab+deploy-token-{n}")
+	cmd.Flags().StringSliceVarP(&opts.Scopes, "scope", "s", []string{"read_registry", "read_package_registry"},
+		"Scopes of the token: read_repository, read_registry, write_registry, read_package_registry, write_package_registry")
+	cmd.Flags().StringVarP(&opts.ExpiresAt, "expires-at", "e", "", "Expiry date in the YYYY-MM-DD format or a period from now such as 90d")
+
+	return cmd
+}
+
+func createRun(opts *CreateOpts) error {
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	target, err := cmdutils.ResolveTarget(opts.Group, opts.BaseRepo)
+	if err != nil {
+		return err
+	}
+
+	var username *string
+	if opts.Username != "" {
+		username = gitlab.String(opts.Username)
+	}
+
+	var token *gitlab.DeployToken
+	if target.Group != "" {
+		token, err = api.CreateGroupDeployToken(httpClient, target.Group, &gitlab.CreateGroupDeployTokenOptions{
+			Name:      gitlab.String(opts.Name),
+			Username:  username,
+			Scopes:    opts.Scopes,
+			ExpiresAt: opts.expiresAt,
+		})
+	} else {
+		token, err = api.CreateProjectDeployToken(httpClient, target.Repo.FullName(), &gitlab.CreateProjectDeployTokenOptions{
+			Name:      gitlab.String(opts.Name),
+			Username:  username,
+			Scopes:    opts.Scopes,
+			ExpiresAt: opts.expiresAt,
+		})
+	}
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to create deploy token")
+	}
+
+	cs := opts.IO.Color()
+	opts.IO.Logf("%s Created deploy token %q (ID %d) on %s with username %s and scopes %s\n",
+		cs.GreenCheck(), token.Name, token.ID, target, cs.Bold(token.Username), strings.Join(token.Scopes, ","))
+	if opts.IO.IsErrTTY {
+		opts.IO.Logf("%s Make sure to save the token now. You will not be able to access it again.\n", cs.WarnIcon())
+	}
+	fmt.Fprintln(opts.IO.StdOut, token.Token)
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package create
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/shlex"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCmdCreate(t *testing.T) {
+	tests := []struct {
+		name       string
+		cli        string
+		wantScopes []string
+		wantExpiry string
+		wantErr    bool
+	}{
+		{
+			name:       "default scopes",
+			cli:        "k8s-pull",
+			wantScopes: []string{"read_registry", "read_package_registry"},
+		},
+		{
+			name:       "custom scopes and expiry",
+			cli:        "mirror -s read_repository --expires-at 2022-01-31",
+			wantScopes: []string{"read_repository"},
+			wantExpiry: "2022-01-31",
+		},
+		{
+			name:    "invalid expiry",
+			cli:     "mirror --expires-at tomorrow",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			f := &cmdutils.Factory{IO: io}
+
+			var gotOpts *CreateOpts
+			cmd := NewCmdCreate(f, func(opts *CreateOpts) error {
+				gotOpts = opts
+				return nil
+			})
+
+			argv, err := shlex.Split(tt.cli)
+			require.NoError(t, err)
+			cmd.SetArgs(argv)
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantScopes, gotOpts.Scopes)
+			if tt.wantExpiry == "" {
+				assert.Nil(t, gotOpts.expiresAt)
+			} else {
+				assert.Equal(t, tt.wantExpiry, gotOpts.expiresAt.Format("2006-01-02"))
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package deploytoken
+
+import (
+	"github.com/profclems/glab/commands/cmdutils"
+	cmdCreate "github.com/profclems/glab/commands/deploy-token/create"
+	cmdList "github.com/profclems/glab/commands/deploy-token/list"
+	cmdRevoke "github.com/profclems/glab/commands/deploy-token/revoke"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdDeployToken(f *cmdutils.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deploy-token <command>",
+		Short: "Manage deploy tokens",
+		Long:  "Manage deploy tokens which give access to the repository, container registry and package registry of a project or group",
+	}
+
+	cmdutils.EnableRepoOverride(cmd, f)
+
+	cmd.AddCommand(cmdList.NewCmdList(f, nil))
+	cmd.AddCommand(cmdCreate.NewCmdCreate(f, nil))
+	cmd.AddCommand(cmdRevoke.NewCmdRevoke(f, nil))
+
+	return cmd
+}
@@ -0,0 +1,50 @@
+package deploytokenutils
+
+import (
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/xanzy/go-gitlab"
+)
+
+// ListTokens returns all deploy tokens of the target
+func ListTokens(client *gitlab.Client, target cmdutils.Target) ([]*gitlab.DeployToken, error) {
+	var tokens []*gitlab.DeployToken
+
+	for page := 1; ; page++ {
+		var t []*gitlab.DeployToken
+		var err error
+		if target.Group != "" {
+			t, err = api.ListGroupDeployTokens(client, target.Group, &gitlab.ListGroupDeployTokensOptions{Page: page, PerPage: 100})
+		} else {
+			t, err = api.ListProjectDeployTokens(client, target.Repo.FullName(), &gitlab.ListProjectDeployTokensOptions{Page: page, PerPage: 100})
+		}
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, t...)
+		if len(t) < 100 {
+			break
+		}
+	}
+
+	return tokens, nil
+}
+
+// FindToken returns the deploy token of the target with the given ID or name
+func FindToken(client *gitlab.Client, target cmdutils.Target, idOrName string) (*gitlab.DeployToken, error) {
+	tokens, err := ListTokens(client, target)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int, len(tokens))
+	names := make([]string, len(tokens))
+	for i, t := range tokens {
+		ids[i], names[i] = t.ID, t.Name
+	}
+	i, err := cmdutils.FindByIDOrName("deploy token", target, idOrName, ids, names)
+	if err != nil {
+		return nil, err
+	}
+	return tokens[i], nil
+}
@@ -0,0 +1,96 @@
+package list
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/commands/deploy-token/deploytokenutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/profclems/glab/pkg/tableprinter"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type ListOpts struct {
+	HTTPClient func() (*gitlab.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+
+	Group string
+}
+
+func NewCmdList(f *cmdutils.Factory, runE func(*ListOpts) error) *cobra.Command {
+	opts := &ListOpts{
+		IO: f.IO,
+	}
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List deploy tokens of a project or group",
+		Long:    "Get a list of the active deploy tokens of a project or group",
+		Aliases: []string{"ls"},
+		Example: heredoc.Doc(`
+		$ glab deploy-token list
+		$ glab deploy-token list -g mygroup
+		`),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.HTTPClient = f.HttpClient
+			opts.BaseRepo = f.BaseRepo
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return listRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Group, "group", "g", "", "List the deploy tokens of a group instead of the project")
+
+	return cmd
+}
+
+func listRun(opts *ListOpts) error {
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	target, err := cmdutils.ResolveTarget(opts.Group, opts.BaseRepo)
+	if err != nil {
+		return err
+	}
+
+	tokens, err := deploytokenutils.ListTokens(httpClient, target)
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to get deploy tokens")
+	}
+
+	if len(tokens) == 0 {
+		fmt.Fprintf(opts.IO.StdOut, "No deploy tokens found on %s\n", target)
+		return nil
+	}
+
+	cs := opts.IO.Color()
+	table := tableprinter.NewTablePrinter()
+	table.AddRow("ID", "NAME", "USERNAME", "SCOPES", "EXPIRES")
+
+	for _, token := range tokens {
+		expires := "never"
+		if token.ExpiresAt != nil {
+			expires = token.ExpiresAt.Format("2006-01-02")
+			if token.ExpiresAt.Before(time.Now()) {
+				expires = cs.Red(expires + " (expired)")
+			}
+		}
+		table.AddRow(token.ID, token.Name, token.Username, strings.Join(token.Scopes, ","), expires)
+	}
+
+	opts.IO.LogInfo(table.String())
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package revoke
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/commands/deploy-token/deploytokenutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/profclems/glab/pkg/prompt"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type RevokeOpts struct {
+	HTTPClient func() (*gitlab.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+
+	Token       string
+	Group       string
+	ForceRevoke bool
+}
+
+func NewCmdRevoke(f *cmdutils.Factory, runE func(*RevokeOpts) error) *cobra.Command {
+	opts := &RevokeOpts{
+		IO: f.IO,
+	}
+	cmd := &cobra.Command{
+		Use:     "revoke <id|name>",
+		Short:   "Revoke a deploy token",
+		Long:    "Revokes a deploy token of a project or group by its ID or name",
+		Aliases: []string{"delete", "rm"},
+		Example: heredoc.Doc(`
+		$ glab deploy-token revoke 42
+		$ glab deploy-token revoke k8s-pull --yes
+		$ glab deploy-token revoke registry-push -g mygroup
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.HTTPClient = f.HttpClient
+			opts.BaseRepo = f.BaseRepo
+			opts.Token = args[0]
+
+			if !opts.ForceRevoke && !opts.IO.PromptEnabled() {
+				return &cmdutils.FlagError{Err: errors.New("--yes or -y flag is required when not running interactively")}
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return revokeRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Group, "group", "g", "", "Revoke a deploy token of a group instead of the project")
+	cmd.Flags().BoolVarP(&opts.ForceRevoke, "yes", "y", false, "Skip the confirmation prompt")
+
+	return cmd
+}
+
+func revokeRun(opts *RevokeOpts) error {
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	target, err := cmdutils.ResolveTarget(opts.Group, opts.BaseRepo)
+	if err != nil {
+		return err
+	}
+
+	token, err := deploytokenutils.FindToken(httpClient, target, opts.Token)
+	if err != nil {
+		return err
+	}
+
+	if !opts.ForceRevoke && opts.IO.PromptEnabled() {
+		err = prompt.Confirm(&opts.ForceRevoke, fmt.Sprintf("Revoke deploy token %q (ID %d) on %s?", token.Name, token.ID, target), false)
+		if err != nil {
+			return cmdutils.WrapError(err, "could not prompt")
+		}
+	}
+
+	if !opts.ForceRevoke {
+		return cmdutils.CancelError()
+	}
+
+	if target.Group != "" {
+		err = api.DeleteGroupDeployToken(httpClient, target.Group, token.ID)
+	} else {
+		err = api.DeleteProjectDeployToken(httpClient, target.Repo.FullName(), token.ID)
+	}
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to revoke deploy token")
+	}
+
+	cs := opts.IO.Color()
+	fmt.Fprintf(opts.IO.StdOut, "%s Revoked deploy token %q (ID %d) on %s\n", cs.RedCheck(), token.Name, token.ID, target)
+
+	return nil
+}
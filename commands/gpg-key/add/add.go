@@ -0,0 +1,132 @@
+package add
+
+import (
+	"errors"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/commands/gpg-key/gpgkeyutils"
+	"github.com/profclems/glab/commands/ssh-key/sshkeyutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type AddOpts struct {
+	HTTPClient func() (*gitlab.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+
+	KeyFile    string
+	KeyID      string
+	SigningKey bool
+}
+
+func NewCmdAdd(f *cmdutils.Factory, runE func(*AddOpts) error) *cobra.Command {
+	opts := &AddOpts{
+		IO: f.IO,
+	}
+	cmd := &cobra.Command{
+		Use:   "add [key-file]",
+		Short: "Add a GPG key to your GitLab account",
+		Long: heredoc.Doc(`
+		Adds an ASCII armored public GPG key to the currently authenticated user.
+
+		The key is read from the key file, from standard input, exported with
+		gpg --armor --export for --key-id, or exported for the key git signs commits with
+		when --signing-key is used.
+		`),
+		Example: heredoc.Doc(`
+		# Read the key from stdin and upload
+		$ gpg --armor --export 3AA5C34371567BD2 | glab gpg-key add
+
+		# Export the key with gpg and upload
+		$ glab gpg-key add --key-id 3AA5C34371567BD2
+
+		# Upload the key configured as user.signingkey in git
+		$ glab gpg-key add --signing-key
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.HTTPClient = f.HttpClient
+			opts.BaseRepo = f.BaseRepo
+
+			sources := len(args)
+			if opts.KeyID != "" {
+				sources++
+			}
+			if opts.SigningKey {
+				sources++
+			}
+			if sources > 1 {
+				return &cmdutils.FlagError{Err: errors.New("specify only one of a key file, --key-id or --signing-key")}
+			}
+
+			if len(args) == 1 {
+				opts.KeyFile = args[0]
+			} else if sources == 0 {
+				if opts.IO.IsOutputTTY() && opts.IO.IsInTTY {
+					return &cmdutils.FlagError{Err: errors.New("missing key file. Specify a key file, --key-id or --signing-key")}
+				}
+				opts.KeyFile = "-"
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return addRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.KeyID, "key-id", "k", "", "Export the key with this ID using gpg")
+	cmd.Flags().BoolVarP(&opts.SigningKey, "signing-key", "", false, "Export the key configured as user.signingkey in git using gpg")
+
+	return cmd
+}
+
+func addRun(opts *AddOpts) error {
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	var key string
+	switch {
+	case opts.KeyFile != "":
+		key, err = sshkeyutils.ReadKeyFile(opts.IO, opts.KeyFile)
+	case opts.SigningKey:
+		var keyID string
+		keyID, err = gpgkeyutils.SigningKeyID()
+		if err == nil {
+			key, err = gpgkeyutils.ExportKey(keyID)
+		}
+	default:
+		key, err = gpgkeyutils.ExportKey(opts.KeyID)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := gpgkeyutils.ValidateKey(key); err != nil {
+		return err
+	}
+
+	gpgKey, err := api.AddGPGKey(httpClient, &api.AddGPGKeyOptions{Key: &key})
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to add gpg key")
+	}
+
+	if opts.IO.IsOutputTTY() {
+		cs := opts.IO.Color()
+		keyID := ""
+		if info, err := gpgkeyutils.ParseKey(gpgKey.Key); err == nil {
+			keyID = " " + info.KeyID
+		}
+		opts.IO.Logf("%s GPG key%s added to your account\n", cs.GreenCheck(), keyID)
+	}
+
+	return nil
+}
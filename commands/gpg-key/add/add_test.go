@@ -0,0 +1,79 @@
+package add
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/pkg/httpmock"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xanzy/go-gitlab"
+)
+
+var testKey = heredoc.Doc(`
+	-----BEGIN PGP PUBLIC KEY BLOCK-----
+
+	mDMEatZRzBYJKwYBBAHaRw8BAQdAKq43pn4VgbHmMGeQPJlJvgo/pdAyskswncY+
+	6TGY4r20HFRlc3QgVXNlciA8dGVzdEBleGFtcGxlLmNvbT6IkAQTFggAOBYhBAWd
+	/hUFS432OaghuWFWDB/tg2+BBQJq1lHMAhsDBQsJCAcCBhUKCQgLAgQWAgMBAh4B
+	AheAAAoJEGFWDB/tg2+BAiwBAKVjGIsT2phRAQQgweFH/IwDEy6o+HtscHEEPMr5
+	gyB8AQCmcUlZEWUQ2UfhxEHZS/CIaofigzSZDSG5/Bdf0XUJAg==
+	=+L3z
+	-----END PGP PUBLIC KEY BLOCK-----
+`)
+
+func Test_addRun(t *testing.T) {
+	reg := &httpmock.Mocker{}
+	defer reg.Verify(t)
+
+	var body map[string]interface{}
+	reg.RegisterResponder("POST", "/api/v4/user/gpg_keys", func(req *http.Request) (*http.Response, error) {
+		b, err := ioutil.ReadAll(req.Body)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(b, &body))
+		resp, _ := json.Marshal(map[string]interface{}{"id": 1, "key": testKey})
+		return httpmock.NewStringResponse(201, string(resp))(req)
+	})
+
+	io, stdin, _, stderr := iostreams.Test()
+	io.IsaTTY = true
+	io.IsErrTTY = true
+	stdin.WriteString(testKey)
+
+	opts := &AddOpts{
+		HTTPClient: func() (*gitlab.Client, error) {
+			a, _ := api.TestClient(&http.Client{Transport: reg}, "", "gitlab.com", false)
+			return a.Lab(), nil
+		},
+		IO:      io,
+		KeyFile: "-",
+	}
+	_, _ = opts.HTTPClient()
+
+	err := addRun(opts)
+	require.NoError(t, err)
+
+	assert.Equal(t, testKey, body["key"])
+	assert.Equal(t, "✓ GPG key 61560C1FED836F81 added to your account\n", stderr.String())
+}
+
+func Test_addRun_invalidKey(t *testing.T) {
+	io, stdin, _, _ := iostreams.Test()
+	stdin.WriteString("ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIGx0\n")
+
+	opts := &AddOpts{
+		HTTPClient: func() (*gitlab.Client, error) {
+			return &gitlab.Client{}, nil
+		},
+		IO:      io,
+		KeyFile: "-",
+	}
+
+	err := addRun(opts)
+	assert.EqualError(t, err, "not an ASCII armored public GPG key. Export the key with `gpg --armor --export <key-id>`")
+}
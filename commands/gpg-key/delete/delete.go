@@ -0,0 +1,107 @@
+package delete
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/commands/gpg-key/gpgkeyutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/profclems/glab/pkg/prompt"
+	"github.com/profclems/glab/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type DeleteOpts struct {
+	HTTPClient func() (*gitlab.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+
+	KeyID       int
+	ForceDelete bool
+}
+
+func NewCmdDelete(f *cmdutils.Factory, runE func(*DeleteOpts) error) *cobra.Command {
+	opts := &DeleteOpts{
+		IO: f.IO,
+	}
+	cmd := &cobra.Command{
+		Use:     "delete <key-id>",
+		Short:   "Deletes a single GPG key",
+		Long:    "Deletes a GPG key specified by the ID from your GitLab account. Commits signed with the key will no longer be verified",
+		Aliases: []string{"rm"},
+		Example: heredoc.Doc(`
+		$ glab gpg-key delete 7750633
+		$ glab gpg-key delete 7750633 --yes
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.HTTPClient = f.HttpClient
+			opts.BaseRepo = f.BaseRepo
+
+			opts.KeyID = utils.StringToInt(args[0])
+			if opts.KeyID == 0 {
+				return &cmdutils.FlagError{Err: fmt.Errorf("invalid key ID %q", args[0])}
+			}
+
+			if !opts.ForceDelete && !opts.IO.PromptEnabled() {
+				return &cmdutils.FlagError{Err: errors.New("--yes or -y flag is required when not running interactively")}
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return deleteRun(opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.ForceDelete, "yes", "y", false, "Skip the confirmation prompt")
+
+	return cmd
+}
+
+func deleteRun(opts *DeleteOpts) error {
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	key, err := api.GetGPGKey(httpClient, opts.KeyID)
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to get gpg key")
+	}
+
+	name := strconv.Itoa(key.ID)
+	if info, err := gpgkeyutils.ParseKey(key.Key); err == nil {
+		name = info.KeyID
+	}
+
+	if !opts.ForceDelete && opts.IO.PromptEnabled() {
+		err = prompt.Confirm(&opts.ForceDelete, fmt.Sprintf("Delete GPG key %s from your account?", name), false)
+		if err != nil {
+			return cmdutils.WrapError(err, "could not prompt")
+		}
+	}
+
+	if !opts.ForceDelete {
+		return cmdutils.CancelError()
+	}
+
+	err = api.DeleteGPGKey(httpClient, opts.KeyID)
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to delete gpg key")
+	}
+
+	if opts.IO.IsOutputTTY() {
+		cs := opts.IO.Color()
+		opts.IO.Logf("%s GPG key %s deleted from your account\n", cs.RedCheck(), name)
+	}
+
+	return nil
+}
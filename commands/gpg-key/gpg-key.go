@@ -0,0 +1,25 @@
+package gpgkey
+
+import (
+	"github.com/profclems/glab/commands/cmdutils"
+	cmdAdd "github.com/profclems/glab/commands/gpg-key/add"
+	cmdDelete "github.com/profclems/glab/commands/gpg-key/delete"
+	cmdList "github.com/profclems/glab/commands/gpg-key/list"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdGPGKey(f *cmdutils.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gpg-key <command>",
+		Short: "Manage GPG keys",
+		Long:  "Manage GPG keys registered with your GitLab account for verifying signed commits",
+	}
+
+	cmdutils.EnableRepoOverride(cmd, f)
+
+	cmd.AddCommand(cmdList.NewCmdList(f, nil))
+	cmd.AddCommand(cmdAdd.NewCmdAdd(f, nil))
+	cmd.AddCommand(cmdDelete.NewCmdDelete(f, nil))
+
+	return cmd
+}
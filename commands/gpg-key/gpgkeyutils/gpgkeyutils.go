@@ -0,0 +1,89 @@
+package gpgkeyutils
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os/exec"
+	"sort"
+	"strings"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/profclems/glab/internal/run"
+	"github.com/profclems/glab/pkg/git"
+)
+
+const armorHeader = "-----BEGIN PGP PUBLIC KEY BLOCK-----"
+
+// ExportKey returns the ASCII armored public key exported by gpg for the key ID
+func ExportKey(keyID string) (string, error) {
+	if _, err := exec.LookPath("gpg"); err != nil {
+		return "", errors.New("gpg is required to export the key. Pass the exported key as a file instead")
+	}
+
+	out, err := run.PrepareCmd(exec.Command("gpg", "--armor", "--export", keyID)).Output()
+	if err != nil {
+		return "", fmt.Errorf("failed to export gpg key %s: %w", keyID, err)
+	}
+	if len(bytes.TrimSpace(out)) == 0 {
+		return "", fmt.Errorf("no public gpg key found for %s", keyID)
+	}
+
+	return string(out), nil
+}
+
+// SigningKeyID returns the GPG key configured as user.signingkey for signing commits
+func SigningKeyID() (string, error) {
+	if format, _ := git.Config("gpg.format"); format != "" && format != "openpgp" {
+		return "", fmt.Errorf("git is configured to sign with %s keys. Use `glab ssh-key add --signing-key` instead", format)
+	}
+
+	keyID, err := git.Config("user.signingkey")
+	if err != nil || keyID == "" {
+		return "", errors.New("no signing key configured. Set one with `git config user.signingkey <key-id>`")
+	}
+
+	return keyID, nil
+}
+
+// ValidateKey checks that key is an ASCII armored public key block
+func ValidateKey(key string) error {
+	if !strings.HasPrefix(strings.TrimSpace(key), armorHeader) {
+		return errors.New("not an ASCII armored public GPG key. Export the key with `gpg --armor --export <key-id>`")
+	}
+	return nil
+}
+
+// KeyInfo describes the primary key of an OpenPGP public key block
+type KeyInfo struct {
+	Fingerprint string
+	KeyID       string
+	UserIDs     []string
+}
+
+// ParseKey reads the fingerprint and user IDs from an ASCII armored public key block
+func ParseKey(armored string) (*KeyInfo, error) {
+	if err := ValidateKey(armored); err != nil {
+		return nil, err
+	}
+
+	entities, err := openpgp.ReadArmoredKeyRing(strings.NewReader(armored))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read gpg key: %w", err)
+	}
+	if len(entities) == 0 {
+		return nil, errors.New("no public key found in gpg key block")
+	}
+
+	primary := entities[0].PrimaryKey
+	info := &KeyInfo{
+		Fingerprint: strings.ToUpper(hex.EncodeToString(primary.Fingerprint)),
+		KeyID:       primary.KeyIdString(),
+	}
+	for name := range entities[0].Identities {
+		info.UserIDs = append(info.UserIDs, name)
+	}
+	sort.Strings(info.UserIDs)
+	return info, nil
+}
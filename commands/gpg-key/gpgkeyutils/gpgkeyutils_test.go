@@ -0,0 +1,60 @@
+package gpgkeyutils
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/ProtonMail/go-crypto/openpgp/packet"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testKey = heredoc.Doc(`
+	-----BEGIN PGP PUBLIC KEY BLOCK-----
+
+	mDMEatZRzBYJKwYBBAHaRw8BAQdAKq43pn4VgbHmMGeQPJlJvgo/pdAyskswncY+
+	6TGY4r20HFRlc3QgVXNlciA8dGVzdEBleGFtcGxlLmNvbT6IkAQTFggAOBYhBAWd
+	/hUFS432OaghuWFWDB/tg2+BBQJq1lHMAhsDBQsJCAcCBhUKCQgLAgQWAgMBAh4B
+	AheAAAoJEGFWDB/tg2+BAiwBAKVjGIsT2phRAQQgweFH/IwDEy6o+HtscHEEPMr5
+	gyB8AQCmcUlZEWUQ2UfhxEHZS/CIaofigzSZDSG5/Bdf0XUJAg==
+	=+L3z
+	-----END PGP PUBLIC KEY BLOCK-----
+`)
+
+func Test_ParseKey(t *testing.T) {
+	info, err := ParseKey(testKey)
+	require.NoError(t, err)
+
+	assert.Equal(t, "059DFE15054B8DF639A821B961560C1FED836F81", info.Fingerprint)
+	assert.Equal(t, "61560C1FED836F81", info.KeyID)
+	assert.Equal(t, []string{"Test User <test@example.com>"}, info.UserIDs)
+}
+
+func Test_ParseKey_invalid(t *testing.T) {
+	_, err := ParseKey("ssh-ed25519 AAAA")
+	assert.EqualError(t, err, "not an ASCII armored public GPG key. Export the key with `gpg --armor --export <key-id>`")
+
+	_, err = ParseKey("-----BEGIN PGP PUBLIC KEY BLOCK-----\n\nmDME\n")
+	assert.Error(t, err)
+}
+
+func Test_ParseKey_v5(t *testing.T) {
+	entity, err := openpgp.NewEntity("Test User", "", "test@example.com", &packet.Config{V5Keys: true})
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	w, err := armor.Encode(&buf, openpgp.PublicKeyType, nil)
+	require.NoError(t, err)
+	require.NoError(t, entity.Serialize(w))
+	require.NoError(t, w.Close())
+
+	info, err := ParseKey(buf.String())
+	require.NoError(t, err)
+
+	assert.Len(t, info.Fingerprint, 64)
+	assert.Len(t, info.KeyID, 16)
+	assert.Equal(t, []string{"Test User <test@example.com>"}, info.UserIDs)
+}
@@ -0,0 +1,109 @@
+package list
+
+import (
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/commands/gpg-key/gpgkeyutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/profclems/glab/pkg/tableprinter"
+	"github.com/profclems/glab/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type ListOpts struct {
+	HTTPClient func() (*gitlab.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+
+	ShowKey bool
+}
+
+func NewCmdList(f *cmdutils.Factory, runE func(*ListOpts) error) *cobra.Command {
+	opts := &ListOpts{
+		IO: f.IO,
+	}
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "Lists currently authenticated user’s GPG keys",
+		Long:    "Get a list of currently authenticated user’s GPG keys",
+		Aliases: []string{"ls"},
+		Example: heredoc.Doc(`
+		$ glab gpg-key list
+
+		# Print the ASCII armored public keys
+		$ glab gpg-key list --show-key
+		`),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.HTTPClient = f.HttpClient
+			opts.BaseRepo = f.BaseRepo
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return listRun(opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.ShowKey, "show-key", "", false, "Print the ASCII armored public keys")
+
+	return cmd
+}
+
+func listRun(opts *ListOpts) error {
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	keys, err := api.ListGPGKeys(httpClient)
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to get gpg keys")
+	}
+
+	if opts.ShowKey {
+		for _, key := range keys {
+			opts.IO.LogInfo(strings.TrimSpace(key.Key) + "\n")
+		}
+		return nil
+	}
+
+	if len(keys) == 0 {
+		opts.IO.Logf("No GPG keys registered with your account\n")
+		return nil
+	}
+
+	cs := opts.IO.Color()
+	table := tableprinter.NewTablePrinter()
+	isTTy := opts.IO.IsOutputTTY()
+	if isTTy {
+		table.AddRow("ID", "KEY ID", "USER IDS", "CREATED")
+	}
+
+	for _, key := range keys {
+		keyID, userIDs := "unknown", ""
+		if info, err := gpgkeyutils.ParseKey(key.Key); err == nil {
+			keyID = info.KeyID
+			userIDs = strings.Join(info.UserIDs, ", ")
+		}
+
+		createdAt := ""
+		if key.CreatedAt != nil {
+			createdAt = key.CreatedAt.String()
+			if isTTy {
+				createdAt = utils.TimeToPrettyTimeAgo(*key.CreatedAt)
+			}
+		}
+		table.AddRow(key.ID, keyID, userIDs, cs.Gray(createdAt))
+	}
+
+	opts.IO.LogInfo(table.String())
+
+	return nil
+}
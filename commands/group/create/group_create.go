@@ -0,0 +1,117 @@
+package create
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type CreateOpts struct {
+	HTTPClient func() (*gitlab.Client, error)
+	IO         *iostreams.IOStreams
+
+	Path        string
+	Parent      string
+	Name        string
+	Description string
+	Visibility  string
+}
+
+func NewCmdCreate(f *cmdutils.Factory, runE func(*CreateOpts) error) *cobra.Command {
+	opts := &CreateOpts{
+		IO: f.IO,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "create <path> [flags]",
+		Short: `Create a group or subgroup`,
+		Long: heredoc.Doc(`
+			Create a new group.
+
+			A subgroup is created when the path contains the full path of an existing parent group,
+			e.g. mygroup/subgroup, or when the parent group is given with --parent.
+		`),
+		Aliases: []string{"new"},
+		Example: heredoc.Doc(`
+			$ glab group create mygroup
+			$ glab group create mygroup/subgroup --description "Backend services"
+			$ glab group create subgroup --parent mygroup --visibility internal
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.HTTPClient = f.HttpClient
+
+			opts.Path = strings.Trim(args[0], "/")
+			if dir := path.Dir(opts.Path); dir != "." {
+				if opts.Parent != "" {
+					return &cmdutils.FlagError{Err: fmt.Errorf("specify the parent group either in the path or with --parent, not both")}
+				}
+				opts.Parent = dir
+				opts.Path = path.Base(opts.Path)
+			}
+			if opts.Name == "" {
+				opts.Name = opts.Path
+			}
+
+			switch opts.Visibility {
+			case "", "private", "internal", "public":
+			default:
+				return &cmdutils.FlagError{Err: fmt.Errorf("invalid visibility %q. Must be one of private, internal or public", opts.Visibility)}
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return createRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Parent, "parent", "p", "", "Full path of the parent group to create a subgroup in")
+	cmd.Flags().StringVarP(&opts.Name, "name", "n", "", "Name of the group. Defaults to the path")
+	cmd.Flags().StringVarP(&opts.Description, "description", "d", "", "Description of the group")
+	cmd.Flags().StringVarP(&opts.Visibility, "visibility", "v", "", "Visibility of the group: private, internal or public")
+
+	return cmd
+}
+
+func createRun(opts *CreateOpts) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	createOpts := &gitlab.CreateGroupOptions{
+		Name: gitlab.String(opts.Name),
+		Path: gitlab.String(opts.Path),
+	}
+	if opts.Description != "" {
+		createOpts.Description = gitlab.String(opts.Description)
+	}
+	if opts.Visibility != "" {
+		createOpts.Visibility = gitlab.Visibility(gitlab.VisibilityValue(opts.Visibility))
+	}
+	if opts.Parent != "" {
+		parent, err := api.GetGroup(apiClient, opts.Parent)
+		if err != nil {
+			return cmdutils.WrapError(err, fmt.Sprintf("could not find parent group %s", opts.Parent))
+		}
+		createOpts.ParentID = gitlab.Int(parent.ID)
+	}
+
+	group, err := api.CreateGroup(apiClient, createOpts)
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to create group")
+	}
+
+	c := opts.IO.Color()
+	fmt.Fprintf(opts.IO.StdOut, "%s Created group %s\n%s\n", c.GreenCheck(), group.FullPath, group.WebURL)
+	return nil
+}
@@ -0,0 +1,77 @@
+package create
+
+import (
+	"testing"
+
+	"github.com/google/shlex"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCmdCreate(t *testing.T) {
+	tests := []struct {
+		name     string
+		cli      string
+		wantOpts CreateOpts
+		wantErr  string
+	}{
+		{
+			name:     "top level group",
+			cli:      "mygroup",
+			wantOpts: CreateOpts{Path: "mygroup", Name: "mygroup"},
+		},
+		{
+			name:     "subgroup from path",
+			cli:      "mygroup/backend/services --name Services",
+			wantOpts: CreateOpts{Path: "services", Parent: "mygroup/backend", Name: "Services"},
+		},
+		{
+			name:     "subgroup from flag",
+			cli:      "services --parent mygroup -v internal",
+			wantOpts: CreateOpts{Path: "services", Parent: "mygroup", Name: "services", Visibility: "internal"},
+		},
+		{
+			name:    "parent in path and flag",
+			cli:     "mygroup/services --parent other",
+			wantErr: "specify the parent group either in the path or with --parent, not both",
+		},
+		{
+			name:    "invalid visibility",
+			cli:     "mygroup -v secret",
+			wantErr: `invalid visibility "secret". Must be one of private, internal or public`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			f := &cmdutils.Factory{IO: io}
+
+			var gotOpts *CreateOpts
+			cmd := NewCmdCreate(f, func(opts *CreateOpts) error {
+				gotOpts = opts
+				return nil
+			})
+
+			argv, err := shlex.Split(tt.cli)
+			require.NoError(t, err)
+			cmd.SetArgs(argv)
+			cmd.SetOut(io.StdOut)
+			cmd.SetErr(io.StdErr)
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantOpts.Path, gotOpts.Path)
+			assert.Equal(t, tt.wantOpts.Parent, gotOpts.Parent)
+			assert.Equal(t, tt.wantOpts.Name, gotOpts.Name)
+			assert.Equal(t, tt.wantOpts.Visibility, gotOpts.Visibility)
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package delete
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/profclems/glab/pkg/prompt"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type DeleteOpts struct {
+	HTTPClient func() (*gitlab.Client, error)
+	IO         *iostreams.IOStreams
+
+	Group       string
+	ForceDelete bool
+}
+
+func NewCmdDelete(f *cmdutils.Factory, runE func(*DeleteOpts) error) *cobra.Command {
+	opts := &DeleteOpts{
+		IO: f.IO,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "delete <group> [flags]",
+		Short: `Delete a group or subgroup`,
+		Long: heredoc.Doc(`
+			Delete a group together with its subgroups and projects.
+
+			On instances with delayed deletion enabled, the group is marked for deletion
+			and removed after the configured retention period.
+		`),
+		Aliases: []string{"rm"},
+		Example: heredoc.Doc(`
+			$ glab group delete mygroup/subgroup
+			$ glab group delete mygroup/subgroup --yes
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.HTTPClient = f.HttpClient
+			opts.Group = args[0]
+
+			if !opts.ForceDelete && !opts.IO.PromptEnabled() {
+				return &cmdutils.FlagError{Err: errors.New("--yes or -y flag is required when not running interactively")}
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return deleteRun(opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.ForceDelete, "yes", "y", false, "Skip the confirmation prompt")
+
+	return cmd
+}
+
+func deleteRun(opts *DeleteOpts) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	if !opts.ForceDelete && opts.IO.PromptEnabled() {
+		err = prompt.Confirm(&opts.ForceDelete, fmt.Sprintf("Delete group %s with all its subgroups and projects?", opts.Group), false)
+		if err != nil {
+			return cmdutils.WrapError(err, "could not prompt")
+		}
+	}
+
+	if !opts.ForceDelete {
+		return cmdutils.CancelError()
+	}
+
+	err = api.DeleteGroup(apiClient, opts.Group)
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to delete group %s", opts.Group))
+	}
+
+	c := opts.IO.Color()
+	fmt.Fprintf(opts.IO.StdOut, "%s Deleted group %s\n", c.RedCheck(), opts.Group)
+	return nil
+}
@@ -0,0 +1,37 @@
+package group
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/profclems/glab/commands/cmdutils"
+	groupCreateCmd "github.com/profclems/glab/commands/group/create"
+	groupDeleteCmd "github.com/profclems/glab/commands/group/delete"
+	groupListCmd "github.com/profclems/glab/commands/group/list"
+	groupProjectsCmd "github.com/profclems/glab/commands/group/projects"
+	groupTransferCmd "github.com/profclems/glab/commands/group/transfer"
+	groupViewCmd "github.com/profclems/glab/commands/group/view"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdGroup(f *cmdutils.Factory) *cobra.Command {
+	var groupCmd = &cobra.Command{
+		Use:   "group <command> [flags]",
+		Short: `Manage groups and subgroups`,
+		Long:  ``,
+		Example: heredoc.Doc(`
+			$ glab group list
+			$ glab group create mygroup/subgroup
+			$ glab group projects mygroup --tree
+			$ glab group transfer mygroup/subgroup
+		`),
+	}
+
+	cmdutils.EnableRepoOverride(groupCmd, f)
+
+	groupCmd.AddCommand(groupListCmd.NewCmdList(f, nil))
+	groupCmd.AddCommand(groupViewCmd.NewCmdView(f, nil))
+	groupCmd.AddCommand(groupCreateCmd.NewCmdCreate(f, nil))
+	groupCmd.AddCommand(groupDeleteCmd.NewCmdDelete(f, nil))
+	groupCmd.AddCommand(groupProjectsCmd.NewCmdProjects(f, nil))
+	groupCmd.AddCommand(groupTransferCmd.NewCmdTransfer(f, nil))
+	return groupCmd
+}
@@ -0,0 +1,160 @@
+package grouputils
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/xanzy/go-gitlab"
+)
+
+// ResolveGroup returns the group given as argument or,
+// when none is given, the namespace of the current repository
+func ResolveGroup(args []string, baseRepo func() (glrepo.Interface, error)) (string, error) {
+	if len(args) > 0 && args[0] != "" {
+		return strings.Trim(args[0], "/"), nil
+	}
+	repo, err := baseRepo()
+	if err != nil {
+		return "", err
+	}
+	return repo.RepoOwner(), nil
+}
+
+// ListAllProjects returns every project in the group and its subgroups
+func ListAllProjects(client *gitlab.Client, group string, archived bool) ([]*gitlab.Project, error) {
+	var projects []*gitlab.Project
+
+	opts := &gitlab.ListGroupProjectsOptions{
+		IncludeSubgroups: gitlab.Bool(true),
+		OrderBy:          gitlab.String("path"),
+		Sort:             gitlab.String("asc"),
+	}
+	if !archived {
+		opts.Archived = gitlab.Bool(false)
+	}
+	opts.PerPage = 100
+	for opts.Page = 1; ; opts.Page++ {
+		p, err := api.ListGroupProjects(client, group, opts)
+		if err != nil {
+			return nil, err
+		}
+		projects = append(projects, p...)
+		if len(p) < opts.PerPage {
+			break
+		}
+	}
+
+	return projects, nil
+}
+
+// ListAllDescendantGroups returns every subgroup of the group, at any depth
+func ListAllDescendantGroups(client *gitlab.Client, group string) ([]*gitlab.Group, error) {
+	var groups []*gitlab.Group
+
+	opts := &gitlab.ListDescendantGroupsOptions{}
+	opts.PerPage = 100
+	for opts.Page = 1; ; opts.Page++ {
+		g, err := api.ListDescendantGroups(client, group, opts)
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, g...)
+		if len(g) < opts.PerPage {
+			break
+		}
+	}
+
+	return groups, nil
+}
+
+type treeNode struct {
+	name     string
+	children map[string]*treeNode
+	projects []*gitlab.Project
+}
+
+func newTreeNode(name string) *treeNode {
+	return &treeNode{name: name, children: map[string]*treeNode{}}
+}
+
+// RenderTree renders the subgroups and projects of root as a tree.
+// Subgroups are listed before projects and both are sorted by path.
+func RenderTree(c *iostreams.ColorPalette, root string, groups []*gitlab.Group, projects []*gitlab.Project) string {
+	tree := newTreeNode(root)
+
+	nodeFor := func(fullPath string) *treeNode {
+		// GitLab paths are case-insensitive, so root may differ in case from the paths returned
+		rel := fullPath
+		if len(fullPath) >= len(root) && strings.EqualFold(fullPath[:len(root)], root) {
+			rel = strings.TrimPrefix(fullPath[len(root):], "/")
+		}
+		node := tree
+		if rel == "" {
+			return node
+		}
+		for _, part := range strings.Split(rel, "/") {
+			child, ok := node.children[part]
+			if !ok {
+				child = newTreeNode(part)
+				node.children[part] = child
+			}
+			node = child
+		}
+		return node
+	}
+
+	for _, g := range groups {
+		nodeFor(g.FullPath)
+	}
+	for _, p := range projects {
+		namespace := root
+		if p.Namespace != nil {
+			namespace = p.Namespace.FullPath
+		}
+		node := nodeFor(namespace)
+		node.projects = append(node.projects, p)
+	}
+
+	var b strings.Builder
+	b.WriteString(c.Bold(root) + "\n")
+	renderNode(&b, c, tree, "")
+	return b.String()
+}
+
+func renderNode(b *strings.Builder, c *iostreams.ColorPalette, node *treeNode, prefix string) {
+	names := make([]string, 0, len(node.children))
+	for name := range node.children {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	sort.SliceStable(node.projects, func(i, j int) bool {
+		return node.projects[i].Path < node.projects[j].Path
+	})
+
+	total := len(names) + len(node.projects)
+	i := 0
+	branch := func() (string, string) {
+		i++
+		if i == total {
+			return "└── ", "    "
+		}
+		return "├── ", "│   "
+	}
+
+	for _, name := range names {
+		connector, indent := branch()
+		b.WriteString(prefix + connector + c.Blue(name+"/") + "\n")
+		renderNode(b, c, node.children[name], prefix+indent)
+	}
+	for _, p := range node.projects {
+		connector, _ := branch()
+		line := p.Path
+		if p.Archived {
+			line += " " + c.Gray("(archived)")
+		}
+		b.WriteString(prefix + connector + line + "\n")
+	}
+}
@@ -0,0 +1,66 @@
+package grouputils
+
+import (
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/xanzy/go-gitlab"
+)
+
+func Test_RenderTree(t *testing.T) {
+	io, _, _, _ := iostreams.Test()
+
+	groups := []*gitlab.Group{
+		{FullPath: "acme/backend"},
+		{FullPath: "acme/backend/services"},
+		{FullPath: "acme/empty"},
+	}
+	projects := []*gitlab.Project{
+		{Path: "website", Namespace: &gitlab.ProjectNamespace{FullPath: "acme"}},
+		{Path: "docs", Namespace: &gitlab.ProjectNamespace{FullPath: "acme"}, Archived: true},
+		{Path: "worker", Namespace: &gitlab.ProjectNamespace{FullPath: "acme/backend/services"}},
+		{Path: "api", Namespace: &gitlab.ProjectNamespace{FullPath: "acme/backend/services"}},
+		{Path: "shared", Namespace: &gitlab.ProjectNamespace{FullPath: "acme/backend"}},
+	}
+
+	got := RenderTree(io.Color(), "acme", groups, projects)
+	assert.Equal(t, heredoc.Doc(`
+		acme
+		├── backend/
+		│   ├── services/
+		│   │   ├── api
+		│   │   └── worker
+		│   └── shared
+		├── empty/
+		├── docs (archived)
+		└── website
+	`), got)
+}
+
+func Test_RenderTree_rootCase(t *testing.T) {
+	io, _, _, _ := iostreams.Test()
+
+	groups := []*gitlab.Group{
+		{FullPath: "acme/backend"},
+	}
+	projects := []*gitlab.Project{
+		{Path: "website", Namespace: &gitlab.ProjectNamespace{FullPath: "acme"}},
+		{Path: "api", Namespace: &gitlab.ProjectNamespace{FullPath: "acme/backend"}},
+	}
+
+	got := RenderTree(io.Color(), "ACME", groups, projects)
+	assert.Equal(t, heredoc.Doc(`
+		ACME
+		├── backend/
+		│   └── api
+		└── website
+	`), got)
+}
+
+func Test_ResolveGroup(t *testing.T) {
+	group, err := ResolveGroup([]string{"acme/backend/"}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "acme/backend", group)
+}
@@ -0,0 +1,138 @@
+package list
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/profclems/glab/pkg/tableprinter"
+	"github.com/profclems/glab/pkg/text"
+	"github.com/profclems/glab/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type ListOpts struct {
+	HTTPClient func() (*gitlab.Client, error)
+	IO         *iostreams.IOStreams
+
+	Group       string
+	Search      string
+	Owned       bool
+	TopLevel    bool
+	Descendants bool
+	Page        int
+	PerPage     int
+}
+
+func NewCmdList(f *cmdutils.Factory, runE func(*ListOpts) error) *cobra.Command {
+	opts := &ListOpts{
+		IO: f.IO,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "list [<group>] [flags]",
+		Short: `List groups or the subgroups of a group`,
+		Long: heredoc.Doc(`
+			List the groups you are a member of.
+			When a group is given, its subgroups are listed instead.
+		`),
+		Aliases: []string{"ls"},
+		Example: heredoc.Doc(`
+			$ glab group list
+			$ glab group list --owned --top-level
+			$ glab group list mygroup
+			$ glab group list mygroup --all
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.HTTPClient = f.HttpClient
+			if len(args) > 0 {
+				opts.Group = args[0]
+			}
+
+			if opts.Group != "" && (opts.Owned || opts.TopLevel) {
+				return &cmdutils.FlagError{Err: fmt.Errorf("--owned and --top-level cannot be used when listing subgroups")}
+			}
+			if opts.Group == "" && opts.Descendants {
+				return &cmdutils.FlagError{Err: fmt.Errorf("--all requires a group")}
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return listRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Search, "search", "s", "", "Only list groups matching the search string")
+	cmd.Flags().BoolVarP(&opts.Owned, "owned", "o", false, "Only list groups you own")
+	cmd.Flags().BoolVarP(&opts.TopLevel, "top-level", "t", false, "Only list top level groups")
+	cmd.Flags().BoolVarP(&opts.Descendants, "all", "a", false, "List subgroups at any depth instead of direct subgroups only")
+	cmd.Flags().IntVarP(&opts.Page, "page", "p", 1, "Page number")
+	cmd.Flags().IntVarP(&opts.PerPage, "per-page", "P", 30, "Number of items to list per page")
+
+	return cmd
+}
+
+func listRun(opts *ListOpts) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	l := gitlab.ListGroupsOptions{}
+	l.Page = opts.Page
+	l.PerPage = opts.PerPage
+	if opts.Search != "" {
+		l.Search = gitlab.String(opts.Search)
+	}
+
+	var groups []*gitlab.Group
+	var kind, scope string
+	switch {
+	case opts.Group != "" && opts.Descendants:
+		kind, scope = "descendant group", " of "+opts.Group
+		descendantOpts := gitlab.ListDescendantGroupsOptions(l)
+		groups, err = api.ListDescendantGroups(apiClient, opts.Group, &descendantOpts)
+	case opts.Group != "":
+		kind, scope = "subgroup", " of "+opts.Group
+		subgroupOpts := gitlab.ListSubgroupsOptions(l)
+		groups, err = api.ListSubgroups(apiClient, opts.Group, &subgroupOpts)
+	default:
+		kind = "group"
+		if opts.Owned {
+			l.Owned = gitlab.Bool(true)
+		}
+		if opts.TopLevel {
+			l.TopLevelOnly = gitlab.Bool(true)
+		}
+		groups, err = api.ListGroups(apiClient, &l)
+	}
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to list groups")
+	}
+
+	if len(groups) == 0 {
+		fmt.Fprintf(opts.IO.StdOut, "No %ss%s found\n", kind, scope)
+		return nil
+	}
+
+	fmt.Fprintf(opts.IO.StdOut, "Showing %s%s (Page %d)\n\n%s\n", utils.Pluralize(len(groups), kind), scope, opts.Page, DisplayGroups(opts.IO, groups))
+	return nil
+}
+
+// DisplayGroups renders a table of groups with their visibility and description
+func DisplayGroups(streams *iostreams.IOStreams, groups []*gitlab.Group) string {
+	c := streams.Color()
+	table := tableprinter.NewTablePrinter()
+
+	for _, group := range groups {
+		table.AddRow(c.Blue(group.FullPath), c.Gray(string(group.Visibility)), text.Truncate(group.Description, 60))
+	}
+
+	return table.Render()
+}
@@ -0,0 +1,136 @@
+package projects
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/commands/group/grouputils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/profclems/glab/pkg/tableprinter"
+	"github.com/profclems/glab/pkg/text"
+	"github.com/profclems/glab/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type ProjectsOpts struct {
+	HTTPClient func() (*gitlab.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+
+	Group            string
+	Tree             bool
+	IncludeSubgroups bool
+	Archived         bool
+	Page             int
+	PerPage          int
+}
+
+func NewCmdProjects(f *cmdutils.Factory, runE func(*ProjectsOpts) error) *cobra.Command {
+	opts := &ProjectsOpts{
+		IO: f.IO,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "projects [<group>] [flags]",
+		Short: `List the projects of a group`,
+		Long: heredoc.Doc(`
+			List the projects of a group.
+
+			With --tree, all projects of the group and its subgroups are fetched and shown
+			as a tree that follows the subgroup hierarchy.
+			Without an explicit group argument, the namespace of the current repository is used.
+		`),
+		Example: heredoc.Doc(`
+			$ glab group projects mygroup
+			$ glab group projects mygroup --include-subgroups
+			$ glab group projects mygroup --tree
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var err error
+
+			opts.HTTPClient = f.HttpClient
+			opts.BaseRepo = f.BaseRepo
+
+			opts.Group, err = grouputils.ResolveGroup(args, opts.BaseRepo)
+			if err != nil {
+				return cmdutils.WrapError(err, "`group` is required when not running in a git repository")
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return projectsRun(opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.Tree, "tree", "t", false, "Show all projects of the group and its subgroups as a tree")
+	cmd.Flags().BoolVarP(&opts.IncludeSubgroups, "include-subgroups", "G", false, "Include projects of subgroups")
+	cmd.Flags().BoolVarP(&opts.Archived, "archived", "a", false, "Include archived projects")
+	cmd.Flags().IntVarP(&opts.Page, "page", "p", 1, "Page number")
+	cmd.Flags().IntVarP(&opts.PerPage, "per-page", "P", 30, "Number of items to list per page")
+
+	return cmd
+}
+
+func projectsRun(opts *ProjectsOpts) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	if opts.Tree {
+		groups, err := grouputils.ListAllDescendantGroups(apiClient, opts.Group)
+		if err != nil {
+			return cmdutils.WrapError(err, fmt.Sprintf("failed to list subgroups of %s", opts.Group))
+		}
+		projects, err := grouputils.ListAllProjects(apiClient, opts.Group, opts.Archived)
+		if err != nil {
+			return cmdutils.WrapError(err, fmt.Sprintf("failed to list projects of %s", opts.Group))
+		}
+
+		fmt.Fprint(opts.IO.StdOut, grouputils.RenderTree(opts.IO.Color(), opts.Group, groups, projects))
+		return nil
+	}
+
+	l := &gitlab.ListGroupProjectsOptions{
+		OrderBy: gitlab.String("path"),
+		Sort:    gitlab.String("asc"),
+	}
+	l.Page = opts.Page
+	l.PerPage = opts.PerPage
+	if opts.IncludeSubgroups {
+		l.IncludeSubgroups = gitlab.Bool(true)
+	}
+	if !opts.Archived {
+		l.Archived = gitlab.Bool(false)
+	}
+
+	projects, err := api.ListGroupProjects(apiClient, opts.Group, l)
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to list projects of %s", opts.Group))
+	}
+
+	if len(projects) == 0 {
+		fmt.Fprintf(opts.IO.StdOut, "No projects found in %s\n", opts.Group)
+		return nil
+	}
+
+	c := opts.IO.Color()
+	table := tableprinter.NewTablePrinter()
+	for _, p := range projects {
+		name := c.Blue(p.PathWithNamespace)
+		if p.Archived {
+			name += " " + c.Gray("(archived)")
+		}
+		table.AddRow(name, c.Gray(string(p.Visibility)), text.Truncate(p.Description, 60))
+	}
+
+	fmt.Fprintf(opts.IO.StdOut, "Showing %s in %s (Page %d)\n\n%s\n", utils.Pluralize(len(projects), "project"), opts.Group, opts.Page, table.Render())
+	return nil
+}
@@ -0,0 +1,106 @@
+package transfer
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type TransferOpts struct {
+	HTTPClient func() (*gitlab.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+
+	Group         string
+	Projects      []string
+	ForceTransfer bool
+}
+
+func NewCmdTransfer(f *cmdutils.Factory, runE func(*TransferOpts) error) *cobra.Command {
+	opts := &TransferOpts{
+		IO: f.IO,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "transfer <group> [<project>...] [flags]",
+		Short: `Transfer projects to another group`,
+		Long: heredoc.Doc(`
+			Move one or more projects into a group.
+			Without explicit projects, the current repository is transferred.
+
+			You need to be an owner of the projects and be allowed to create projects in the target group.
+			A transfer may not be undone: you can only move the projects back if you can create
+			projects in their original namespace.
+		`),
+		Example: heredoc.Doc(`
+			$ glab group transfer mygroup/archive
+			$ glab group transfer mygroup/backend oldgroup/api oldgroup/worker --yes
+		`),
+		Args: cmdutils.MinimumArgs(1, "no target group specified"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.HTTPClient = f.HttpClient
+			opts.BaseRepo = f.BaseRepo
+			opts.Group = strings.Trim(args[0], "/")
+			opts.Projects = args[1:]
+
+			if !opts.ForceTransfer && !opts.IO.PromptEnabled() {
+				return &cmdutils.FlagError{Err: errors.New("--yes or -y flag is required when not running interactively")}
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return transferRun(opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.ForceTransfer, "yes", "y", false, "Danger: Skip confirmation prompt and force transfer operation. Transfer cannot be undone.")
+
+	return cmd
+}
+
+func transferRun(opts *TransferOpts) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	if len(opts.Projects) == 0 {
+		repo, err := opts.BaseRepo()
+		if err != nil {
+			return cmdutils.WrapError(err, "`project` is required when not running in a git repository")
+		}
+		opts.Projects = []string{repo.FullName()}
+	}
+
+	cmdutils.PrintTransferWarning(opts.IO, opts.Projects, opts.Group)
+
+	if !opts.ForceTransfer {
+		if err = cmdutils.ConfirmTransfer(); err != nil {
+			return fmt.Errorf("unable to confirm: %w", err)
+		}
+	}
+
+	c := opts.IO.Color()
+	for _, p := range opts.Projects {
+		project, err := api.TransferProject(apiClient, p, &gitlab.TransferProjectOptions{
+			Namespace: opts.Group,
+		})
+		if err != nil {
+			return cmdutils.WrapError(err, fmt.Sprintf("failed to transfer %s to %s", p, opts.Group))
+		}
+
+		fmt.Fprintf(opts.IO.StdOut, "%s Transferred %s to %s\n", c.GreenCheck(), p, project.PathWithNamespace)
+	}
+
+	return nil
+}
@@ -0,0 +1,167 @@
+package view
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/commands/group/grouputils"
+	"github.com/profclems/glab/internal/config"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/glinstance"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/profclems/glab/pkg/tableprinter"
+	"github.com/profclems/glab/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type ViewOpts struct {
+	HTTPClient func() (*gitlab.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+	Config     func() (config.Config, error)
+
+	Group         string
+	OpenInBrowser bool
+}
+
+func NewCmdView(f *cmdutils.Factory, runE func(*ViewOpts) error) *cobra.Command {
+	opts := &ViewOpts{
+		IO:     f.IO,
+		Config: f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "view [<group>] [flags]",
+		Short: `View a group and its settings`,
+		Long: heredoc.Doc(`
+			Display the description and group-level settings of a group.
+
+			Without an explicit group argument, the namespace of the current repository is shown.
+		`),
+		Example: heredoc.Doc(`
+			$ glab group view
+			$ glab group view mygroup/subgroup
+			$ glab group view mygroup --web
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var err error
+
+			opts.HTTPClient = f.HttpClient
+			opts.BaseRepo = f.BaseRepo
+
+			opts.Group, err = grouputils.ResolveGroup(args, opts.BaseRepo)
+			if err != nil {
+				return cmdutils.WrapError(err, "`group` is required when not running in a git repository")
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return viewRun(opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.OpenInBrowser, "web", "w", false, "Open the group in the browser")
+
+	return cmd
+}
+
+func viewRun(opts *ViewOpts) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	group, err := api.GetGroup(apiClient, opts.Group)
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to get group %s", opts.Group))
+	}
+
+	if opts.OpenInBrowser {
+		if opts.IO.IsOutputTTY() {
+			opts.IO.Logf("Opening %s in your browser.\n", utils.DisplayURL(group.WebURL))
+		}
+
+		cfg, _ := opts.Config()
+		browser, _ := cfg.Get(glinstance.OverridableDefault(), "browser")
+		return utils.OpenInBrowser(group.WebURL, browser)
+	}
+
+	if !opts.IO.IsOutputTTY() {
+		fmt.Fprintf(opts.IO.StdOut, "name:\t%s\n", group.FullName)
+		fmt.Fprintf(opts.IO.StdOut, "path:\t%s\n", group.FullPath)
+		fmt.Fprintf(opts.IO.StdOut, "description:\t%s\n", group.Description)
+		for _, s := range Settings(group) {
+			fmt.Fprintf(opts.IO.StdOut, "%s:\t%s\n", s.Key, s.Value)
+		}
+		return nil
+	}
+
+	c := opts.IO.Color()
+	fmt.Fprintf(opts.IO.StdOut, "%s %s\n", c.Bold(group.FullName), c.Gray("("+group.FullPath+")"))
+	if group.Description != "" {
+		fmt.Fprintf(opts.IO.StdOut, "%s\n", group.Description)
+	} else {
+		fmt.Fprintln(opts.IO.StdOut, c.Gray("(No description provided)"))
+	}
+
+	table := tableprinter.NewTablePrinter()
+	for _, s := range Settings(group) {
+		table.AddRow(c.Gray(s.Label), s.Value)
+	}
+	fmt.Fprintf(opts.IO.StdOut, "\n%s\n%s\n", c.Bold("Settings"), table.Render())
+	fmt.Fprintf(opts.IO.StdOut, c.Gray("View this group on GitLab: %s\n"), group.WebURL)
+
+	return nil
+}
+
+// Setting is a single group-level setting
+type Setting struct {
+	Key   string
+	Label string
+	Value string
+}
+
+// Settings returns the group-level settings of a group in display order
+func Settings(group *gitlab.Group) []Setting {
+	twoFactor := strconv.FormatBool(group.RequireTwoFactorAuth)
+	if group.RequireTwoFactorAuth {
+		twoFactor += fmt.Sprintf(" (%s grace period)", utils.Pluralize(group.TwoFactorGracePeriod, "hour"))
+	}
+
+	return []Setting{
+		{"visibility", "Visibility", string(group.Visibility)},
+		{"project_creation_level", "Project creation", string(group.ProjectCreationLevel)},
+		{"subgroup_creation_level", "Subgroup creation", string(group.SubGroupCreationLevel)},
+		{"default_branch_protection", "Default branch protection", branchProtection(group.DefaultBranchProtection)},
+		{"request_access_enabled", "Request access", strconv.FormatBool(group.RequestAccessEnabled)},
+		{"membership_lock", "Membership lock", strconv.FormatBool(group.MembershipLock)},
+		{"share_with_group_lock", "Share with group lock", strconv.FormatBool(group.ShareWithGroupLock)},
+		{"require_two_factor_authentication", "Require two-factor authentication", twoFactor},
+		{"lfs_enabled", "LFS", strconv.FormatBool(group.LFSEnabled)},
+		{"auto_devops_enabled", "Auto DevOps", strconv.FormatBool(group.AutoDevopsEnabled)},
+		{"emails_disabled", "Emails disabled", strconv.FormatBool(group.EmailsDisabled)},
+		{"mentions_disabled", "Mentions disabled", strconv.FormatBool(group.MentionsDisabled)},
+	}
+}
+
+func branchProtection(level int) string {
+	switch level {
+	case 0:
+		return "not protected"
+	case 1:
+		return "developers and maintainers can push"
+	case 2:
+		return "fully protected"
+	case 3:
+		return "maintainers can push"
+	default:
+		return strconv.Itoa(level)
+	}
+}
@@ -3,6 +3,10 @@ package board
 import (
 	"github.com/profclems/glab/commands/cmdutils"
 	boardCreateCmd "github.com/profclems/glab/commands/issue/board/create"
+	boardCreateListCmd "github.com/profclems/glab/commands/issue/board/createlist"
+	boardDeleteListCmd "github.com/profclems/glab/commands/issue/board/deletelist"
+	boardListCmd "github.com/profclems/glab/commands/issue/board/list"
+	boardMoveCmd "github.com/profclems/glab/commands/issue/board/move"
 	boardViewCmd "github.com/profclems/glab/commands/issue/board/view"
 	"github.com/spf13/cobra"
 )
@@ -16,6 +20,10 @@ func NewCmdBoard(f *cmdutils.Factory) *cobra.Command {
 
 	issueCmd.AddCommand(boardCreateCmd.NewCmdCreate(f))
 	issueCmd.AddCommand(boardViewCmd.NewCmdView(f))
+	issueCmd.AddCommand(boardListCmd.NewCmdList(f, nil))
+	issueCmd.AddCommand(boardMoveCmd.NewCmdMove(f, nil))
+	issueCmd.AddCommand(boardCreateListCmd.NewCmdCreateList(f, nil))
+	issueCmd.AddCommand(boardDeleteListCmd.NewCmdDeleteList(f, nil))
 	issueCmd.PersistentFlags().StringP("repo", "R", "", "Select another repository using the OWNER/REPO format or the project ID. Supports group namespaces")
 
 	return issueCmd
@@ -0,0 +1,316 @@
+package boardutils
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/profclems/glab/api"
+	"github.com/xanzy/go-gitlab"
+)
+
+// ListKind is the kind of issues a board list contains
+type ListKind int
+
+const (
+	// KindOpen contains the open issues which are not in any other list
+	KindOpen ListKind = iota
+	KindLabel
+	KindAssignee
+	KindMilestone
+	// KindClosed contains the closed issues
+	KindClosed
+)
+
+// maxIssuePages limits the number of pages of 100 issues fetched for a board
+const maxIssuePages = 10
+
+// List is a column of an issue board
+type List struct {
+	// ID is zero for the open and closed lists which are not returned by the API
+	ID        int
+	Kind      ListKind
+	Label     *gitlab.Label
+	Assignee  *gitlab.BasicUser
+	Milestone *gitlab.Milestone
+	Issues    []*gitlab.Issue
+}
+
+// Title returns the name shown for the list on the board
+func (l *List) Title() string {
+	switch l.Kind {
+	case KindLabel:
+		return l.Label.Name
+	case KindAssignee:
+		return "@" + l.Assignee.Username
+	case KindMilestone:
+		return l.Milestone.Title
+	case KindClosed:
+		return "Closed"
+	default:
+		return "Open"
+	}
+}
+
+// Color returns the label color of label lists and an empty string for other lists
+func (l *List) Color() string {
+	if l.Kind == KindLabel {
+		return l.Label.Color
+	}
+	return ""
+}
+
+func (l *List) matches(issue *gitlab.Issue) bool {
+	switch l.Kind {
+	case KindLabel:
+		for _, label := range issue.Labels {
+			if label == l.Label.Name {
+				return true
+			}
+		}
+	case KindAssignee:
+		for _, a := range issue.Assignees {
+			if a.ID == l.Assignee.ID {
+				return true
+			}
+		}
+	case KindMilestone:
+		return issue.Milestone != nil && issue.Milestone.ID == l.Milestone.ID
+	}
+	return false
+}
+
+// Filter limits the issues shown on a board
+type Filter struct {
+	Assignee string
+	Labels   []string
+}
+
+// SelectBoard returns the board matching the name or ID. When no board is given, the only
+// board of the project is returned, or the user is asked to pick one if promptEnabled is true.
+func SelectBoard(client *gitlab.Client, project string, board string, promptEnabled bool) (*gitlab.IssueBoard, error) {
+	boards, err := api.ListIssueBoards(client, project, &gitlab.ListIssueBoardsOptions{PerPage: 100})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list issue boards: %w", err)
+	}
+	if len(boards) == 0 {
+		return nil, fmt.Errorf("%s has no issue boards. Create one with `glab issue board create`", project)
+	}
+
+	names := make([]string, len(boards))
+	for i, b := range boards {
+		names[i] = b.Name
+	}
+
+	if board != "" {
+		for _, b := range boards {
+			if strings.EqualFold(b.Name, board) || strconv.Itoa(b.ID) == board {
+				return b, nil
+			}
+		}
+		return nil, fmt.Errorf("no board named %q. Available boards: %s", board, strings.Join(names, ", "))
+	}
+
+	if len(boards) == 1 {
+		return boards[0], nil
+	}
+	if !promptEnabled {
+		return nil, fmt.Errorf("%s has %d boards. Specify one with --board: %s", project, len(boards), strings.Join(names, ", "))
+	}
+
+	var selected int
+	err = survey.AskOne(&survey.Select{
+		Message: "Select Board:",
+		Options: names,
+	}, &selected)
+	if err != nil {
+		return nil, err
+	}
+	return boards[selected], nil
+}
+
+// LoadBoard fetches the lists of the board along with the issues in each list
+func LoadBoard(client *gitlab.Client, project string, board *gitlab.IssueBoard, filter Filter) ([]*List, error) {
+	boardLists, err := api.ListBoardLists(client, project, board.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get board lists: %w", err)
+	}
+
+	// the board only shows the issues with all of the labels it is scoped to
+	scope, err := api.GetIssueBoard(client, project, board.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get board: %w", err)
+	}
+
+	opts := &gitlab.ListProjectIssuesOptions{}
+	opts.PerPage = 100
+	if filter.Assignee != "" {
+		opts.AssigneeUsername = gitlab.String(strings.TrimPrefix(filter.Assignee, "@"))
+	}
+	labels := append([]string{}, filter.Labels...)
+	for _, label := range scope.Labels {
+		labels = append(labels, label.Name)
+	}
+	if len(labels) > 0 {
+		opts.Labels = labels
+	}
+	// a board scoped to a milestone only shows the issues of that milestone
+	if board.Milestone != nil {
+		opts.Milestone = gitlab.String(board.Milestone.Title)
+	}
+
+	listIssues := func(state string) ([]*gitlab.Issue, error) {
+		opts.State = gitlab.String(state)
+		var all []*gitlab.Issue
+		for opts.Page = 1; opts.Page <= maxIssuePages; opts.Page++ {
+			issues, err := api.ListIssues(client, project, opts)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list issues: %w", err)
+			}
+			all = append(all, issues...)
+			if len(issues) < opts.PerPage {
+				break
+			}
+		}
+		return all, nil
+	}
+
+	open, err := listIssues("opened")
+	if err != nil {
+		return nil, err
+	}
+	// the most recently updated closed issues are shown first
+	opts.OrderBy = gitlab.String("updated_at")
+	closed, err := listIssues("closed")
+	if err != nil {
+		return nil, err
+	}
+
+	return Distribute(boardLists, open, closed), nil
+}
+
+// Distribute builds the lists of a board: the open list, the board lists ordered by their
+// position and the closed list. An open issue appears in every board list it matches and
+// in the open list when it matches none of them.
+func Distribute(boardLists []*api.BoardList, open, closed []*gitlab.Issue) []*List {
+	sorted := make([]*api.BoardList, len(boardLists))
+	copy(sorted, boardLists)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Position < sorted[j].Position
+	})
+
+	openList := &List{Kind: KindOpen}
+	lists := []*List{openList}
+	for _, bl := range sorted {
+		list := &List{ID: bl.ID, Label: bl.Label, Assignee: bl.Assignee, Milestone: bl.Milestone}
+		switch {
+		case bl.Label != nil:
+			list.Kind = KindLabel
+		case bl.Assignee != nil:
+			list.Kind = KindAssignee
+		case bl.Milestone != nil:
+			list.Kind = KindMilestone
+		default:
+			// unsupported list types such as iteration lists
+			continue
+		}
+		lists = append(lists, list)
+	}
+
+	for _, issue := range open {
+		matched := false
+		for _, list := range lists[1:] {
+			if list.matches(issue) {
+				list.Issues = append(list.Issues, issue)
+				matched = true
+			}
+		}
+		if !matched {
+			openList.Issues = append(openList.Issues, issue)
+		}
+	}
+
+	lists = append(lists, &List{Kind: KindClosed, Issues: closed})
+	return lists
+}
+
+// MoveOptions returns the changes to the issue needed to move it from one list to another,
+// the same way moving a card on the board in the GitLab UI does: the label, assignee or
+// milestone of the source list is removed and the one of the destination list is added.
+// Moving to and from the closed list closes and reopens the issue.
+func MoveOptions(issue *gitlab.Issue, from, to *List) (*gitlab.UpdateIssueOptions, error) {
+	if from == to || (from.ID != 0 && from.ID == to.ID) || (from.ID == 0 && to.ID == 0 && from.Kind == to.Kind) {
+		return nil, errors.New("the issue is already in this list")
+	}
+
+	opts := &gitlab.UpdateIssueOptions{}
+	assignees := map[int]bool{}
+	for _, a := range issue.Assignees {
+		assignees[a.ID] = true
+	}
+	assigneesChanged := false
+
+	switch from.Kind {
+	case KindLabel:
+		opts.RemoveLabels = gitlab.Labels{from.Label.Name}
+	case KindAssignee:
+		delete(assignees, from.Assignee.ID)
+		assigneesChanged = true
+	case KindMilestone:
+		opts.MilestoneID = gitlab.Int(0)
+	case KindClosed:
+		opts.StateEvent = gitlab.String("reopen")
+	}
+
+	switch to.Kind {
+	case KindLabel:
+		opts.AddLabels = gitlab.Labels{to.Label.Name}
+	case KindAssignee:
+		assignees[to.Assignee.ID] = true
+		assigneesChanged = true
+	case KindMilestone:
+		opts.MilestoneID = gitlab.Int(to.Milestone.ID)
+	case KindClosed:
+		opts.StateEvent = gitlab.String("close")
+	}
+
+	if assigneesChanged {
+		opts.AssigneeIDs = make([]int, 0, len(assignees))
+		for id := range assignees {
+			opts.AssigneeIDs = append(opts.AssigneeIDs, id)
+		}
+		sort.Ints(opts.AssigneeIDs)
+		// an assignee ID of 0 unassigns all users
+		if len(opts.AssigneeIDs) == 0 {
+			opts.AssigneeIDs = []int{0}
+		}
+	}
+
+	return opts, nil
+}
+
+// MoveIssue updates the issue to move it from one list to another
+func MoveIssue(client *gitlab.Client, project string, issue *gitlab.Issue, from, to *List) (*gitlab.Issue, error) {
+	opts, err := MoveOptions(issue, from, to)
+	if err != nil {
+		return nil, err
+	}
+	return api.UpdateIssue(client, project, issue.IID, opts)
+}
+
+// FindList returns the list with the given title or ID
+func FindList(lists []*List, list string) (*List, error) {
+	for _, l := range lists {
+		if strings.EqualFold(l.Title(), list) || strings.EqualFold(strings.TrimPrefix(l.Title(), "@"), list) || (l.ID != 0 && strconv.Itoa(l.ID) == list) {
+			return l, nil
+		}
+	}
+	titles := make([]string, len(lists))
+	for i, l := range lists {
+		titles[i] = l.Title()
+	}
+	return nil, fmt.Errorf("no list named %q. Available lists: %s", list, strings.Join(titles, ", "))
+}
@@ -0,0 +1,166 @@
+package boardutils
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/pkg/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xanzy/go-gitlab"
+)
+
+func testBoard() []*List {
+	boardLists := []*api.BoardList{
+		{ID: 3, Position: 2, Milestone: &gitlab.Milestone{ID: 7, Title: "v1.0"}},
+		{ID: 1, Position: 0, Label: &gitlab.Label{Name: "To Do", Color: "#ff0000"}},
+		{ID: 2, Position: 1, Assignee: &gitlab.BasicUser{ID: 5, Username: "jane"}},
+	}
+	open := []*gitlab.Issue{
+		{IID: 1, Labels: gitlab.Labels{"To Do"}},
+		{IID: 2, Assignees: []*gitlab.IssueAssignee{{ID: 5, Username: "jane"}, {ID: 6, Username: "john"}}},
+		{IID: 3, Milestone: &gitlab.Milestone{ID: 7}, Labels: gitlab.Labels{"To Do"}},
+		{IID: 4, Labels: gitlab.Labels{"bug"}},
+	}
+	closed := []*gitlab.Issue{{IID: 5, State: "closed"}}
+
+	return Distribute(boardLists, open, closed)
+}
+
+func iids(list *List) []int {
+	var ids []int
+	for _, issue := range list.Issues {
+		ids = append(ids, issue.IID)
+	}
+	return ids
+}
+
+func Test_Distribute(t *testing.T) {
+	lists := testBoard()
+
+	require.Len(t, lists, 5)
+	var titles []string
+	for _, l := range lists {
+		titles = append(titles, l.Title())
+	}
+	assert.Equal(t, []string{"Open", "To Do", "@jane", "v1.0", "Closed"}, titles)
+
+	assert.Equal(t, []int{4}, iids(lists[0]))
+	assert.Equal(t, []int{1, 3}, iids(lists[1]))
+	assert.Equal(t, []int{2}, iids(lists[2]))
+	assert.Equal(t, []int{3}, iids(lists[3]))
+	assert.Equal(t, []int{5}, iids(lists[4]))
+	assert.Equal(t, "#ff0000", lists[1].Color())
+}
+
+func Test_MoveOptions(t *testing.T) {
+	lists := testBoard()
+	open, todo, jane, milestone, closed := lists[0], lists[1], lists[2], lists[3], lists[4]
+
+	tests := []struct {
+		name     string
+		issue    *gitlab.Issue
+		from, to *List
+		want     *gitlab.UpdateIssueOptions
+	}{
+		{
+			name:  "open to label",
+			issue: open.Issues[0],
+			from:  open, to: todo,
+			want: &gitlab.UpdateIssueOptions{AddLabels: gitlab.Labels{"To Do"}},
+		},
+		{
+			name:  "label to assignee",
+			issue: todo.Issues[0],
+			from:  todo, to: jane,
+			want: &gitlab.UpdateIssueOptions{RemoveLabels: gitlab.Labels{"To Do"}, AssigneeIDs: []int{5}},
+		},
+		{
+			name:  "assignee to open keeps other assignees",
+			issue: jane.Issues[0],
+			from:  jane, to: open,
+			want: &gitlab.UpdateIssueOptions{AssigneeIDs: []int{6}},
+		},
+		{
+			name:  "milestone to closed",
+			issue: milestone.Issues[0],
+			from:  milestone, to: closed,
+			want: &gitlab.UpdateIssueOptions{MilestoneID: gitlab.Int(0), StateEvent: gitlab.String("close")},
+		},
+		{
+			name:  "closed to milestone",
+			issue: closed.Issues[0],
+			from:  closed, to: milestone,
+			want: &gitlab.UpdateIssueOptions{MilestoneID: gitlab.Int(7), StateEvent: gitlab.String("reopen")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MoveOptions(tt.issue, tt.from, tt.to)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err := MoveOptions(open.Issues[0], open, open)
+	assert.EqualError(t, err, "the issue is already in this list")
+
+	single := &gitlab.Issue{IID: 9, Assignees: []*gitlab.IssueAssignee{{ID: 5}}}
+	got, err := MoveOptions(single, jane, open)
+	require.NoError(t, err)
+	assert.Equal(t, []int{0}, got.AssigneeIDs)
+}
+
+func Test_FindList(t *testing.T) {
+	lists := testBoard()
+
+	for _, name := range []string{"to do", "jane", "@jane", "3", "closed"} {
+		_, err := FindList(lists, name)
+		assert.NoError(t, err, name)
+	}
+
+	_, err := FindList(lists, "Doing")
+	assert.EqualError(t, err, `no list named "Doing". Available lists: Open, To Do, @jane, v1.0, Closed`)
+}
+
+func Test_LoadBoard(t *testing.T) {
+	reg := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+	defer reg.Verify(t)
+
+	issues := func(state string, from, to int) string {
+		var parts []string
+		for iid := from; iid <= to; iid++ {
+			parts = append(parts, fmt.Sprintf(`{"id": %d, "iid": %d, "state": %q, "labels": ["backend", "bug"]}`, iid, iid, state))
+		}
+		return "[" + strings.Join(parts, ",") + "]"
+	}
+
+	reg.RegisterResponder("GET", "/api/v4/projects/owner/repo/boards/1/lists",
+		httpmock.NewStringResponse(200, `[{"id": 3, "position": 0, "label": {"name": "doing"}}]`))
+	reg.RegisterResponder("GET", "/api/v4/projects/owner/repo/boards/1",
+		httpmock.NewStringResponse(200, `{"id": 1, "name": "Backend", "labels": [{"name": "backend"}]}`))
+	reg.RegisterResponder("GET", "/api/v4/projects/owner/repo/issues?labels=bug%2Cbackend&page=1&per_page=100&state=opened",
+		httpmock.NewStringResponse(200, issues("opened", 1, 2)))
+	reg.RegisterResponder("GET", "/api/v4/projects/owner/repo/issues?labels=bug%2Cbackend&order_by=updated_at&page=1&per_page=100&state=closed",
+		httpmock.NewStringResponse(200, issues("closed", 101, 200)))
+	reg.RegisterResponder("GET", "/api/v4/projects/owner/repo/issues?labels=bug%2Cbackend&order_by=updated_at&page=2&per_page=100&state=closed",
+		httpmock.NewStringResponse(200, issues("closed", 201, 201)))
+
+	httpClient := func() *gitlab.Client {
+		a, _ := api.TestClient(&http.Client{Transport: reg}, "", "gitlab.com", false)
+		return a.Lab()
+	}
+	_ = httpClient()
+
+	lists, err := LoadBoard(httpClient(), "owner/repo", &gitlab.IssueBoard{ID: 1}, Filter{Labels: []string{"bug"}})
+	require.NoError(t, err)
+
+	require.Len(t, lists, 3)
+	assert.Equal(t, []int{1, 2}, iids(lists[0]))
+	assert.Empty(t, lists[1].Issues)
+	assert.Len(t, lists[2].Issues, 101)
+}
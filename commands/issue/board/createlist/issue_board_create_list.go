@@ -0,0 +1,125 @@
+package createlist
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/commands/issue/board/boardutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type CreateListOpts struct {
+	HTTPClient func() (*gitlab.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+
+	Board     string
+	Label     string
+	Assignee  string
+	Milestone string
+}
+
+func NewCmdCreateList(f *cmdutils.Factory, runE func(*CreateListOpts) error) *cobra.Command {
+	opts := &CreateListOpts{
+		IO: f.IO,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "create-list [flags]",
+		Short: `Add a label, assignee or milestone list to an issue board`,
+		Long: heredoc.Doc(`
+			Add a list to an issue board. A list shows the open issues with a label, assigned to a user
+			or in a milestone. Assignee and milestone lists require GitLab Premium.
+		`),
+		Example: heredoc.Doc(`
+			$ glab issue board create-list --label "In Progress"
+			$ glab issue board create-list --board Development --assignee johndoe
+			$ glab issue board create-list --milestone v1.0
+		`),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.HTTPClient = f.HttpClient
+			opts.BaseRepo = f.BaseRepo
+
+			set := 0
+			for _, v := range []string{opts.Label, opts.Assignee, opts.Milestone} {
+				if v != "" {
+					set++
+				}
+			}
+			if set != 1 {
+				return &cmdutils.FlagError{Err: errors.New("specify exactly one of --label, --assignee or --milestone")}
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return createListRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Board, "board", "b", "", "Name or ID of the board")
+	cmd.Flags().StringVarP(&opts.Label, "label", "l", "", "Create a list for the issues with this label")
+	cmd.Flags().StringVarP(&opts.Assignee, "assignee", "a", "", "Create a list for the issues assigned to this user")
+	cmd.Flags().StringVarP(&opts.Milestone, "milestone", "m", "", "Create a list for the issues in this milestone")
+
+	return cmd
+}
+
+func createListRun(opts *CreateListOpts) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	board, err := boardutils.SelectBoard(apiClient, repo.FullName(), opts.Board, opts.IO.PromptEnabled())
+	if err != nil {
+		return err
+	}
+
+	listOpts := &api.CreateBoardListOptions{}
+	switch {
+	case opts.Label != "":
+		label, err := api.GetLabel(apiClient, repo.FullName(), opts.Label)
+		if err != nil {
+			return cmdutils.WrapError(err, fmt.Sprintf("failed to find label %q", opts.Label))
+		}
+		listOpts.LabelID = gitlab.Int(label.ID)
+	case opts.Assignee != "":
+		user, err := api.UserByName(apiClient, strings.TrimPrefix(opts.Assignee, "@"))
+		if err != nil {
+			return cmdutils.WrapError(err, fmt.Sprintf("failed to find user %q", opts.Assignee))
+		}
+		listOpts.AssigneeID = gitlab.Int(user.ID)
+	default:
+		milestoneID, err := cmdutils.ParseMilestone(apiClient, repo, opts.Milestone)
+		if err != nil {
+			return err
+		}
+		listOpts.MilestoneID = gitlab.Int(milestoneID)
+	}
+
+	list, err := api.CreateBoardList(apiClient, repo.FullName(), board.ID, listOpts)
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to create board list")
+	}
+
+	title := opts.Label + opts.Assignee + opts.Milestone
+	c := opts.IO.Color()
+	fmt.Fprintf(opts.IO.StdOut, "%s Added list %s (ID %d) to board %s\n", c.GreenCheck(), title, list.ID, board.Name)
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package deletelist
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/commands/issue/board/boardutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/profclems/glab/pkg/prompt"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type DeleteListOpts struct {
+	HTTPClient func() (*gitlab.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+
+	List        string
+	Board       string
+	ForceDelete bool
+}
+
+func NewCmdDeleteList(f *cmdutils.Factory, runE func(*DeleteListOpts) error) *cobra.Command {
+	opts := &DeleteListOpts{
+		IO: f.IO,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "delete-list <list> [flags]",
+		Short: `Remove a list from an issue board`,
+		Long: heredoc.Doc(`
+			Remove a list, specified by its title or ID, from an issue board.
+			The issues in the list and its label, assignee or milestone are not changed.
+		`),
+		Example: heredoc.Doc(`
+			$ glab issue board delete-list "In Progress"
+			$ glab issue board delete-list 12 --board Development -y
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.HTTPClient = f.HttpClient
+			opts.BaseRepo = f.BaseRepo
+			opts.List = args[0]
+
+			if !opts.ForceDelete && !opts.IO.PromptEnabled() {
+				return &cmdutils.FlagError{Err: errors.New("--yes or -y flag is required when not running interactively")}
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return deleteListRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Board, "board", "b", "", "Name or ID of the board")
+	cmd.Flags().BoolVarP(&opts.ForceDelete, "yes", "y", false, "Skip the confirmation prompt")
+
+	return cmd
+}
+
+func deleteListRun(opts *DeleteListOpts) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	board, err := boardutils.SelectBoard(apiClient, repo.FullName(), opts.Board, opts.IO.PromptEnabled())
+	if err != nil {
+		return err
+	}
+
+	boardLists, err := api.ListBoardLists(apiClient, repo.FullName(), board.ID)
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to get board lists")
+	}
+	list, err := boardutils.FindList(boardutils.Distribute(boardLists, nil, nil), opts.List)
+	if err != nil {
+		return err
+	}
+	if list.ID == 0 {
+		return fmt.Errorf("the %s list cannot be removed", list.Title())
+	}
+
+	if !opts.ForceDelete && opts.IO.PromptEnabled() {
+		err = prompt.Confirm(&opts.ForceDelete, fmt.Sprintf("Remove list %s from board %s?", list.Title(), board.Name), false)
+		if err != nil {
+			return cmdutils.WrapError(err, "could not prompt")
+		}
+	}
+
+	if !opts.ForceDelete {
+		return cmdutils.CancelError()
+	}
+
+	err = api.DeleteBoardList(apiClient, repo.FullName(), board.ID, list.ID)
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to remove board list")
+	}
+
+	c := opts.IO.Color()
+	fmt.Fprintf(opts.IO.StdOut, "%s Removed list %s from board %s\n", c.RedCheck(), list.Title(), board.Name)
+
+	return nil
+}
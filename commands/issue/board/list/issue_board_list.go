@@ -0,0 +1,163 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/commands/issue/board/boardutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/profclems/glab/pkg/tableprinter"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type ListOpts struct {
+	HTTPClient func() (*gitlab.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+
+	Board        string
+	Assignee     string
+	Labels       []string
+	OutputFormat string
+}
+
+type jsonIssue struct {
+	IID       int      `json:"iid"`
+	Title     string   `json:"title"`
+	State     string   `json:"state"`
+	Assignees []string `json:"assignees"`
+	Labels    []string `json:"labels"`
+	WebURL    string   `json:"web_url"`
+}
+
+type jsonList struct {
+	ID     int          `json:"id,omitempty"`
+	Title  string       `json:"title"`
+	Issues []*jsonIssue `json:"issues"`
+}
+
+func NewCmdList(f *cmdutils.Factory, runE func(*ListOpts) error) *cobra.Command {
+	opts := &ListOpts{
+		IO: f.IO,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "list [flags]",
+		Short: `Print the lists and issues of an issue board`,
+		Long: heredoc.Doc(`
+			Print the issues of each list of an issue board without the interactive view,
+			one issue per line, for use in scripts.
+
+			The board is chosen with --board when the project has more than one board.
+		`),
+		Aliases: []string{"ls"},
+		Example: heredoc.Doc(`
+			$ glab issue board list
+			$ glab issue board list --board Development --assignee johndoe
+			$ glab issue board list --label backend --output json
+		`),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.HTTPClient = f.HttpClient
+			opts.BaseRepo = f.BaseRepo
+
+			if opts.OutputFormat != "text" && opts.OutputFormat != "json" {
+				return &cmdutils.FlagError{Err: fmt.Errorf("invalid output format %q. Must be text or json", opts.OutputFormat)}
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return listRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Board, "board", "b", "", "Name or ID of the board")
+	cmd.Flags().StringVarP(&opts.Assignee, "assignee", "a", "", "Only show issues assigned to this user")
+	cmd.Flags().StringSliceVarP(&opts.Labels, "label", "l", []string{}, "Only show issues with these labels")
+	cmd.Flags().StringVarP(&opts.OutputFormat, "output", "F", "text", "Format output as: text, json")
+
+	return cmd
+}
+
+func listRun(opts *ListOpts) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	board, err := boardutils.SelectBoard(apiClient, repo.FullName(), opts.Board, opts.IO.PromptEnabled())
+	if err != nil {
+		return err
+	}
+
+	lists, err := boardutils.LoadBoard(apiClient, repo.FullName(), board, boardutils.Filter{
+		Assignee: opts.Assignee,
+		Labels:   opts.Labels,
+	})
+	if err != nil {
+		return err
+	}
+
+	if opts.OutputFormat == "json" {
+		out := make([]*jsonList, 0, len(lists))
+		for _, list := range lists {
+			jl := &jsonList{ID: list.ID, Title: list.Title(), Issues: []*jsonIssue{}}
+			for _, issue := range list.Issues {
+				jl.Issues = append(jl.Issues, &jsonIssue{
+					IID:       issue.IID,
+					Title:     issue.Title,
+					State:     issue.State,
+					Assignees: assigneeNames(issue),
+					Labels:    issue.Labels,
+					WebURL:    issue.WebURL,
+				})
+			}
+			out = append(out, jl)
+		}
+		b, err := json.MarshalIndent(out, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(opts.IO.StdOut, string(b))
+		return nil
+	}
+
+	c := opts.IO.Color()
+	table := tableprinter.NewTablePrinter()
+	if opts.IO.IsOutputTTY() {
+		table.AddRow("LIST", "ISSUE", "TITLE", "ASSIGNEES", "LABELS")
+	}
+	for _, list := range lists {
+		for _, issue := range list.Issues {
+			table.AddRow(list.Title(), c.Green(fmt.Sprintf("#%d", issue.IID)), issue.Title,
+				strings.Join(assigneeNames(issue), ", "), c.Cyan(strings.Join(issue.Labels, ", ")))
+		}
+	}
+
+	if opts.IO.IsOutputTTY() {
+		fmt.Fprintf(opts.IO.StdOut, "%s • %s\n\n", board.Name, repo.FullName())
+	}
+	fmt.Fprint(opts.IO.StdOut, table.Render())
+
+	return nil
+}
+
+func assigneeNames(issue *gitlab.Issue) []string {
+	names := make([]string, 0, len(issue.Assignees))
+	for _, a := range issue.Assignees {
+		names = append(names, a.Username)
+	}
+	return names
+}
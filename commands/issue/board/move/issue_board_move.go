@@ -0,0 +1,155 @@
+package move
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/commands/issue/board/boardutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/profclems/glab/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type MoveOpts struct {
+	HTTPClient func() (*gitlab.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+
+	IssueID int
+	To      string
+	From    string
+	Board   string
+}
+
+func NewCmdMove(f *cmdutils.Factory, runE func(*MoveOpts) error) *cobra.Command {
+	opts := &MoveOpts{
+		IO: f.IO,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "move <issue> <list> [flags]",
+		Short: `Move an issue to another list of an issue board`,
+		Long: heredoc.Doc(`
+			Move an issue to another list of an issue board, like dragging it on the board in the web UI.
+
+			The label, assignee or milestone of the list the issue is moved from is removed and the
+			one of the destination list is added. Moving to the Closed list closes the issue and
+			moving out of it reopens the issue.
+
+			Use --from when the issue is in more than one list.
+		`),
+		Example: heredoc.Doc(`
+			$ glab issue board move 42 "In Progress"
+			$ glab issue board move 42 Closed
+			$ glab issue board move 42 Review --from Doing --board Development
+		`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.HTTPClient = f.HttpClient
+			opts.BaseRepo = f.BaseRepo
+			opts.To = args[1]
+
+			opts.IssueID = utils.StringToInt(strings.TrimPrefix(args[0], "#"))
+			if opts.IssueID == 0 {
+				return &cmdutils.FlagError{Err: fmt.Errorf("invalid issue %q", args[0])}
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return moveRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Board, "board", "b", "", "Name or ID of the board")
+	cmd.Flags().StringVarP(&opts.From, "from", "", "", "List to move the issue from")
+
+	return cmd
+}
+
+func moveRun(opts *MoveOpts) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	board, err := boardutils.SelectBoard(apiClient, repo.FullName(), opts.Board, opts.IO.PromptEnabled())
+	if err != nil {
+		return err
+	}
+
+	lists, err := boardutils.LoadBoard(apiClient, repo.FullName(), board, boardutils.Filter{})
+	if err != nil {
+		return err
+	}
+
+	to, err := boardutils.FindList(lists, opts.To)
+	if err != nil {
+		return err
+	}
+
+	var from *boardutils.List
+	var issue *gitlab.Issue
+	if opts.From != "" {
+		from, err = boardutils.FindList(lists, opts.From)
+		if err != nil {
+			return err
+		}
+		issue = findIssue(from, opts.IssueID)
+		if issue == nil {
+			return fmt.Errorf("issue #%d is not in the %s list", opts.IssueID, from.Title())
+		}
+	} else {
+		var found []*boardutils.List
+		for _, list := range lists {
+			if i := findIssue(list, opts.IssueID); i != nil && list != to {
+				found = append(found, list)
+				issue = i
+			}
+		}
+		switch len(found) {
+		case 0:
+			if findIssue(to, opts.IssueID) != nil {
+				return fmt.Errorf("issue #%d is already in the %s list", opts.IssueID, to.Title())
+			}
+			return fmt.Errorf("issue #%d is not on board %s", opts.IssueID, board.Name)
+		case 1:
+			from = found[0]
+		default:
+			titles := make([]string, len(found))
+			for i, l := range found {
+				titles[i] = l.Title()
+			}
+			return fmt.Errorf("issue #%d is in more than one list: %s. Specify the list to move it from with --from", opts.IssueID, strings.Join(titles, ", "))
+		}
+	}
+
+	_, err = boardutils.MoveIssue(apiClient, repo.FullName(), issue, from, to)
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to move issue #%d", opts.IssueID))
+	}
+
+	c := opts.IO.Color()
+	fmt.Fprintf(opts.IO.StdOut, "%s Moved issue #%d from %s to %s\n", c.GreenCheck(), opts.IssueID, from.Title(), to.Title())
+
+	return nil
+}
+
+func findIssue(list *boardutils.List, iid int) *gitlab.Issue {
+	for _, issue := range list.Issues {
+		if issue.IID == iid {
+			return issue
+		}
+	}
+	return nil
+}
@@ -4,114 +4,101 @@ import (
 	"fmt"
 	"log"
 	"runtime/debug"
+	"strings"
 
-	"github.com/AlecAivazis/survey/v2"
+	"github.com/MakeNowJust/heredoc"
 	"github.com/gdamore/tcell/v2"
 	"github.com/profclems/glab/api"
 	"github.com/profclems/glab/commands/cmdutils"
-	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/commands/issue/board/boardutils"
 	"github.com/rivo/tview"
 	"github.com/spf13/cobra"
 	"github.com/xanzy/go-gitlab"
 )
 
-var (
-	apiClient     *gitlab.Client
-	project       *gitlab.Project
-	repo          glrepo.Interface
-	selectedBoard string
-)
+const keyHelp = "[yellow]←/→[white] switch list  [yellow]↑/↓[white] select issue  [yellow]</>[white] move issue  [yellow]r[white] reload  [yellow]q[white] quit"
+
+type boardView struct {
+	app       *tview.Application
+	apiClient *gitlab.Client
+	project   string
+	board     *gitlab.IssueBoard
+	filter    boardutils.Filter
+
+	lists       []*boardutils.List
+	columns     *tview.Flex
+	columnLists []*tview.List
+	status      *tview.TextView
+	focused     int
+}
 
 func NewCmdView(f *cmdutils.Factory) *cobra.Command {
 	var viewCmd = &cobra.Command{
 		Use:   "view [flags]",
 		Short: `View project issue board.`,
-		Long:  ``,
+		Long: heredoc.Doc(`
+			View an issue board in an interactive kanban view.
+
+			Issues can be moved between lists with the < and > keys. Moving an issue removes the
+			label, assignee or milestone of its list and adds the one of the destination list.
+			Moving to the Closed list closes the issue and moving out of it reopens the issue.
+		`),
+		Example: heredoc.Doc(`
+			$ glab issue board view
+			$ glab issue board view --board Development --assignee johndoe
+			$ glab issue board view --label backend,bug
+		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			var err error
 
 			a := tview.NewApplication()
 			defer recoverPanic(a)
 
-			//out := utils.ColorableOut(cmd)
-
-			apiClient, err = f.HttpClient()
+			apiClient, err := f.HttpClient()
 			if err != nil {
 				return err
 			}
 
-			repo, err = f.BaseRepo()
+			repo, err := f.BaseRepo()
 			if err != nil {
 				return err
 			}
 
-			project, err = api.GetProject(apiClient, repo.FullName())
+			project, err := api.GetProject(apiClient, repo.FullName())
 			if err != nil {
 				return fmt.Errorf("failed to get project: %w", err)
 			}
 
-			issueBoards, err := api.ListIssueBoards(apiClient, repo.FullName(), &gitlab.ListIssueBoardsOptions{})
+			boardName, _ := cmd.Flags().GetString("board")
+			board, err := boardutils.SelectBoard(apiClient, repo.FullName(), boardName, true)
 			if err != nil {
-				return fmt.Errorf("error retrieving issue board: %w", err)
-			}
-			boardStr := make([]string, len(issueBoards))
-			boardInfo := map[string]int{}
-			for i, board := range issueBoards {
-				boardStr[i] = board.Name
-				boardInfo[boardStr[i]] = board.ID
+				return err
 			}
 
-			prompt := &survey.Select{
-				Message: "Select Board:",
-				Options: boardStr,
-			}
-			err = survey.AskOne(prompt, &selectedBoard)
-			if err != nil {
-				return err
+			v := &boardView{
+				app:       a,
+				apiClient: apiClient,
+				project:   repo.FullName(),
+				board:     board,
 			}
+			v.filter.Assignee, _ = cmd.Flags().GetString("assignee")
+			v.filter.Labels, _ = cmd.Flags().GetStringSlice("label")
 
-			boadLists, err := api.GetIssueBoardLists(apiClient, repo.FullName(), boardInfo[selectedBoard], &gitlab.GetIssueBoardListsOptions{})
-			if err != nil {
+			if err := v.load(); err != nil {
 				return err
 			}
 
-			root := tview.NewFlex()
-			var issues []*gitlab.Issue
-			// TODO: add `open` and `closed` board list. Both are not returned in the List API response payload
-			for _, list := range boadLists {
-				var boardIssues string
-				issues, err = api.ListIssues(apiClient, repo.FullName(), &gitlab.ListProjectIssuesOptions{
-					State:  gitlab.String("opened"),
-					Labels: gitlab.Labels{list.Label.Name},
-				})
-				if err != nil {
-					return fmt.Errorf("error retrieving list issues: %w", err)
-				}
-				bx := tview.NewTextView().SetDynamicColors(true)
-				for _, issue := range issues {
-					var labelPrint string
-					var assignee string
-					totalLables := len(issue.Labels)
-					if totalLables > 0 {
-						for i, l := range issue.Labels {
-							if i == (totalLables - 1) {
-								labelPrint += l
-							} else {
-								labelPrint += l + ", "
-							}
-						}
-					}
-					if issue.Assignee != nil {
-						assignee = issue.Assignee.Username
-					}
-					boardIssues += fmt.Sprintf("[white]%s\n[blue](%s)\n[green]#%d[white] - %s\n\n", issue.Title, labelPrint, issue.IID, assignee)
-				}
-				bx.SetText(boardIssues).SetWrap(true)
-				bx.SetBorder(true).SetTitle(list.Label.Name).SetTitleColor(tcell.GetColor(list.Label.Color))
-				root.AddItem(bx, 0, 1, false)
-			}
+			v.columns = tview.NewFlex()
+			v.status = tview.NewTextView().SetDynamicColors(true).SetText(keyHelp)
+
+			root := tview.NewFlex().SetDirection(tview.FlexRow).
+				AddItem(v.columns, 0, 1, true).
+				AddItem(v.status, 1, 0, false)
+			root.SetBorderPadding(1, 1, 2, 2).SetBorder(true).SetTitle(fmt.Sprintf(" %s • Boards • %s ", board.Name, project.NameWithNamespace))
+
+			v.render(0)
+			a.SetInputCapture(v.handleKey)
 
-			root.SetBorderPadding(1, 1, 2, 2).SetBorder(true).SetTitle(fmt.Sprintf(" %s • Boards • %s ", selectedBoard, project.NameWithNamespace))
 			screen, err := tcell.NewScreen()
 			if err != nil {
 				return err
@@ -125,9 +112,115 @@ func NewCmdView(f *cmdutils.Factory) *cobra.Command {
 		},
 	}
 
+	viewCmd.Flags().StringP("board", "b", "", "Name or ID of the board")
+	viewCmd.Flags().StringP("assignee", "a", "", "Only show issues assigned to this user")
+	viewCmd.Flags().StringSliceP("label", "l", []string{}, "Only show issues with these labels")
+
 	return viewCmd
 }
 
+func (v *boardView) load() error {
+	lists, err := boardutils.LoadBoard(v.apiClient, v.project, v.board, v.filter)
+	if err != nil {
+		return err
+	}
+	v.lists = lists
+	return nil
+}
+
+// render rebuilds the columns and focuses the issue at the given index of the focused list
+func (v *boardView) render(selected int) {
+	v.columns.Clear()
+	v.columnLists = make([]*tview.List, len(v.lists))
+
+	for i, list := range v.lists {
+		col := tview.NewList().SetSelectedFocusOnly(true)
+		for _, issue := range list.Issues {
+			var assignees []string
+			for _, a := range issue.Assignees {
+				assignees = append(assignees, "@"+a.Username)
+			}
+			col.AddItem(
+				tview.Escape(issue.Title),
+				fmt.Sprintf("[green]#%d[white] %s [blue]%s", issue.IID, strings.Join(assignees, " "), tview.Escape(strings.Join(issue.Labels, ", "))),
+				0, nil)
+		}
+		col.SetBorder(true).SetTitle(fmt.Sprintf(" %s (%d) ", list.Title(), len(list.Issues)))
+		if color := list.Color(); color != "" {
+			col.SetTitleColor(tcell.GetColor(color))
+		}
+		v.columnLists[i] = col
+		v.columns.AddItem(col, 0, 1, i == v.focused)
+	}
+
+	if selected < v.columnLists[v.focused].GetItemCount() {
+		v.columnLists[v.focused].SetCurrentItem(selected)
+	}
+	v.app.SetFocus(v.columnLists[v.focused])
+}
+
+func (v *boardView) handleKey(event *tcell.EventKey) *tcell.EventKey {
+	switch {
+	case event.Key() == tcell.KeyLeft || event.Rune() == 'h':
+		v.focus(v.focused - 1)
+	case event.Key() == tcell.KeyRight || event.Rune() == 'l':
+		v.focus(v.focused + 1)
+	case event.Rune() == '<':
+		v.move(v.focused - 1)
+	case event.Rune() == '>':
+		v.move(v.focused + 1)
+	case event.Rune() == 'r':
+		v.reload(v.columnLists[v.focused].GetCurrentItem())
+	case event.Rune() == 'q' || event.Key() == tcell.KeyEscape:
+		v.app.Stop()
+	default:
+		return event
+	}
+	return nil
+}
+
+func (v *boardView) focus(index int) {
+	if index < 0 || index >= len(v.columnLists) {
+		return
+	}
+	v.focused = index
+	v.app.SetFocus(v.columnLists[index])
+}
+
+func (v *boardView) move(target int) {
+	if target < 0 || target >= len(v.lists) {
+		return
+	}
+	from := v.lists[v.focused]
+	if len(from.Issues) == 0 {
+		return
+	}
+	issue := from.Issues[v.columnLists[v.focused].GetCurrentItem()]
+	to := v.lists[target]
+
+	if _, err := boardutils.MoveIssue(v.apiClient, v.project, issue, from, to); err != nil {
+		v.status.SetText(fmt.Sprintf("[red]Failed to move #%d: %s", issue.IID, tview.Escape(err.Error())))
+		return
+	}
+
+	v.focused = target
+	v.reload(0)
+	for i, moved := range v.lists[target].Issues {
+		if moved.IID == issue.IID {
+			v.columnLists[target].SetCurrentItem(i)
+		}
+	}
+	v.status.SetText(fmt.Sprintf("[green]Moved #%d from %s to %s[white]  %s", issue.IID, tview.Escape(from.Title()), tview.Escape(to.Title()), keyHelp))
+}
+
+func (v *boardView) reload(selected int) {
+	if err := v.load(); err != nil {
+		v.status.SetText(fmt.Sprintf("[red]Failed to reload the board: %s", tview.Escape(err.Error())))
+		return
+	}
+	v.render(selected)
+}
+
 func recoverPanic(app *tview.Application) {
 	if r := recover(); r != nil {
 		app.Stop()
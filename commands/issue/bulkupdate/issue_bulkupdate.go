@@ -0,0 +1,177 @@
+package bulkupdate
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/commands/issue/issueutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/profclems/glab/pkg/utils"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type BulkUpdateOptions struct {
+	IIDs      []int
+	FromStdin bool
+
+	Filter issueutils.IssueFilter
+
+	Changes cmdutils.BulkChanges
+	DryRun  bool
+	Yes     bool
+
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+	HTTPClient func() (*gitlab.Client, error)
+}
+
+func NewCmdBulkUpdate(f *cmdutils.Factory, runE func(opts *BulkUpdateOptions) error) *cobra.Command {
+	opts := &BulkUpdateOptions{
+		IO: f.IO,
+	}
+
+	var issueBulkUpdateCmd = &cobra.Command{
+		Use:   "bulk-update [<id>... | -] [flags]",
+		Short: `Update several issues at once`,
+		Long: heredoc.Doc(`
+			Update several issues at once.
+
+			The issues are given as arguments, read from standard input when "-" is passed,
+			or selected with the same filters as "glab issue list". When reading from standard
+			input, only the first field of each line is used, so the output of "glab issue list"
+			can be piped in.
+
+			The issues and the changes are shown before anything is updated.
+		`),
+		Example: heredoc.Doc(`
+			$ glab issue bulk-update --label needs-triage --add-label triaged --remove-label needs-triage
+			$ glab issue bulk-update --milestone 1.0 --set-milestone 1.1 --dry-run
+			$ glab issue bulk-update 12 15 18 --close --yes
+			$ glab issue list --label stale | glab issue bulk-update - --close
+			$ glab issue bulk-update --author @me --set-assignee +john
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+			opts.HTTPClient = f.HttpClient
+
+			for _, arg := range args {
+				if arg == "-" {
+					opts.FromStdin = true
+					continue
+				}
+				iid := utils.StringToInt(arg)
+				if iid <= 0 {
+					return &cmdutils.FlagError{Err: fmt.Errorf("invalid issue ID %q", arg)}
+				}
+				opts.IIDs = append(opts.IIDs, iid)
+			}
+
+			if err := opts.Changes.Validate(); err != nil {
+				return &cmdutils.FlagError{Err: err}
+			}
+
+			hasFilter := opts.Filter.IsSet()
+			if (len(opts.IIDs) > 0 || opts.FromStdin) && (hasFilter || opts.Filter.Closed || opts.Filter.All) {
+				return &cmdutils.FlagError{Err: errors.New("issue IDs and filters can't be used together")}
+			}
+			if len(opts.IIDs) == 0 && !opts.FromStdin && !hasFilter {
+				return &cmdutils.FlagError{Err: errors.New("specify the issues to update with IDs, \"-\" or at least one filter")}
+			}
+			if err := opts.Filter.Validate(); err != nil {
+				return &cmdutils.FlagError{Err: err}
+			}
+			if !opts.Yes && !opts.DryRun && !opts.IO.PromptEnabled() {
+				return &cmdutils.FlagError{Err: errors.New("--yes or --dry-run required when not running interactively")}
+			}
+			if opts.FromStdin && !opts.Yes && !opts.DryRun {
+				return &cmdutils.FlagError{Err: errors.New("--yes or --dry-run required when reading issue IDs from standard input")}
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return bulkUpdateRun(opts)
+		},
+	}
+
+	fl := issueBulkUpdateCmd.Flags()
+	opts.Filter.AddFlags(fl)
+	opts.Changes.AddFlags(fl)
+	fl.BoolVar(&opts.DryRun, "dry-run", false, "Show the issues and changes without updating anything")
+	fl.BoolVarP(&opts.Yes, "yes", "y", false, "Update the issues without asking for confirmation")
+
+	return issueBulkUpdateCmd
+}
+
+func bulkUpdateRun(opts *BulkUpdateOptions) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	issues, err := selectIssues(apiClient, repo, opts)
+	if err != nil {
+		return err
+	}
+
+	if err := opts.Changes.Resolve(apiClient, repo); err != nil {
+		return err
+	}
+
+	byIID := make(map[int]*gitlab.Issue, len(issues))
+	items := make([]cmdutils.BulkItem, 0, len(issues))
+	for _, issue := range issues {
+		byIID[issue.IID] = issue
+		items = append(items, cmdutils.BulkItem{
+			IID:   issue.IID,
+			Title: issue.Title,
+			Ref:   fmt.Sprintf("#%d", issue.IID),
+		})
+	}
+
+	return cmdutils.RunBulkUpdate(opts.IO, "issue", items, opts.Changes.Describe(), opts.DryRun, opts.Yes, func(item cmdutils.BulkItem) error {
+		l, err := opts.Changes.IssueOptions(apiClient, byIID[item.IID])
+		if err != nil {
+			return err
+		}
+		_, err = api.UpdateIssue(apiClient, repo.FullName(), item.IID, l)
+		return err
+	})
+}
+
+func selectIssues(apiClient *gitlab.Client, repo glrepo.Interface, opts *BulkUpdateOptions) ([]*gitlab.Issue, error) {
+	iids := opts.IIDs
+	if opts.FromStdin {
+		read, err := cmdutils.ReadIIDs(opts.IO.In)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read issue IDs: %w", err)
+		}
+		iids = append(iids, read...)
+	}
+
+	if len(iids) > 0 {
+		var issues []*gitlab.Issue
+		for _, iid := range iids {
+			issue, err := api.GetIssue(apiClient, repo.FullName(), iid)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get issue #%d: %w", iid, err)
+			}
+			issues = append(issues, issue)
+		}
+		return issues, nil
+	}
+
+	return issueutils.ListFilteredIssues(apiClient, repo.FullName(), &opts.Filter)
+}
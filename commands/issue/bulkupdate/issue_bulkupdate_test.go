@@ -0,0 +1,112 @@
+package bulkupdate
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/httpmock"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xanzy/go-gitlab"
+)
+
+func newOpts(reg *httpmock.Mocker) (*BulkUpdateOptions, *bytes.Buffer, *bytes.Buffer) {
+	io, _, stdout, stderr := iostreams.Test()
+
+	opts := &BulkUpdateOptions{
+		HTTPClient: func() (*gitlab.Client, error) {
+			a, _ := api.TestClient(&http.Client{Transport: reg}, "", "gitlab.com", false)
+			return a.Lab(), nil
+		},
+		BaseRepo: func() (glrepo.Interface, error) {
+			return glrepo.FromFullName("owner/repo")
+		},
+		IO: io,
+	}
+	_, _ = opts.HTTPClient()
+	return opts, stdout, stderr
+}
+
+const issuesResponse = `[
+	{"id": 1, "iid": 12, "title": "First"},
+	{"id": 2, "iid": 15, "title": "Second"}
+]`
+
+func Test_bulkUpdateRun_dryRun(t *testing.T) {
+	reg := &httpmock.Mocker{}
+	defer reg.Verify(t)
+
+	reg.RegisterResponder("GET", "/api/v4/projects/owner/repo/issues",
+		httpmock.NewStringResponse(200, issuesResponse))
+
+	opts, stdout, _ := newOpts(reg)
+	opts.Filter.Labels = []string{"needs-triage"}
+	opts.Changes = cmdutils.BulkChanges{
+		AddLabels:    []string{"triaged"},
+		RemoveLabels: []string{"needs-triage"},
+		Close:        true,
+	}
+	require.NoError(t, opts.Changes.Validate())
+	opts.DryRun = true
+
+	err := bulkUpdateRun(opts)
+	require.NoError(t, err)
+
+	assert.Equal(t, heredoc.Doc(`
+		2 issues will be updated:
+		  #12 First
+		  #15 Second
+
+		Changes:
+		  - add labels triaged
+		  - remove labels needs-triage
+		  - close
+
+		Dry run: nothing was updated
+	`), stdout.String())
+}
+
+func Test_bulkUpdateRun(t *testing.T) {
+	reg := &httpmock.Mocker{}
+	defer reg.Verify(t)
+
+	reg.RegisterResponder("GET", "/api/v4/projects/owner/repo/issues/12",
+		httpmock.NewStringResponse(200, `{"id": 1, "iid": 12, "title": "First"}`))
+	reg.RegisterResponder("GET", "/api/v4/projects/owner/repo/issues/15",
+		httpmock.NewStringResponse(200, `{"id": 2, "iid": 15, "title": "Second"}`))
+
+	var body string
+	reg.RegisterResponder("PUT", "/api/v4/projects/owner/repo/issues/12",
+		func(req *http.Request) (*http.Response, error) {
+			b, err := ioutil.ReadAll(req.Body)
+			require.NoError(t, err)
+			body = string(b)
+			return httpmock.NewStringResponse(200, `{"id": 1, "iid": 12}`)(req)
+		})
+	reg.RegisterResponder("PUT", "/api/v4/projects/owner/repo/issues/15",
+		httpmock.NewStringResponse(403, `{"message": "403 Forbidden"}`))
+
+	opts, stdout, stderr := newOpts(reg)
+	opts.IIDs = []int{12, 15}
+	opts.Changes = cmdutils.BulkChanges{
+		AddLabels: []string{"triaged"},
+		Reopen:    true,
+	}
+	require.NoError(t, opts.Changes.Validate())
+	opts.Yes = true
+
+	err := bulkUpdateRun(opts)
+	assert.Equal(t, cmdutils.SilentError, err)
+
+	assert.JSONEq(t, `{"add_labels": "triaged", "state_event": "reopen"}`, body)
+	assert.Contains(t, stdout.String(), "✓ Updated #12\n")
+	assert.Contains(t, stderr.String(), "Failed to update #15")
+	assert.Contains(t, stderr.String(), "1 issue of 2 issues failed to update\n")
+}
@@ -0,0 +1,169 @@
+package clone
+
+import (
+	"fmt"
+
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/commands/issue/issueutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+// defaultLabelColor is used for created labels when the color of the original label is unknown
+const defaultLabelColor = "#428BCA"
+
+type CloneOpts struct {
+	Issue     string
+	Target    string
+	WithNotes bool
+
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+	HTTPClient func() (*gitlab.Client, error)
+}
+
+func NewCmdClone(f *cmdutils.Factory, runE func(*CloneOpts) error) *cobra.Command {
+	opts := &CloneOpts{
+		IO: f.IO,
+	}
+
+	var issueCloneCmd = &cobra.Command{
+		Use:   "clone <id> <target-project> [flags]",
+		Short: `Copy an issue to another project`,
+		Long: heredoc.Doc(`
+			Copy an issue to another project.
+
+			The title, description, labels and confidentiality of the issue are copied, and
+			labels missing in the target project are created with the color of the original.
+			The original issue is left untouched.
+		`),
+		Example: heredoc.Doc(`
+			$ glab issue clone 42 other-group/other-project
+			$ glab issue clone 42 other-group/other-project --with-notes
+		`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+			opts.HTTPClient = f.HttpClient
+			opts.Issue = args[0]
+			opts.Target = args[1]
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return cloneRun(opts)
+		},
+	}
+
+	issueCloneCmd.Flags().BoolVar(&opts.WithNotes, "with-notes", false, "Copy the comments of the issue too")
+
+	return issueCloneCmd
+}
+
+func cloneRun(opts *CloneOpts) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	project, iid, err := issueutils.ParseIssueRef(opts.Issue, opts.BaseRepo)
+	if err != nil {
+		return err
+	}
+	targetRepo, err := glrepo.FromFullName(opts.Target)
+	if err != nil {
+		return err
+	}
+	target := targetRepo.FullName()
+
+	issue, err := api.GetIssue(apiClient, project, iid)
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to get %s#%d", project, iid))
+	}
+
+	var notes []*issueutils.ExportedNote
+	if opts.WithNotes {
+		notes, err = issueutils.ListIssueComments(apiClient, project, iid)
+		if err != nil {
+			return err
+		}
+	}
+
+	c := opts.IO.Color()
+	for _, label := range issue.Labels {
+		created, err := ensureLabel(apiClient, project, target, label)
+		if err != nil {
+			return cmdutils.WrapError(err, fmt.Sprintf("failed to create label %q in %s", label, target))
+		}
+		if created {
+			fmt.Fprintf(opts.IO.StdErr, "%s Created label %q in %s\n", c.GreenCheck(), label, target)
+		}
+	}
+
+	cloned, err := api.CreateIssue(apiClient, target, &gitlab.CreateIssueOptions{
+		Title:        gitlab.String(issue.Title),
+		Description:  gitlab.String(issue.Description),
+		Labels:       issue.Labels,
+		Confidential: gitlab.Bool(issue.Confidential),
+	})
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to create the issue in %s", target))
+	}
+
+	for _, note := range notes {
+		_, err := api.CreateIssueNote(apiClient, target, cloned.IID, &gitlab.CreateIssueNoteOptions{
+			Body: gitlab.String(note.AttributedBody()),
+		})
+		if err != nil {
+			return cmdutils.WrapError(err, fmt.Sprintf("failed to copy the comments to %s#%d", target, cloned.IID))
+		}
+	}
+
+	fmt.Fprintf(opts.IO.StdOut, "%s Cloned %s#%d to %s#%d\n", c.GreenCheck(), project, iid, target, cloned.IID)
+	fmt.Fprintln(opts.IO.StdOut, cloned.WebURL)
+	return nil
+}
+
+// ensureLabel creates the label in the target project, copying its color and description
+// from the source project, unless the target project or one of its groups has it already
+func ensureLabel(client *gitlab.Client, source, target, name string) (bool, error) {
+	opts := &gitlab.ListLabelsOptions{
+		IncludeAncestorGroups: gitlab.Bool(true),
+		Search:                gitlab.String(name),
+	}
+	opts.PerPage = 100
+	for opts.Page = 1; ; opts.Page++ {
+		existing, err := api.ListLabels(client, target, opts)
+		if err != nil {
+			return false, err
+		}
+		for _, label := range existing {
+			if label.Name == name {
+				return false, nil
+			}
+		}
+		if len(existing) < opts.PerPage {
+			break
+		}
+	}
+
+	createOpts := &gitlab.CreateLabelOptions{
+		Name:  gitlab.String(name),
+		Color: gitlab.String(defaultLabelColor),
+	}
+	if original, err := api.GetLabel(client, source, name); err == nil {
+		createOpts.Color = gitlab.String(original.Color)
+		if original.Description != "" {
+			createOpts.Description = gitlab.String(original.Description)
+		}
+	}
+
+	_, err := api.CreateLabel(client, target, createOpts)
+	return err == nil, err
+}
@@ -0,0 +1,137 @@
+package clone
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/httpmock"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xanzy/go-gitlab"
+)
+
+func Test_cloneRun(t *testing.T) {
+	reg := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+	defer reg.Verify(t)
+
+	bodies := map[string]map[string]interface{}{}
+	record := func(name string, resp string) httpmock.Responder {
+		return func(req *http.Request) (*http.Response, error) {
+			b, err := ioutil.ReadAll(req.Body)
+			require.NoError(t, err)
+			body := map[string]interface{}{}
+			require.NoError(t, json.Unmarshal(b, &body))
+			bodies[name] = body
+			return httpmock.NewStringResponse(200, resp)(req)
+		}
+	}
+
+	reg.RegisterResponder("GET", "/api/v4/projects/owner/repo/issues/42",
+		httpmock.NewStringResponse(200, `{"id": 1, "iid": 42, "title": "Crash on start",
+			"description": "It crashes.", "labels": ["bug", "p1"], "confidential": true}`))
+	reg.RegisterResponder("GET", "/api/v4/projects/owner/repo/issues/42/notes?order_by=created_at&page=1&per_page=100&sort=asc",
+		httpmock.NewStringResponse(200, `[
+			{"id": 1, "body": "changed the description", "system": true, "author": {"username": "bob"}},
+			{"id": 2, "body": "Confirmed", "author": {"username": "alice"}, "created_at": "2021-05-04T10:00:00Z"}
+		]`))
+	reg.RegisterResponder("GET", "/api/v4/projects/other/project/labels?include_ancestor_groups=true&page=1&per_page=100&search=bug",
+		httpmock.NewStringResponse(200, `[{"id": 1, "name": "bug"}, {"id": 2, "name": "bug-fix"}]`))
+	reg.RegisterResponder("GET", "/api/v4/projects/other/project/labels?include_ancestor_groups=true&page=1&per_page=100&search=p1",
+		httpmock.NewStringResponse(200, `[{"id": 5, "name": "p10"}]`))
+	reg.RegisterResponder("GET", "/api/v4/projects/owner/repo/labels/p1",
+		httpmock.NewStringResponse(200, `{"id": 3, "name": "p1", "color": "#FF0000", "description": "Urgent"}`))
+	reg.RegisterResponder("POST", "/api/v4/projects/other/project/labels",
+		record("label", `{"id": 4, "name": "p1"}`))
+	reg.RegisterResponder("POST", "/api/v4/projects/other/project/issues",
+		record("issue", `{"id": 2, "iid": 7, "web_url": "https://gitlab.com/other/project/-/issues/7"}`))
+	reg.RegisterResponder("POST", "/api/v4/projects/other/project/issues/7/notes",
+		record("note", `{"id": 3}`))
+
+	io, _, stdout, stderr := iostreams.Test()
+	opts := &CloneOpts{
+		Issue:     "42",
+		Target:    "other/project",
+		WithNotes: true,
+		IO:        io,
+		BaseRepo: func() (glrepo.Interface, error) {
+			return glrepo.FromFullName("owner/repo")
+		},
+		HTTPClient: func() (*gitlab.Client, error) {
+			a, _ := api.TestClient(&http.Client{Transport: reg}, "", "gitlab.com", false)
+			return a.Lab(), nil
+		},
+	}
+	_, _ = opts.HTTPClient()
+
+	err := cloneRun(opts)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]interface{}{
+		"name":        "p1",
+		"color":       "#FF0000",
+		"description": "Urgent",
+	}, bodies["label"])
+	assert.Equal(t, map[string]interface{}{
+		"title":        "Crash on start",
+		"description":  "It crashes.",
+		"labels":       "bug,p1",
+		"confidential": true,
+	}, bodies["issue"])
+	assert.Equal(t, "_`alice` commented on 2021-05-04:_\n\nConfirmed", bodies["note"]["body"])
+
+	assert.Equal(t, "✓ Created label \"p1\" in other/project\n", stderr.String())
+	assert.Equal(t, "✓ Cloned owner/repo#42 to other/project#7\nhttps://gitlab.com/other/project/-/issues/7\n", stdout.String())
+}
+
+func Test_cloneRun_labelWithSlash(t *testing.T) {
+	reg := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+	defer reg.Verify(t)
+
+	var labelBody map[string]interface{}
+	reg.RegisterResponder("GET", "/api/v4/projects/owner/repo/issues/42",
+		httpmock.NewStringResponse(200, `{"id": 1, "iid": 42, "title": "Crash on start", "labels": ["team/backend"]}`))
+	reg.RegisterResponder("GET", "/api/v4/projects/other/project/labels?include_ancestor_groups=true&page=1&per_page=100&search=team%2Fbackend",
+		httpmock.NewStringResponse(200, `[]`))
+	reg.RegisterResponder("GET", "/api/v4/projects/owner/repo/labels/team/backend",
+		func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, "/api/v4/projects/owner%2Frepo/labels/team%2Fbackend", req.URL.EscapedPath())
+			return httpmock.NewStringResponse(200, `{"id": 3, "name": "team/backend", "color": "#00FF00"}`)(req)
+		})
+	reg.RegisterResponder("POST", "/api/v4/projects/other/project/labels",
+		func(req *http.Request) (*http.Response, error) {
+			b, err := ioutil.ReadAll(req.Body)
+			require.NoError(t, err)
+			require.NoError(t, json.Unmarshal(b, &labelBody))
+			return httpmock.NewStringResponse(201, `{"id": 4, "name": "team/backend"}`)(req)
+		})
+	reg.RegisterResponder("POST", "/api/v4/projects/other/project/issues",
+		httpmock.NewStringResponse(201, `{"id": 2, "iid": 7, "web_url": "https://gitlab.com/other/project/-/issues/7"}`))
+
+	io, _, stdout, stderr := iostreams.Test()
+	opts := &CloneOpts{
+		Issue:  "42",
+		Target: "other/project",
+		IO:     io,
+		BaseRepo: func() (glrepo.Interface, error) {
+			return glrepo.FromFullName("owner/repo")
+		},
+		HTTPClient: func() (*gitlab.Client, error) {
+			a, _ := api.TestClient(&http.Client{Transport: reg}, "", "gitlab.com", false)
+			return a.Lab(), nil
+		},
+	}
+	_, _ = opts.HTTPClient()
+
+	err := cloneRun(opts)
+	require.NoError(t, err)
+
+	assert.Equal(t, "team/backend", labelBody["name"])
+	assert.Equal(t, "#00FF00", labelBody["color"])
+	assert.Equal(t, "✓ Created label \"team/backend\" in other/project\n", stderr.String())
+	assert.Equal(t, "✓ Cloned owner/repo#42 to other/project#7\nhttps://gitlab.com/other/project/-/issues/7\n", stdout.String())
+}
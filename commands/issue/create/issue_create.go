@@ -39,6 +39,9 @@ type CreateOpts struct {
 
 	MilestoneFlag string
 
+	Template       string
+	TemplateFields []string
+
 	NoEditor       bool
 	IsConfidential bool
 	IsInteractive  bool
@@ -72,6 +75,7 @@ func NewCmdCreate(f *cmdutils.Factory) *cobra.Command {
 			$ glab issue create -m release-2.0.0 -t "we need this feature" --label important
 			$ glab issue new -t "Fix CVE-YYYY-XXXX" -l security --linked-mr 123
 			$ glab issue create -m release-1.0.1 -t "security fix" --label security --web
+			$ glab issue create --template bug --field version=1.2.0
 		`),
 		Args: cobra.ExactArgs(0),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -100,8 +104,19 @@ func NewCmdCreate(f *cmdutils.Factory) *cobra.Command {
 			// disable interactive mode if title and description are explicitly defined
 			opts.IsInteractive = !(hasTitle && hasDescription)
 
+			if opts.Template != "" && hasDescription {
+				return &cmdutils.FlagError{Err: errors.New("--template and --description can't be used together")}
+			}
+			if len(opts.TemplateFields) > 0 && opts.Template == "" {
+				return &cmdutils.FlagError{Err: errors.New("--field can only be used with --template")}
+			}
+
 			if opts.IsInteractive && !opts.IO.PromptEnabled() {
-				return &cmdutils.FlagError{Err: errors.New("--title and --description required for non-interactive mode")}
+				// the template provides the description
+				if opts.Template == "" {
+					return &cmdutils.FlagError{Err: errors.New("--title and --description required for non-interactive mode")}
+				}
+				opts.IsInteractive = false
 			}
 
 			// Remove this once --yes does more than just skip the prompts that --web happen to skip
@@ -138,6 +153,8 @@ func NewCmdCreate(f *cmdutils.Factory) *cobra.Command {
 	issueCreateCmd.Flags().StringVarP(&opts.IssueLinkType, "link-type", "", "relates_to", "Type for the issue link")
 	issueCreateCmd.Flags().StringVarP(&opts.TimeEstimate, "time-estimate", "e", "", "Set time estimate for the issue")
 	issueCreateCmd.Flags().StringVarP(&opts.TimeSpent, "time-spent", "s", "", "Set time spent for the issue")
+	issueCreateCmd.Flags().StringVarP(&opts.Template, "template", "T", "", "Use the description template <name> from .gitlab/issue_templates")
+	issueCreateCmd.Flags().StringArrayVar(&opts.TemplateFields, "field", []string{}, "Set a template field as name=value instead of being prompted for it")
 
 	return issueCreateCmd
 }
@@ -154,12 +171,13 @@ func createRun(opts *CreateOpts) error {
 	}
 
 	var templateName string
+	var templateTitle string
 	var templateContents string
 
 	issueCreateOpts := &gitlab.CreateIssueOptions{}
 
-	if opts.MilestoneFlag != "" {
-		opts.MileStone, err = cmdutils.ParseMilestone(apiClient, repo, opts.MilestoneFlag)
+	if opts.Template != "" {
+		templateTitle, templateContents, err = applyTemplate(opts, opts.Template)
 		if err != nil {
 			return err
 		}
@@ -168,11 +186,11 @@ func createRun(opts *CreateOpts) error {
 	if opts.IsInteractive {
 		if opts.Description == "" {
 			if opts.NoEditor {
-				err = prompt.AskMultiline(&opts.Description, "description", "Description:", "")
+				err = prompt.AskMultiline(&opts.Description, "description", "Description:", templateContents)
 				if err != nil {
 					return err
 				}
-			} else {
+			} else if opts.Template == "" {
 
 				templateResponse := struct {
 					Index int
@@ -197,17 +215,17 @@ func createRun(opts *CreateOpts) error {
 				if err := prompt.Ask(selectQs, &templateResponse); err != nil {
 					return fmt.Errorf("could not prompt: %w", err)
 				}
-				if templateResponse.Index != len(templateNames) {
+				if templateResponse.Index != len(templateNames)-1 {
 					templateName = templateNames[templateResponse.Index]
-					templateContents, err = cmdutils.LoadGitLabTemplate(cmdutils.IssueTemplate, templateName)
+					templateTitle, templateContents, err = applyTemplate(opts, templateName)
 					if err != nil {
-						return fmt.Errorf("failed to get template contents: %w", err)
+						return err
 					}
 				}
 			}
 		}
 		if opts.Title == "" {
-			err = prompt.AskQuestionWithInput(&opts.Title, "title", "Title", "", true)
+			err = prompt.AskQuestionWithInput(&opts.Title, "title", "Title", templateTitle, true)
 			if err != nil {
 				return err
 			}
@@ -233,6 +251,13 @@ func createRun(opts *CreateOpts) error {
 		return fmt.Errorf("title can't be blank")
 	}
 
+	if opts.MilestoneFlag != "" {
+		opts.MileStone, err = cmdutils.ParseMilestone(apiClient, repo, opts.MilestoneFlag)
+		if err != nil {
+			return err
+		}
+	}
+
 	var action cmdutils.Action
 
 	// submit without prompting for non interactive mode
@@ -353,6 +378,55 @@ func createRun(opts *CreateOpts) error {
 	return errors.New("expected to cancel, preview in browser, add metadata, or submit")
 }
 
+// applyTemplate loads the template, fills in its fields and applies the defaults of its
+// front matter which were not set with flags. It returns the rendered title and body.
+func applyTemplate(opts *CreateOpts, name string) (string, string, error) {
+	content, err := cmdutils.LoadGitLabTemplate(cmdutils.IssueTemplate, name)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get template contents: %w", err)
+	}
+	if content == "" {
+		return "", "", fmt.Errorf("template %q not found in .gitlab/%s", name, cmdutils.IssueTemplate)
+	}
+
+	tmpl, err := cmdutils.ParseTemplate(content)
+	if err != nil {
+		return "", "", fmt.Errorf("template %q: %w", name, err)
+	}
+
+	values, err := cmdutils.ParseTemplateValues(opts.TemplateFields)
+	if err != nil {
+		return "", "", &cmdutils.FlagError{Err: err}
+	}
+	if err := cmdutils.FillTemplateValues(tmpl, values, opts.IO.PromptEnabled()); err != nil {
+		return "", "", err
+	}
+	title, body := tmpl.Render(values)
+
+	for _, label := range tmpl.Labels {
+		if !utils.PresentInStringSlice(opts.Labels, label) {
+			opts.Labels = append(opts.Labels, label)
+		}
+	}
+	if len(opts.Assignees) == 0 {
+		opts.Assignees = tmpl.Assignees
+	}
+	if opts.MilestoneFlag == "" && opts.MileStone == 0 {
+		opts.MilestoneFlag = tmpl.Milestone
+	}
+	opts.IsConfidential = opts.IsConfidential || tmpl.Confidential
+
+	if !opts.IsInteractive {
+		if opts.Title == "" {
+			opts.Title = title
+		}
+		if opts.Description == "" {
+			opts.Description = body
+		}
+	}
+	return title, body, nil
+}
+
 func postCreateActions(apiClient *gitlab.Client, issue *gitlab.Issue, opts *CreateOpts, repo glrepo.Interface) error {
 	if len(opts.LinkedIssues) > 0 {
 		var err error
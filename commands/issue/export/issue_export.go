@@ -0,0 +1,140 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/commands/issue/issueutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/profclems/glab/pkg/utils"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type ExportOpts struct {
+	Filter     issueutils.IssueFilter
+	Format     string
+	OutputFile string
+
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+	HTTPClient func() (*gitlab.Client, error)
+}
+
+func NewCmdExport(f *cmdutils.Factory, runE func(*ExportOpts) error) *cobra.Command {
+	opts := &ExportOpts{
+		IO: f.IO,
+	}
+
+	var issueExportCmd = &cobra.Command{
+		Use:   "export [flags]",
+		Short: `Export issues to a JSON or CSV file`,
+		Long: heredoc.Doc(`
+			Export the issues matching the filters with their comments, labels, milestone,
+			assignees and links. The file can be imported into another project with
+			"glab issue import".
+
+			The format is taken from the extension of the output file, or set with --format.
+			In CSV files, labels, assignees and links are comma separated and the comments
+			are a JSON array.
+		`),
+		Example: heredoc.Doc(`
+			$ glab issue export --all > issues.json
+			$ glab issue export --label bug --output bugs.csv
+			$ glab issue export --milestone 1.0 --format csv
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+			opts.HTTPClient = f.HttpClient
+
+			if err := opts.Filter.Validate(); err != nil {
+				return &cmdutils.FlagError{Err: err}
+			}
+
+			if opts.Format == "" {
+				opts.Format = formatFromFile(opts.OutputFile)
+			}
+			if opts.Format != issueutils.ExportFormatJSON && opts.Format != issueutils.ExportFormatCSV {
+				return &cmdutils.FlagError{Err: fmt.Errorf("invalid format %q. Must be json or csv", opts.Format)}
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return exportRun(opts)
+		},
+	}
+
+	opts.Filter.AddFlags(issueExportCmd.Flags())
+	issueExportCmd.Flags().StringVarP(&opts.Format, "format", "F", "", "Format of the export: json, csv (default: from the output file extension, or json)")
+	issueExportCmd.Flags().StringVarP(&opts.OutputFile, "output", "o", "", "Write the export to a file instead of the standard output")
+
+	return issueExportCmd
+}
+
+func formatFromFile(name string) string {
+	if strings.EqualFold(filepath.Ext(name), ".csv") {
+		return issueutils.ExportFormatCSV
+	}
+	return issueutils.ExportFormatJSON
+}
+
+func exportRun(opts *ExportOpts) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	issues, err := issueutils.ListFilteredIssues(apiClient, repo.FullName(), &opts.Filter)
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to list issues")
+	}
+
+	exported := make([]*issueutils.ExportedIssue, 0, len(issues))
+	for i, issue := range issues {
+		if opts.IO.IsErrTTY {
+			fmt.Fprintf(opts.IO.StdErr, "\r- Exporting issue %d of %d", i+1, len(issues))
+		}
+		e, err := issueutils.ExportIssue(apiClient, repo.FullName(), issue)
+		if err != nil {
+			return err
+		}
+		exported = append(exported, e)
+	}
+	if opts.IO.IsErrTTY && len(issues) > 0 {
+		fmt.Fprintln(opts.IO.StdErr)
+	}
+
+	var out io.Writer = opts.IO.StdOut
+	if opts.OutputFile != "" {
+		file, err := os.Create(opts.OutputFile)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		out = file
+	}
+
+	if err := issueutils.WriteExportedIssues(out, opts.Format, exported); err != nil {
+		return cmdutils.WrapError(err, "failed to write the export")
+	}
+
+	if opts.OutputFile != "" {
+		fmt.Fprintf(opts.IO.StdErr, "%s Exported %s to %s\n", opts.IO.Color().GreenCheck(), utils.Pluralize(len(exported), "issue"), opts.OutputFile)
+	}
+	return nil
+}
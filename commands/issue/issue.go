@@ -4,16 +4,25 @@ import (
 	"github.com/MakeNowJust/heredoc"
 	"github.com/profclems/glab/commands/cmdutils"
 	issueBoardCmd "github.com/profclems/glab/commands/issue/board"
+	issueBulkUpdateCmd "github.com/profclems/glab/commands/issue/bulkupdate"
+	issueCloneCmd "github.com/profclems/glab/commands/issue/clone"
 	issueCloseCmd "github.com/profclems/glab/commands/issue/close"
 	issueCreateCmd "github.com/profclems/glab/commands/issue/create"
 	issueDeleteCmd "github.com/profclems/glab/commands/issue/delete"
+	issueExportCmd "github.com/profclems/glab/commands/issue/export"
+	issueImportCmd "github.com/profclems/glab/commands/issue/issueimport"
+	issueLinkCmd "github.com/profclems/glab/commands/issue/link"
+	issueLinksCmd "github.com/profclems/glab/commands/issue/links"
 	issueListCmd "github.com/profclems/glab/commands/issue/list"
+	issueMoveCmd "github.com/profclems/glab/commands/issue/move"
 	issueNoteCmd "github.com/profclems/glab/commands/issue/note"
 	issueReopenCmd "github.com/profclems/glab/commands/issue/reopen"
 	issueSubscribeCmd "github.com/profclems/glab/commands/issue/subscribe"
+	issueUnlinkCmd "github.com/profclems/glab/commands/issue/unlink"
 	issueUnsubscribeCmd "github.com/profclems/glab/commands/issue/unsubscribe"
 	issueUpdateCmd "github.com/profclems/glab/commands/issue/update"
 	issueViewCmd "github.com/profclems/glab/commands/issue/view"
+	"github.com/profclems/glab/commands/timetracking"
 
 	"github.com/spf13/cobra"
 )
@@ -40,15 +49,24 @@ func NewCmdIssue(f *cmdutils.Factory) *cobra.Command {
 
 	cmdutils.EnableRepoOverride(issueCmd, f)
 
+	issueCmd.AddCommand(issueCloneCmd.NewCmdClone(f, nil))
 	issueCmd.AddCommand(issueCloseCmd.NewCmdClose(f))
 	issueCmd.AddCommand(issueBoardCmd.NewCmdBoard(f))
+	issueCmd.AddCommand(issueBulkUpdateCmd.NewCmdBulkUpdate(f, nil))
 	issueCmd.AddCommand(issueCreateCmd.NewCmdCreate(f))
 	issueCmd.AddCommand(issueDeleteCmd.NewCmdDelete(f))
+	issueCmd.AddCommand(issueExportCmd.NewCmdExport(f, nil))
+	issueCmd.AddCommand(issueImportCmd.NewCmdImport(f, nil))
+	issueCmd.AddCommand(issueLinkCmd.NewCmdLink(f, nil))
+	issueCmd.AddCommand(issueLinksCmd.NewCmdLinks(f, nil))
 	issueCmd.AddCommand(issueListCmd.NewCmdList(f, nil))
+	issueCmd.AddCommand(issueMoveCmd.NewCmdMove(f, nil))
 	issueCmd.AddCommand(issueNoteCmd.NewCmdNote(f))
 	issueCmd.AddCommand(issueReopenCmd.NewCmdReopen(f))
+	issueCmd.AddCommand(timetracking.NewCmdTime(f, timetracking.Issue))
 	issueCmd.AddCommand(issueViewCmd.NewCmdView(f))
 	issueCmd.AddCommand(issueSubscribeCmd.NewCmdSubscribe(f))
+	issueCmd.AddCommand(issueUnlinkCmd.NewCmdUnlink(f, nil))
 	issueCmd.AddCommand(issueUnsubscribeCmd.NewCmdUnsubscribe(f))
 	issueCmd.AddCommand(issueUpdateCmd.NewCmdUpdate(f))
 	return issueCmd
@@ -0,0 +1,370 @@
+package issueimport
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/commands/issue/issueutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/profclems/glab/pkg/utils"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type ImportOpts struct {
+	File   string
+	Format string
+	DryRun bool
+
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+	HTTPClient func() (*gitlab.Client, error)
+}
+
+func NewCmdImport(f *cmdutils.Factory, runE func(*ImportOpts) error) *cobra.Command {
+	opts := &ImportOpts{
+		IO: f.IO,
+	}
+
+	var issueImportCmd = &cobra.Command{
+		Use:   "import <file> [flags]",
+		Short: `Import issues from a JSON or CSV file`,
+		Long: heredoc.Doc(`
+			Create issues from a file written by "glab issue export". Pass "-" to read the
+			file from the standard input.
+
+			Comments, labels, the milestone, assignees and the state of the issues are imported.
+			Milestones and users which do not exist in the project are skipped with a warning.
+			Links are recreated between the imported issues.
+
+			The description of each imported issue records where it was imported from, so
+			issues which were already imported are skipped when a file is imported again.
+		`),
+		Example: heredoc.Doc(`
+			$ glab issue import issues.json --dry-run
+			$ glab issue import bugs.csv -R group/new-project
+			$ glab issue export -R group/old-project | glab issue import - -R group/new-project
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+			opts.HTTPClient = f.HttpClient
+			opts.File = args[0]
+
+			if opts.Format == "" {
+				opts.Format = issueutils.ExportFormatJSON
+				if strings.EqualFold(filepath.Ext(opts.File), ".csv") {
+					opts.Format = issueutils.ExportFormatCSV
+				}
+			}
+			if opts.Format != issueutils.ExportFormatJSON && opts.Format != issueutils.ExportFormatCSV {
+				return &cmdutils.FlagError{Err: fmt.Errorf("invalid format %q. Must be json or csv", opts.Format)}
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return importRun(opts)
+		},
+	}
+
+	issueImportCmd.Flags().StringVarP(&opts.Format, "format", "F", "", "Format of the file: json, csv (default: from the file extension, or json)")
+	issueImportCmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Report what would be imported without creating anything")
+
+	return issueImportCmd
+}
+
+// importer holds the state of an import
+type importer struct {
+	opts      *ImportOpts
+	client    *gitlab.Client
+	repo      string
+	imported  map[string]int // source reference to the IID of the imported issue
+	created   map[string]bool
+	milestone map[string]int
+	users     map[string]int
+	warnings  []string
+}
+
+func importRun(opts *ImportOpts) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	issues, err := readIssues(opts)
+	if err != nil {
+		return err
+	}
+
+	existing, err := issueutils.ListFilteredIssues(apiClient, repo.FullName(), &issueutils.IssueFilter{All: true})
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to list the issues of the project")
+	}
+
+	im := &importer{
+		opts:      opts,
+		client:    apiClient,
+		repo:      repo.FullName(),
+		imported:  map[string]int{},
+		created:   map[string]bool{},
+		milestone: map[string]int{},
+		users:     map[string]int{},
+	}
+	for _, issue := range existing {
+		if source := issueutils.ImportedFrom(issue.Description); source != "" {
+			im.imported[source] = issue.IID
+		}
+	}
+
+	c := opts.IO.Color()
+	out := opts.IO.StdOut
+	skipped := 0
+	var toCreate []*issueutils.ExportedIssue
+	for _, issue := range issues {
+		if issue.Source == "" || issue.Title == "" {
+			return fmt.Errorf("every issue needs a source and a title")
+		}
+		if iid, ok := im.imported[issue.Source]; ok {
+			skipped++
+			fmt.Fprintf(out, "%s %s already imported as #%d\n", c.Gray("skip  "), issue.Source, iid)
+			continue
+		}
+		// mark duplicated sources of the file as imported
+		im.imported[issue.Source] = 0
+		toCreate = append(toCreate, issue)
+	}
+
+	for _, issue := range toCreate {
+		milestoneID, assigneeIDs := im.resolveMetadata(issue)
+
+		if opts.DryRun {
+			fmt.Fprintf(out, "%s %s %s (%s, %s)\n", c.Green("create"), issue.Source, issue.Title,
+				utils.Pluralize(len(issue.Notes), "comment"), utils.Pluralize(len(issue.Links), "link"))
+			continue
+		}
+
+		created, err := im.create(issue, milestoneID, assigneeIDs)
+		if err != nil {
+			return cmdutils.WrapError(err, fmt.Sprintf("failed to import %s", issue.Source))
+		}
+		im.imported[issue.Source] = created.IID
+		im.created[issue.Source] = true
+		fmt.Fprintf(out, "%s %s as #%d %s\n", c.GreenCheck(), issue.Source, created.IID, issue.Title)
+	}
+
+	links := 0
+	if !opts.DryRun {
+		links, err = im.link(toCreate)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, warning := range im.warnings {
+		fmt.Fprintf(opts.IO.StdErr, "%s %s\n", c.WarnIcon(), warning)
+	}
+
+	if opts.DryRun {
+		fmt.Fprintf(out, "\nDry run: %s would be imported, %s already imported\n",
+			utils.Pluralize(len(toCreate), "issue"), utils.Pluralize(skipped, "issue"))
+		return nil
+	}
+	fmt.Fprintf(out, "\nImported %s and %s, skipped %s already imported\n",
+		utils.Pluralize(len(toCreate), "issue"), utils.Pluralize(links, "link"), utils.Pluralize(skipped, "issue"))
+	return nil
+}
+
+func readIssues(opts *ImportOpts) ([]*issueutils.ExportedIssue, error) {
+	var r io.Reader = opts.IO.In
+	if opts.File != "-" {
+		file, err := os.Open(opts.File)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+		r = file
+	}
+
+	issues, err := issueutils.ReadExportedIssues(r, opts.Format)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", opts.File, err)
+	}
+	return issues, nil
+}
+
+// resolveMetadata looks up the milestone and assignees of the issue in the project.
+// The ones which do not exist are reported as warnings.
+func (im *importer) resolveMetadata(issue *issueutils.ExportedIssue) (int, []int) {
+	milestoneID := 0
+	if issue.Milestone != "" {
+		id, ok := im.milestone[issue.Milestone]
+		if !ok {
+			milestone, err := api.ProjectMilestoneByTitle(im.client, im.repo, issue.Milestone)
+			if err == nil {
+				id = milestone.ID
+			} else {
+				im.warnings = append(im.warnings, fmt.Sprintf("milestone %q not found in %s", issue.Milestone, im.repo))
+			}
+			im.milestone[issue.Milestone] = id
+		}
+		milestoneID = id
+	}
+
+	var assigneeIDs []int
+	for _, username := range issue.Assignees {
+		id, ok := im.users[username]
+		if !ok {
+			user, err := api.UserByName(im.client, username)
+			if err == nil {
+				id = user.ID
+			} else {
+				im.warnings = append(im.warnings, fmt.Sprintf("user %q not found", username))
+			}
+			im.users[username] = id
+		}
+		if id != 0 {
+			assigneeIDs = append(assigneeIDs, id)
+		}
+	}
+	return milestoneID, assigneeIDs
+}
+
+func (im *importer) create(issue *issueutils.ExportedIssue, milestoneID int, assigneeIDs []int) (*gitlab.Issue, error) {
+	description := issueutils.StripImportMarkers(issue.Description)
+	if description != "" {
+		description += "\n\n"
+	}
+	if issue.Author != "" {
+		description += fmt.Sprintf("_Originally created by `%s`", issue.Author)
+		if issue.CreatedAt != nil {
+			description += " on " + issue.CreatedAt.Format("2006-01-02")
+		}
+		description += "._\n\n"
+	}
+
+	createOpts := &gitlab.CreateIssueOptions{
+		Title:       gitlab.String(issue.Title),
+		Description: gitlab.String(strings.TrimSpace(description)),
+		Labels:      issue.Labels,
+		AssigneeIDs: assigneeIDs,
+	}
+	if milestoneID != 0 {
+		createOpts.MilestoneID = gitlab.Int(milestoneID)
+	}
+	if issue.Confidential {
+		createOpts.Confidential = gitlab.Bool(true)
+	}
+	if issue.DueDate != "" {
+		dueDate, err := time.Parse("2006-01-02", issue.DueDate)
+		if err != nil {
+			return nil, fmt.Errorf("invalid due date %q", issue.DueDate)
+		}
+		d := gitlab.ISOTime(dueDate)
+		createOpts.DueDate = &d
+	}
+
+	created, err := api.CreateIssue(im.client, im.repo, createOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	// an issue which failed half-way is deleted, so the next run does not import it twice
+	completed, err := im.complete(created.IID, issue, description)
+	if err != nil {
+		if deleteErr := api.DeleteIssue(im.client, im.repo, created.IID); deleteErr != nil {
+			return nil, fmt.Errorf("%w. The partially imported issue #%d could not be deleted: delete it before importing again", err, created.IID)
+		}
+		return nil, err
+	}
+	return completed, nil
+}
+
+// complete imports the comments and the state of the created issue. The marker is only
+// written once they are imported, so the issue is not skipped by the next run before that.
+func (im *importer) complete(iid int, issue *issueutils.ExportedIssue, description string) (*gitlab.Issue, error) {
+	for _, note := range issue.Notes {
+		_, err := api.CreateIssueNote(im.client, im.repo, iid, &gitlab.CreateIssueNoteOptions{
+			Body: gitlab.String(note.AttributedBody()),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to add comment: %w", err)
+		}
+	}
+
+	updateOpts := &gitlab.UpdateIssueOptions{
+		Description: gitlab.String(description + issueutils.ImportMarker(issue.Source)),
+	}
+	if issue.State == "closed" {
+		updateOpts.StateEvent = gitlab.String("close")
+	}
+	updated, err := api.UpdateIssue(im.client, im.repo, iid, updateOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update issue: %w", err)
+	}
+	return updated, nil
+}
+
+// link recreates the links of the created issues between the issues of the project
+// which were imported. Links to issues which were not imported are reported as warnings.
+func (im *importer) link(issues []*issueutils.ExportedIssue) (int, error) {
+	done := map[string]bool{}
+	count := 0
+	for _, issue := range issues {
+		for _, link := range issue.Links {
+			target, ok := im.imported[link.Issue]
+			if !ok || target == 0 {
+				im.warnings = append(im.warnings, fmt.Sprintf("skipped the link from %s to %s, which was not imported", issue.Source, link.Issue))
+				continue
+			}
+
+			// both ends of a link are exported, so it is created once
+			key := linkKey(issue.Source, link.Issue, link.Type)
+			if done[key] {
+				continue
+			}
+			done[key] = true
+
+			_, _, err := api.LinkIssues(im.client, im.repo, im.imported[issue.Source], &gitlab.CreateIssueLinkOptions{
+				TargetProjectID: gitlab.String(im.repo),
+				TargetIssueIID:  gitlab.String(strconv.Itoa(target)),
+				LinkType:        gitlab.String(link.Type),
+			})
+			if err != nil {
+				return count, cmdutils.WrapError(err, fmt.Sprintf("failed to link %s to %s", issue.Source, link.Issue))
+			}
+			count++
+		}
+	}
+	return count, nil
+}
+
+// linkKey identifies a link the same way from both of its ends
+func linkKey(from, to, linkType string) string {
+	switch linkType {
+	case issueutils.LinkIsBlockedBy:
+		return to + " blocks " + from
+	case issueutils.LinkBlocks:
+		return from + " blocks " + to
+	}
+	if from > to {
+		from, to = to, from
+	}
+	return from + " relates to " + to
+}
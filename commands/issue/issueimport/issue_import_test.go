@@ -0,0 +1,178 @@
+package issueimport
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/httpmock"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xanzy/go-gitlab"
+)
+
+const importFile = `[
+	{"source": "old/project#1", "title": "Already imported", "state": "opened"},
+	{
+		"source": "old/project#2",
+		"title": "Crash on start",
+		"description": "It crashes.",
+		"state": "closed",
+		"labels": ["bug"],
+		"author": "bob",
+		"created_at": "2021-05-03T10:00:00Z",
+		"links": [
+			{"type": "blocks", "issue": "old/project#1"},
+			{"type": "relates_to", "issue": "old/project#9"}
+		],
+		"notes": [{"author": "alice", "body": "Confirmed", "created_at": "2021-05-04T10:00:00Z"}]
+	}
+]`
+
+func newOpts(reg *httpmock.Mocker) (*ImportOpts, *bytes.Buffer, *bytes.Buffer) {
+	io, stdin, stdout, stderr := iostreams.Test()
+	stdin.WriteString(importFile)
+
+	opts := &ImportOpts{
+		File:   "-",
+		Format: "json",
+		IO:     io,
+		HTTPClient: func() (*gitlab.Client, error) {
+			a, _ := api.TestClient(&http.Client{Transport: reg}, "", "gitlab.com", false)
+			return a.Lab(), nil
+		},
+		BaseRepo: func() (glrepo.Interface, error) {
+			return glrepo.FromFullName("new/project")
+		},
+	}
+	_, _ = opts.HTTPClient()
+	return opts, stdout, stderr
+}
+
+func registerExisting(reg *httpmock.Mocker) {
+	reg.RegisterResponder("GET", "/api/v4/projects/new/project/issues",
+		httpmock.NewStringResponse(200, `[
+			{"id": 100, "iid": 5, "title": "Already imported", "description": "<!-- glab-import: old/project#1 -->"},
+			{"id": 101, "iid": 6, "title": "Unrelated"}
+		]`))
+}
+
+func Test_importRun_dryRun(t *testing.T) {
+	reg := &httpmock.Mocker{}
+	defer reg.Verify(t)
+	registerExisting(reg)
+
+	opts, stdout, _ := newOpts(reg)
+	opts.DryRun = true
+
+	err := importRun(opts)
+	require.NoError(t, err)
+
+	assert.Equal(t, heredoc.Doc(`
+		skip   old/project#1 already imported as #5
+		create old/project#2 Crash on start (1 comment, 2 links)
+
+		Dry run: 1 issue would be imported, 1 issue already imported
+	`), stdout.String())
+}
+
+func Test_importRun(t *testing.T) {
+	reg := &httpmock.Mocker{}
+	defer reg.Verify(t)
+	registerExisting(reg)
+
+	bodies := map[string]map[string]interface{}{}
+	record := func(name string, resp string) httpmock.Responder {
+		return func(req *http.Request) (*http.Response, error) {
+			b, err := ioutil.ReadAll(req.Body)
+			require.NoError(t, err)
+			body := map[string]interface{}{}
+			require.NoError(t, json.Unmarshal(b, &body))
+			bodies[name] = body
+			return httpmock.NewStringResponse(200, resp)(req)
+		}
+	}
+	reg.RegisterResponder("POST", "/api/v4/projects/new/project/issues",
+		record("issue", `{"id": 102, "iid": 7, "title": "Crash on start"}`))
+	reg.RegisterResponder("POST", "/api/v4/projects/new/project/issues/7/notes",
+		record("note", `{"id": 1}`))
+	reg.RegisterResponder("PUT", "/api/v4/projects/new/project/issues/7",
+		record("update", `{"id": 102, "iid": 7, "state": "closed"}`))
+	reg.RegisterResponder("POST", "/api/v4/projects/new/project/issues/7/links",
+		record("link", `{"source_issue": {"id": 102, "iid": 7}, "target_issue": {"id": 100, "iid": 5}}`))
+
+	opts, stdout, stderr := newOpts(reg)
+
+	err := importRun(opts)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Crash on start", bodies["issue"]["title"])
+	assert.Equal(t, "bug", bodies["issue"]["labels"])
+	assert.Equal(t, "It crashes.\n\n_Originally created by `bob` on 2021-05-03._", bodies["issue"]["description"])
+	assert.Equal(t, "_`alice` commented on 2021-05-04:_\n\nConfirmed", bodies["note"]["body"])
+	assert.Equal(t, "close", bodies["update"]["state_event"])
+	assert.Equal(t, "It crashes.\n\n_Originally created by `bob` on 2021-05-03._\n\n<!-- glab-import: old/project#2 -->", bodies["update"]["description"])
+	assert.Equal(t, map[string]interface{}{
+		"target_project_id": "new/project",
+		"target_issue_iid":  "5",
+		"link_type":         "blocks",
+	}, bodies["link"])
+
+	assert.Equal(t, heredoc.Doc(`
+		skip   old/project#1 already imported as #5
+		✓ old/project#2 as #7 Crash on start
+
+		Imported 1 issue and 1 link, skipped 1 issue already imported
+	`), stdout.String())
+	assert.Equal(t, "! skipped the link from old/project#2 to old/project#9, which was not imported\n", stderr.String())
+}
+
+func Test_importRun_deletesPartialIssue(t *testing.T) {
+	tests := []struct {
+		name         string
+		deleteStatus int
+		wantErr      string
+	}{
+		{
+			name:         "deleted",
+			deleteStatus: 204,
+			wantErr:      "failed to add comment: POST https://gitlab.com/api/v4/projects/new/project/issues/7/notes: 403 {message: 403 Forbidden}",
+		},
+		{
+			name:         "delete fails",
+			deleteStatus: 403,
+			wantErr:      "failed to add comment: POST https://gitlab.com/api/v4/projects/new/project/issues/7/notes: 403 {message: 403 Forbidden}. The partially imported issue #7 could not be deleted: delete it before importing again",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Mocker{}
+			defer reg.Verify(t)
+			registerExisting(reg)
+
+			reg.RegisterResponder("POST", "/api/v4/projects/new/project/issues",
+				httpmock.NewStringResponse(201, `{"id": 102, "iid": 7, "title": "Crash on start"}`))
+			reg.RegisterResponder("POST", "/api/v4/projects/new/project/issues/7/notes",
+				httpmock.NewStringResponse(403, `{"message": "403 Forbidden"}`))
+			reg.RegisterResponder("DELETE", "/api/v4/projects/new/project/issues/7",
+				httpmock.NewStringResponse(tt.deleteStatus, ``))
+
+			opts, _, _ := newOpts(reg)
+
+			err := importRun(opts)
+			var exitErr *cmdutils.ExitError
+			require.True(t, errors.As(err, &exitErr))
+			assert.Equal(t, "failed to import old/project#2", exitErr.Details)
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,347 @@
+package issueutils
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/profclems/glab/api"
+
+	"github.com/xanzy/go-gitlab"
+)
+
+// ExportedIssue is an issue as written by "glab issue export" and read by "glab issue import"
+type ExportedIssue struct {
+	// Source is the full reference of the exported issue, e.g. "group/project#12"
+	Source       string          `json:"source"`
+	WebURL       string          `json:"web_url"`
+	Title        string          `json:"title"`
+	Description  string          `json:"description"`
+	State        string          `json:"state"`
+	Labels       []string        `json:"labels"`
+	Milestone    string          `json:"milestone,omitempty"`
+	Assignees    []string        `json:"assignees"`
+	Author       string          `json:"author"`
+	Confidential bool            `json:"confidential"`
+	DueDate      string          `json:"due_date,omitempty"`
+	CreatedAt    *time.Time      `json:"created_at,omitempty"`
+	ClosedAt     *time.Time      `json:"closed_at,omitempty"`
+	Links        []*ExportedLink `json:"links"`
+	Notes        []*ExportedNote `json:"notes"`
+}
+
+// ExportedLink is a link from an exported issue to another issue
+type ExportedLink struct {
+	Type string `json:"type"`
+	// Issue is the full reference of the linked issue
+	Issue string `json:"issue"`
+}
+
+// ExportedNote is a comment on an exported issue
+type ExportedNote struct {
+	Author    string     `json:"author"`
+	Body      string     `json:"body"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+}
+
+// The formats issues can be exported to
+const (
+	ExportFormatJSON = "json"
+	ExportFormatCSV  = "csv"
+)
+
+var exportCSVHeader = []string{
+	"source", "web_url", "title", "description", "state", "labels", "milestone", "assignees",
+	"author", "confidential", "due_date", "created_at", "closed_at", "links", "notes",
+}
+
+// ExportIssue converts the issue, fetching its comments and links. System notes are left out.
+func ExportIssue(client *gitlab.Client, projectPath string, issue *gitlab.Issue) (*ExportedIssue, error) {
+	exported := &ExportedIssue{
+		Source:       IssueFullRef(issue, projectPath),
+		WebURL:       issue.WebURL,
+		Title:        issue.Title,
+		Description:  issue.Description,
+		State:        issue.State,
+		Labels:       issue.Labels,
+		Confidential: issue.Confidential,
+		CreatedAt:    issue.CreatedAt,
+		ClosedAt:     issue.ClosedAt,
+		Assignees:    []string{},
+		Links:        []*ExportedLink{},
+		Notes:        []*ExportedNote{},
+	}
+	if exported.Labels == nil {
+		exported.Labels = []string{}
+	}
+	if issue.Author != nil {
+		exported.Author = issue.Author.Username
+	}
+	if issue.Milestone != nil {
+		exported.Milestone = issue.Milestone.Title
+	}
+	if issue.DueDate != nil {
+		exported.DueDate = time.Time(*issue.DueDate).Format("2006-01-02")
+	}
+	for _, assignee := range issue.Assignees {
+		exported.Assignees = append(exported.Assignees, assignee.Username)
+	}
+
+	notes, err := ListIssueComments(client, projectPath, issue.IID)
+	if err != nil {
+		return nil, err
+	}
+	exported.Notes = notes
+
+	links, err := api.ListIssueLinks(client, projectPath, issue.IID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the links of #%d: %w", issue.IID, err)
+	}
+	for _, link := range links {
+		exported.Links = append(exported.Links, &ExportedLink{
+			Type:  link.LinkType,
+			Issue: IssueFullRef(link.Issue, ""),
+		})
+	}
+
+	return exported, nil
+}
+
+// ListIssueComments returns the comments of an issue, oldest first. System notes are left out.
+func ListIssueComments(client *gitlab.Client, projectPath string, iid int) ([]*ExportedNote, error) {
+	comments := []*ExportedNote{}
+	opts := &gitlab.ListIssueNotesOptions{
+		Sort:    gitlab.String("asc"),
+		OrderBy: gitlab.String("created_at"),
+	}
+	opts.PerPage = 100
+	for opts.Page = 1; ; opts.Page++ {
+		notes, err := api.ListIssueNotes(client, projectPath, iid, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the comments of #%d: %w", iid, err)
+		}
+		for _, note := range notes {
+			if note.System {
+				continue
+			}
+			comments = append(comments, &ExportedNote{
+				Author:    note.Author.Username,
+				Body:      note.Body,
+				CreatedAt: note.CreatedAt,
+			})
+		}
+		if len(notes) < opts.PerPage {
+			break
+		}
+	}
+	return comments, nil
+}
+
+// AttributedBody returns the body of the comment headed by its original author and date,
+// for recreating it in another project
+func (n *ExportedNote) AttributedBody() string {
+	if n.Author == "" {
+		return n.Body
+	}
+	header := fmt.Sprintf("_`%s` commented", n.Author)
+	if n.CreatedAt != nil {
+		header += " on " + n.CreatedAt.Format("2006-01-02")
+	}
+	return header + ":_\n\n" + n.Body
+}
+
+// IssueFullRef returns the full reference of an issue, e.g. "group/project#12".
+// When the issue has no references, as with old instances, the project path is used
+// or, when empty, the project is taken from the URL of the issue.
+func IssueFullRef(issue *gitlab.Issue, projectPath string) string {
+	if issue.References != nil && issue.References.Full != "" {
+		return issue.References.Full
+	}
+	if projectPath == "" {
+		if _, repo := issueMetadataFromURL(issue.WebURL); repo != nil {
+			projectPath = repo.FullName()
+		}
+	}
+	return fmt.Sprintf("%s#%d", projectPath, issue.IID)
+}
+
+// WriteExportedIssues writes the issues in the given format
+func WriteExportedIssues(w io.Writer, format string, issues []*ExportedIssue) error {
+	if format == ExportFormatJSON {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(issues)
+	}
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(exportCSVHeader); err != nil {
+		return err
+	}
+	for _, issue := range issues {
+		var links []string
+		for _, link := range issue.Links {
+			links = append(links, link.Type+":"+link.Issue)
+		}
+		notes, err := json.Marshal(issue.Notes)
+		if err != nil {
+			return err
+		}
+		record := []string{
+			issue.Source,
+			issue.WebURL,
+			issue.Title,
+			issue.Description,
+			issue.State,
+			strings.Join(issue.Labels, ","),
+			issue.Milestone,
+			strings.Join(issue.Assignees, ","),
+			issue.Author,
+			strconv.FormatBool(issue.Confidential),
+			issue.DueDate,
+			formatExportTime(issue.CreatedAt),
+			formatExportTime(issue.ClosedAt),
+			strings.Join(links, ","),
+			string(notes),
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// ReadExportedIssues reads issues written by WriteExportedIssues
+func ReadExportedIssues(r io.Reader, format string) ([]*ExportedIssue, error) {
+	if format == ExportFormatJSON {
+		var issues []*ExportedIssue
+		if err := json.NewDecoder(r).Decode(&issues); err != nil {
+			return nil, fmt.Errorf("invalid JSON: %w", err)
+		}
+		return issues, nil
+	}
+
+	cr := csv.NewReader(r)
+	records, err := cr.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("invalid CSV: %w", err)
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	// columns are looked up by name so files edited in a spreadsheet can reorder or drop them
+	columns := map[string]int{}
+	for i, name := range records[0] {
+		columns[strings.TrimSpace(name)] = i
+	}
+	for _, required := range []string{"source", "title"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("invalid CSV: missing %q column", required)
+		}
+	}
+
+	var issues []*ExportedIssue
+	for n, record := range records[1:] {
+		get := func(column string) string {
+			if i, ok := columns[column]; ok && i < len(record) {
+				return record[i]
+			}
+			return ""
+		}
+
+		issue := &ExportedIssue{
+			Source:       get("source"),
+			WebURL:       get("web_url"),
+			Title:        get("title"),
+			Description:  get("description"),
+			State:        get("state"),
+			Labels:       splitList(get("labels")),
+			Milestone:    get("milestone"),
+			Assignees:    splitList(get("assignees")),
+			Author:       get("author"),
+			Confidential: get("confidential") == "true",
+			DueDate:      get("due_date"),
+			Links:        []*ExportedLink{},
+			Notes:        []*ExportedNote{},
+		}
+		line := n + 2
+		if issue.CreatedAt, err = parseExportTime(get("created_at")); err != nil {
+			return nil, fmt.Errorf("line %d: invalid created_at: %w", line, err)
+		}
+		if issue.ClosedAt, err = parseExportTime(get("closed_at")); err != nil {
+			return nil, fmt.Errorf("line %d: invalid closed_at: %w", line, err)
+		}
+		for _, link := range splitList(get("links")) {
+			i := strings.Index(link, ":")
+			if i < 1 {
+				return nil, fmt.Errorf("line %d: invalid link %q. Use the type:reference format", line, link)
+			}
+			issue.Links = append(issue.Links, &ExportedLink{Type: link[:i], Issue: link[i+1:]})
+		}
+		if notes := get("notes"); notes != "" {
+			if err := json.Unmarshal([]byte(notes), &issue.Notes); err != nil {
+				return nil, fmt.Errorf("line %d: invalid notes: %w", line, err)
+			}
+		}
+		issues = append(issues, issue)
+	}
+	return issues, nil
+}
+
+func splitList(s string) []string {
+	list := []string{}
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+func formatExportTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func parseExportTime(s string) (*time.Time, error) {
+	if s == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+var importMarkerRE = regexp.MustCompile(`<!-- glab-import: (\S+) -->`)
+
+// ImportMarker returns the comment added to the description of imported issues to
+// record where they were imported from
+func ImportMarker(source string) string {
+	return fmt.Sprintf("<!-- glab-import: %s -->", source)
+}
+
+// ImportedFrom returns the source recorded in the description of an imported issue.
+// Issues imported more than once keep the markers of the earlier imports, so the
+// last one is used.
+func ImportedFrom(description string) string {
+	m := importMarkerRE.FindAllStringSubmatch(description, -1)
+	if m == nil {
+		return ""
+	}
+	return m[len(m)-1][1]
+}
+
+// StripImportMarkers removes the import markers from a description
+func StripImportMarkers(description string) string {
+	return strings.TrimSpace(importMarkerRE.ReplaceAllString(description, ""))
+}
@@ -0,0 +1,81 @@
+package issueutils
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ExportedIssues_roundTrip(t *testing.T) {
+	created := time.Date(2021, 5, 3, 10, 0, 0, 0, time.UTC)
+	issues := []*ExportedIssue{
+		{
+			Source:      "group/project#12",
+			WebURL:      "https://gitlab.com/group/project/-/issues/12",
+			Title:       "Crash, on start",
+			Description: "It crashes.\n\nEvery \"time\".",
+			State:       "closed",
+			Labels:      []string{"bug", "p1"},
+			Milestone:   "1.0",
+			Assignees:   []string{"alice"},
+			Author:      "bob",
+			DueDate:     "2021-06-01",
+			CreatedAt:   &created,
+			Links: []*ExportedLink{
+				{Type: LinkBlocks, Issue: "group/project#13"},
+				{Type: LinkRelatesTo, Issue: "other/project#2"},
+			},
+			Notes: []*ExportedNote{
+				{Author: "alice", Body: "Confirmed,\nsee logs", CreatedAt: &created},
+			},
+		},
+		{
+			Source:    "group/project#13",
+			Title:     "Follow-up",
+			State:     "opened",
+			Labels:    []string{},
+			Assignees: []string{},
+			Links:     []*ExportedLink{},
+			Notes:     []*ExportedNote{},
+		},
+	}
+
+	for _, format := range []string{ExportFormatJSON, ExportFormatCSV} {
+		t.Run(format, func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, WriteExportedIssues(&buf, format, issues))
+
+			read, err := ReadExportedIssues(&buf, format)
+			require.NoError(t, err)
+			assert.Equal(t, issues, read)
+		})
+	}
+}
+
+func Test_ReadExportedIssues_csvColumns(t *testing.T) {
+	// columns may be reordered or left out
+	csv := "title,labels,source\nFirst,\"a, b\",old/project#1\n"
+
+	issues, err := ReadExportedIssues(bytes.NewBufferString(csv), ExportFormatCSV)
+	require.NoError(t, err)
+	require.Len(t, issues, 1)
+	assert.Equal(t, "old/project#1", issues[0].Source)
+	assert.Equal(t, "First", issues[0].Title)
+	assert.Equal(t, []string{"a", "b"}, issues[0].Labels)
+
+	_, err = ReadExportedIssues(bytes.NewBufferString("title\nFirst\n"), ExportFormatCSV)
+	assert.EqualError(t, err, `invalid CSV: missing "source" column`)
+}
+
+func Test_ImportMarker(t *testing.T) {
+	description := "Some text\n\n" + ImportMarker("group/project#12")
+	assert.Equal(t, "group/project#12", ImportedFrom(description))
+	assert.Equal(t, "", ImportedFrom("Some text"))
+
+	description = ImportMarker("a/project#1") + "\n\n" + description
+	assert.Equal(t, "group/project#12", ImportedFrom(description))
+	assert.Equal(t, "Some text", StripImportMarkers(description))
+}
@@ -0,0 +1,104 @@
+package issueutils
+
+import (
+	"errors"
+
+	"github.com/profclems/glab/api"
+
+	"github.com/spf13/pflag"
+	"github.com/xanzy/go-gitlab"
+)
+
+// IssueFilter selects issues with a subset of the filters of "glab issue list"
+type IssueFilter struct {
+	Labels    []string
+	NotLabels []string
+	Milestone string
+	Author    string
+	Assignee  string
+	Search    string
+	Closed    bool
+	All       bool
+}
+
+// AddFlags registers the filter flags
+func (f *IssueFilter) AddFlags(fs *pflag.FlagSet) {
+	fs.StringSliceVarP(&f.Labels, "label", "l", []string{}, "Select issues with label <name>")
+	fs.StringSliceVar(&f.NotLabels, "not-label", []string{}, "Select issues without label <name>")
+	fs.StringVarP(&f.Milestone, "milestone", "m", "", "Select issues in milestone <title>")
+	fs.StringVar(&f.Author, "author", "", "Select issues by author <username>")
+	fs.StringVarP(&f.Assignee, "assignee", "a", "", "Select issues assigned to <username>")
+	fs.StringVar(&f.Search, "search", "", "Select issues with <string> in the title or description")
+	fs.BoolVarP(&f.Closed, "closed", "c", false, "Select closed issues instead of open ones")
+	fs.BoolVarP(&f.All, "all", "A", false, "Select both open and closed issues")
+}
+
+// Validate checks that the filters do not conflict
+func (f *IssueFilter) Validate() error {
+	if f.Closed && f.All {
+		return errors.New("--closed and --all are mutually exclusive")
+	}
+	return nil
+}
+
+// IsSet reports whether any filter other than the state is set
+func (f *IssueFilter) IsSet() bool {
+	return len(f.Labels) > 0 || len(f.NotLabels) > 0 || f.Milestone != "" ||
+		f.Author != "" || f.Assignee != "" || f.Search != ""
+}
+
+// ListFilteredIssues lists every issue of the project matching the filter
+func ListFilteredIssues(client *gitlab.Client, projectID interface{}, f *IssueFilter) ([]*gitlab.Issue, error) {
+	l := &gitlab.ListProjectIssuesOptions{
+		State: gitlab.String("opened"),
+	}
+	if f.Closed {
+		l.State = gitlab.String("closed")
+	} else if f.All {
+		l.State = gitlab.String("all")
+	}
+	if len(f.Labels) > 0 {
+		l.Labels = f.Labels
+	}
+	if len(f.NotLabels) > 0 {
+		l.NotLabels = f.NotLabels
+	}
+	if f.Milestone != "" {
+		l.Milestone = gitlab.String(f.Milestone)
+	}
+	if f.Search != "" {
+		l.Search = gitlab.String(f.Search)
+	}
+	if f.Assignee != "" {
+		assignee := f.Assignee
+		if assignee == "@me" {
+			u, err := api.CurrentUser(client)
+			if err != nil {
+				return nil, err
+			}
+			assignee = u.Username
+		}
+		l.AssigneeUsername = gitlab.String(assignee)
+	}
+	if f.Author != "" {
+		u, err := api.UserByName(client, f.Author)
+		if err != nil {
+			return nil, err
+		}
+		l.AuthorID = gitlab.Int(u.ID)
+	}
+
+	var issues []*gitlab.Issue
+	l.PerPage = 100
+	for l.Page = 1; ; l.Page++ {
+		page, err := api.ListIssues(client, projectID, l)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, page...)
+		if len(page) < l.PerPage {
+			break
+		}
+	}
+	return issues, nil
+}
@@ -0,0 +1,207 @@
+package issueutils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+
+	"github.com/xanzy/go-gitlab"
+)
+
+// The types of links between issues, relative to the issue the link is listed for
+const (
+	LinkRelatesTo   = "relates_to"
+	LinkBlocks      = "blocks"
+	LinkIsBlockedBy = "is_blocked_by"
+)
+
+// LinkTypes lists the valid issue link types
+var LinkTypes = []string{LinkRelatesTo, LinkBlocks, LinkIsBlockedBy}
+
+// LinkTypeDescription returns how a link of the given type reads in a sentence,
+// e.g. "is blocked by"
+func LinkTypeDescription(linkType string) string {
+	switch linkType {
+	case LinkBlocks:
+		return "blocks"
+	case LinkIsBlockedBy:
+		return "is blocked by"
+	default:
+		return "relates to"
+	}
+}
+
+// ParseIssueRef parses an issue reference which can be an issue number ("12" or "#12"),
+// a reference to an issue of another project ("group/project#12") or an issue URL.
+// It returns the full path of the project of the issue and its IID.
+func ParseIssueRef(arg string, baseRepoFn func() (glrepo.Interface, error)) (string, int, error) {
+	if iid, repo := issueMetadataFromURL(arg); iid != 0 {
+		return repo.FullName(), iid, nil
+	}
+
+	project := ""
+	ref := arg
+	if i := strings.LastIndex(arg, "#"); i > 0 {
+		project, ref = arg[:i], arg[i+1:]
+	}
+	iid, err := strconv.Atoi(strings.TrimPrefix(ref, "#"))
+	if err != nil || iid <= 0 {
+		return "", 0, fmt.Errorf("invalid issue format: %q", arg)
+	}
+
+	if project == "" {
+		repo, err := baseRepoFn()
+		if err != nil {
+			return "", 0, fmt.Errorf("could not determine base repo: %w", err)
+		}
+		project = repo.FullName()
+	}
+	return project, iid, nil
+}
+
+// DependencyNode is an issue in a dependency tree
+type DependencyNode struct {
+	Issue    *gitlab.Issue
+	Children []*DependencyNode
+	// Cycle is set when the issue is already on the path from the root, so it is not expanded
+	Cycle bool
+	// Repeated is set when the issue is expanded elsewhere in the tree
+	Repeated bool
+}
+
+// LinkFetcher lists the links of an issue
+type LinkFetcher func(projectID int, issueIID int) ([]*api.LinkedIssue, error)
+
+// DependencyTree walks the links of the given type from the root issue, for example
+// "is_blocked_by" to find the issues which have to be resolved first. Walking stops
+// at maxDepth levels below the root, or continues until the end of every chain
+// when maxDepth is 0.
+//
+// The cycles found are returned as the chain of issues from the first to the
+// repeated issue.
+func DependencyTree(root *gitlab.Issue, linkType string, maxDepth int, fetch LinkFetcher) (*DependencyNode, [][]*gitlab.Issue, error) {
+	var cycles [][]*gitlab.Issue
+	expanded := map[string]bool{}
+	var path []*gitlab.Issue
+
+	var walk func(issue *gitlab.Issue, depth int) (*DependencyNode, error)
+	walk = func(issue *gitlab.Issue, depth int) (*DependencyNode, error) {
+		node := &DependencyNode{Issue: issue}
+		key := issueKey(issue)
+
+		for i, p := range path {
+			if issueKey(p) == key {
+				node.Cycle = true
+				cycle := append([]*gitlab.Issue{}, path[i:]...)
+				cycles = append(cycles, append(cycle, issue))
+				return node, nil
+			}
+		}
+		if expanded[key] {
+			node.Repeated = true
+			return node, nil
+		}
+		expanded[key] = true
+
+		if maxDepth > 0 && depth >= maxDepth {
+			return node, nil
+		}
+
+		links, err := fetch(issue.ProjectID, issue.IID)
+		if err != nil {
+			return nil, err
+		}
+
+		path = append(path, issue)
+		defer func() { path = path[:len(path)-1] }()
+		for _, link := range links {
+			if link.LinkType != linkType {
+				continue
+			}
+			child, err := walk(link.Issue, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			node.Children = append(node.Children, child)
+		}
+		return node, nil
+	}
+
+	tree, err := walk(root, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+	return tree, cycles, nil
+}
+
+// RenderDependencyTree draws the tree with one issue per line. Issues of other
+// projects than the root's are shown with their full reference.
+func RenderDependencyTree(c *iostreams.ColorPalette, tree *DependencyNode) string {
+	var b strings.Builder
+	projectID := tree.Issue.ProjectID
+
+	var render func(node *DependencyNode, prefix, branch string)
+	render = func(node *DependencyNode, prefix, branch string) {
+		b.WriteString(prefix + branch + dependencyLine(c, node, projectID) + "\n")
+
+		childPrefix := prefix
+		switch branch {
+		case "├── ":
+			childPrefix += "│   "
+		case "└── ":
+			childPrefix += "    "
+		}
+		for i, child := range node.Children {
+			if i == len(node.Children)-1 {
+				render(child, childPrefix, "└── ")
+			} else {
+				render(child, childPrefix, "├── ")
+			}
+		}
+	}
+	render(tree, "", "")
+	return b.String()
+}
+
+func dependencyLine(c *iostreams.ColorPalette, node *DependencyNode, projectID int) string {
+	issue := node.Issue
+	line := IssueRef(issue, projectID)
+	if issue.State == "closed" {
+		line = c.Gray(line + " " + issue.Title + " (closed)")
+	} else {
+		line = c.Green(line) + " " + issue.Title
+	}
+
+	if node.Cycle {
+		line += " " + c.Red("(cycle)")
+	} else if node.Repeated {
+		line += " " + c.Gray("(see above)")
+	}
+	return line
+}
+
+// IssueRef returns "#<iid>" for issues of the given project, or the full reference
+// for issues of other projects
+func IssueRef(issue *gitlab.Issue, projectID int) string {
+	if issue.ProjectID != projectID && issue.References != nil && issue.References.Full != "" {
+		return issue.References.Full
+	}
+	return fmt.Sprintf("#%d", issue.IID)
+}
+
+// FormatCycle formats a dependency cycle as a chain, e.g. "#1 → #2 → #1"
+func FormatCycle(cycle []*gitlab.Issue, projectID int) string {
+	refs := make([]string, 0, len(cycle))
+	for _, issue := range cycle {
+		refs = append(refs, IssueRef(issue, projectID))
+	}
+	return strings.Join(refs, " → ")
+}
+
+func issueKey(issue *gitlab.Issue) string {
+	return fmt.Sprintf("%d#%d", issue.ProjectID, issue.IID)
+}
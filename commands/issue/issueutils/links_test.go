@@ -0,0 +1,97 @@
+package issueutils
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xanzy/go-gitlab"
+)
+
+func Test_ParseIssueRef(t *testing.T) {
+	baseRepo := func() (glrepo.Interface, error) {
+		return glrepo.FromFullName("owner/repo")
+	}
+
+	tests := []struct {
+		arg     string
+		project string
+		iid     int
+		wantErr bool
+	}{
+		{arg: "12", project: "owner/repo", iid: 12},
+		{arg: "#12", project: "owner/repo", iid: 12},
+		{arg: "group/sub/project#7", project: "group/sub/project", iid: 7},
+		{arg: "https://gitlab.com/group/project/-/issues/3", project: "group/project", iid: 3},
+		{arg: "group/project#", wantErr: true},
+		{arg: "abc", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.arg, func(t *testing.T) {
+			project, iid, err := ParseIssueRef(tt.arg, baseRepo)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.project, project)
+			assert.Equal(t, tt.iid, iid)
+		})
+	}
+}
+
+func Test_DependencyTree(t *testing.T) {
+	issue := func(iid int, state string) *gitlab.Issue {
+		return &gitlab.Issue{ProjectID: 1, IID: iid, Title: fmt.Sprintf("Issue %d", iid), State: state}
+	}
+	blockedBy := map[int][]*api.LinkedIssue{
+		1: {
+			{Issue: issue(2, "opened"), LinkType: LinkIsBlockedBy},
+			{Issue: issue(3, "closed"), LinkType: LinkIsBlockedBy},
+			{Issue: issue(9, "opened"), LinkType: LinkRelatesTo},
+		},
+		2: {
+			{Issue: issue(4, "opened"), LinkType: LinkIsBlockedBy},
+			{Issue: issue(1, "opened"), LinkType: LinkBlocks},
+		},
+		3: {
+			{Issue: issue(4, "opened"), LinkType: LinkIsBlockedBy},
+		},
+		4: {
+			{Issue: issue(2, "opened"), LinkType: LinkIsBlockedBy},
+		},
+	}
+	fetched := map[int]int{}
+	fetch := func(projectID int, iid int) ([]*api.LinkedIssue, error) {
+		fetched[iid]++
+		return blockedBy[iid], nil
+	}
+
+	streams, _, _, _ := iostreams.Test()
+	tree, cycles, err := DependencyTree(issue(1, "opened"), LinkIsBlockedBy, 0, fetch)
+	require.NoError(t, err)
+
+	assert.Equal(t, heredoc.Doc(`
+		#1 Issue 1
+		├── #2 Issue 2
+		│   └── #4 Issue 4
+		│       └── #2 Issue 2 (cycle)
+		└── #3 Issue 3 (closed)
+		    └── #4 Issue 4 (see above)
+	`), RenderDependencyTree(streams.Color(), tree))
+
+	require.Len(t, cycles, 1)
+	assert.Equal(t, "#2 → #4 → #2", FormatCycle(cycles[0], 1))
+	assert.Equal(t, map[int]int{1: 1, 2: 1, 3: 1, 4: 1}, fetched)
+
+	tree, cycles, err = DependencyTree(issue(1, "opened"), LinkIsBlockedBy, 1, fetch)
+	require.NoError(t, err)
+	assert.Len(t, cycles, 0)
+	assert.Len(t, tree.Children, 2)
+	assert.Len(t, tree.Children[0].Children, 0)
+}
@@ -0,0 +1,111 @@
+package link
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/commands/issue/issueutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/profclems/glab/pkg/utils"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type LinkOpts struct {
+	Issue    string
+	Targets  []string
+	LinkType string
+
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+	HTTPClient func() (*gitlab.Client, error)
+}
+
+func NewCmdLink(f *cmdutils.Factory, runE func(*LinkOpts) error) *cobra.Command {
+	opts := &LinkOpts{
+		IO: f.IO,
+	}
+
+	var issueLinkCmd = &cobra.Command{
+		Use:   "link <id> <target-id>... [flags]",
+		Short: `Link an issue to other issues`,
+		Long: heredoc.Doc(`
+			Link an issue to other issues.
+
+			The link type is relative to the first issue: with --type blocks, the first issue
+			blocks the target issues. Target issues of other projects are given as
+			"group/project#123" or by URL.
+		`),
+		Example: heredoc.Doc(`
+			$ glab issue link 42 43
+			$ glab issue link 42 43 44 --type blocks
+			$ glab issue link 42 other-group/other-project#7 --type is_blocked_by
+		`),
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+			opts.HTTPClient = f.HttpClient
+			opts.Issue = args[0]
+			opts.Targets = args[1:]
+
+			if !utils.PresentInStringSlice(issueutils.LinkTypes, opts.LinkType) {
+				return &cmdutils.FlagError{Err: fmt.Errorf("invalid link type %q. Must be one of %s", opts.LinkType, strings.Join(issueutils.LinkTypes, ", "))}
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return linkRun(opts)
+		},
+	}
+
+	issueLinkCmd.Flags().StringVarP(&opts.LinkType, "type", "t", issueutils.LinkRelatesTo, "Type of the link: relates_to, blocks, is_blocked_by")
+
+	return issueLinkCmd
+}
+
+func linkRun(opts *LinkOpts) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	project, iid, err := issueutils.ParseIssueRef(opts.Issue, opts.BaseRepo)
+	if err != nil {
+		return err
+	}
+
+	c := opts.IO.Color()
+	for _, target := range opts.Targets {
+		targetProject, targetIID, err := issueutils.ParseIssueRef(target, opts.BaseRepo)
+		if err != nil {
+			return err
+		}
+
+		_, _, err = api.LinkIssues(apiClient, project, iid, &gitlab.CreateIssueLinkOptions{
+			TargetProjectID: gitlab.String(targetProject),
+			TargetIssueIID:  gitlab.String(strconv.Itoa(targetIID)),
+			LinkType:        gitlab.String(opts.LinkType),
+		})
+		if err != nil {
+			return cmdutils.WrapError(err, fmt.Sprintf("failed to link #%d to %s", iid, target))
+		}
+
+		fmt.Fprintf(opts.IO.StdOut, "%s #%d %s %s\n", c.GreenCheck(), iid, issueutils.LinkTypeDescription(opts.LinkType), displayRef(project, targetProject, targetIID))
+	}
+	return nil
+}
+
+func displayRef(project, targetProject string, targetIID int) string {
+	if targetProject == project {
+		return fmt.Sprintf("#%d", targetIID)
+	}
+	return fmt.Sprintf("%s#%d", targetProject, targetIID)
+}
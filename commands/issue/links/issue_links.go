@@ -0,0 +1,153 @@
+package links
+
+import (
+	"fmt"
+
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/commands/issue/issueutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/profclems/glab/pkg/tableprinter"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type LinksOpts struct {
+	Issue   string
+	Tree    bool
+	Reverse bool
+	Depth   int
+
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+	HTTPClient func() (*gitlab.Client, error)
+}
+
+func NewCmdLinks(f *cmdutils.Factory, runE func(*LinksOpts) error) *cobra.Command {
+	opts := &LinksOpts{
+		IO: f.IO,
+	}
+
+	var issueLinksCmd = &cobra.Command{
+		Use:   "links <id> [flags]",
+		Short: `List the issues linked to an issue`,
+		Long: heredoc.Doc(`
+			List the issues linked to an issue, grouped by link type.
+
+			With --tree, the blocking links are followed to show the chain of issues which
+			have to be resolved before the issue, or with --reverse the issues it blocks.
+			Dependency cycles are reported as warnings.
+		`),
+		Example: heredoc.Doc(`
+			$ glab issue links 42
+			$ glab issue links 42 --tree
+			$ glab issue links 42 --tree --reverse --depth 2
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+			opts.HTTPClient = f.HttpClient
+			opts.Issue = args[0]
+
+			if (opts.Reverse || cmd.Flags().Changed("depth")) && !opts.Tree {
+				return &cmdutils.FlagError{Err: fmt.Errorf("--reverse and --depth can only be used with --tree")}
+			}
+			if opts.Depth < 0 {
+				return &cmdutils.FlagError{Err: fmt.Errorf("--depth must not be negative")}
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return linksRun(opts)
+		},
+	}
+
+	issueLinksCmd.Flags().BoolVar(&opts.Tree, "tree", false, "Show the blocking dependencies as a tree")
+	issueLinksCmd.Flags().BoolVar(&opts.Reverse, "reverse", false, "Show the issues blocked by the issue instead of the ones blocking it")
+	issueLinksCmd.Flags().IntVar(&opts.Depth, "depth", 0, "Maximum depth of the tree. 0 shows every level")
+
+	return issueLinksCmd
+}
+
+func linksRun(opts *LinksOpts) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	project, iid, err := issueutils.ParseIssueRef(opts.Issue, opts.BaseRepo)
+	if err != nil {
+		return err
+	}
+
+	issue, err := api.GetIssue(apiClient, project, iid)
+	if err != nil {
+		return err
+	}
+
+	if opts.Tree {
+		return treeRun(opts, apiClient, issue)
+	}
+
+	links, err := api.ListIssueLinks(apiClient, project, iid)
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to get the links of the issue")
+	}
+
+	c := opts.IO.Color()
+	if len(links) == 0 {
+		fmt.Fprintf(opts.IO.StdErr, "#%d has no linked issues\n", issue.IID)
+		return nil
+	}
+
+	printed := false
+	for _, linkType := range []string{issueutils.LinkBlocks, issueutils.LinkIsBlockedBy, issueutils.LinkRelatesTo} {
+		table := tableprinter.NewTablePrinter()
+		for _, link := range links {
+			if link.LinkType != linkType {
+				continue
+			}
+			state := c.Green(link.State)
+			if link.State == "closed" {
+				state = c.Gray(link.State)
+			}
+			table.AddRow(issueutils.IssueRef(link.Issue, issue.ProjectID), link.Title, state)
+		}
+		if len(table.Rows) == 0 {
+			continue
+		}
+		if printed {
+			fmt.Fprintln(opts.IO.StdOut)
+		}
+		printed = true
+		fmt.Fprintf(opts.IO.StdOut, "#%d %s:\n%s", issue.IID, c.Bold(issueutils.LinkTypeDescription(linkType)), table.String())
+	}
+	return nil
+}
+
+func treeRun(opts *LinksOpts, apiClient *gitlab.Client, issue *gitlab.Issue) error {
+	linkType := issueutils.LinkIsBlockedBy
+	if opts.Reverse {
+		linkType = issueutils.LinkBlocks
+	}
+
+	tree, cycles, err := issueutils.DependencyTree(issue, linkType, opts.Depth, func(projectID int, issueIID int) ([]*api.LinkedIssue, error) {
+		return api.ListIssueLinks(apiClient, projectID, issueIID)
+	})
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to get the links of the issues")
+	}
+
+	c := opts.IO.Color()
+	fmt.Fprint(opts.IO.StdOut, issueutils.RenderDependencyTree(c, tree))
+
+	for _, cycle := range cycles {
+		fmt.Fprintf(opts.IO.StdErr, "%s Dependency cycle: %s\n", c.WarnIcon(), issueutils.FormatCycle(cycle, issue.ProjectID))
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package move
+
+import (
+	"fmt"
+
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/commands/issue/issueutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type MoveOpts struct {
+	Issue  string
+	Target string
+
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+	HTTPClient func() (*gitlab.Client, error)
+}
+
+func NewCmdMove(f *cmdutils.Factory, runE func(*MoveOpts) error) *cobra.Command {
+	opts := &MoveOpts{
+		IO: f.IO,
+	}
+
+	var issueMoveCmd = &cobra.Command{
+		Use:   "move <id> <target-project>",
+		Short: `Move an issue to another project`,
+		Long: heredoc.Doc(`
+			Move an issue to another project.
+
+			The issue is closed in its project and recreated in the target project together
+			with its comments and metadata. Labels and milestones which do not exist in the
+			target project are dropped by GitLab.
+		`),
+		Example: heredoc.Doc(`
+			$ glab issue move 42 other-group/other-project
+			$ glab issue move https://gitlab.com/group/project/-/issues/42 group/archive
+		`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+			opts.HTTPClient = f.HttpClient
+			opts.Issue = args[0]
+			opts.Target = args[1]
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return moveRun(opts)
+		},
+	}
+
+	return issueMoveCmd
+}
+
+func moveRun(opts *MoveOpts) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	project, iid, err := issueutils.ParseIssueRef(opts.Issue, opts.BaseRepo)
+	if err != nil {
+		return err
+	}
+
+	targetRepo, err := glrepo.FromFullName(opts.Target)
+	if err != nil {
+		return err
+	}
+	target, err := api.GetProject(apiClient, targetRepo.FullName())
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to find project %s", targetRepo.FullName()))
+	}
+
+	moved, err := api.MoveIssue(apiClient, project, iid, target.ID)
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to move %s#%d", project, iid))
+	}
+
+	c := opts.IO.Color()
+	fmt.Fprintf(opts.IO.StdOut, "%s Moved %s#%d to %s#%d\n", c.GreenCheck(), project, iid, target.PathWithNamespace, moved.IID)
+	fmt.Fprintln(opts.IO.StdOut, moved.WebURL)
+	return nil
+}
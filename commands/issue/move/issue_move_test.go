@@ -0,0 +1,77 @@
+package move
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/httpmock"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xanzy/go-gitlab"
+)
+
+func newOpts(reg *httpmock.Mocker, issue string) (*MoveOpts, *bytes.Buffer) {
+	io, _, stdout, _ := iostreams.Test()
+	opts := &MoveOpts{
+		Issue:  issue,
+		Target: "other/project",
+		IO:     io,
+		BaseRepo: func() (glrepo.Interface, error) {
+			return glrepo.FromFullName("owner/repo")
+		},
+		HTTPClient: func() (*gitlab.Client, error) {
+			a, _ := api.TestClient(&http.Client{Transport: reg}, "", "gitlab.com", false)
+			return a.Lab(), nil
+		},
+	}
+	_, _ = opts.HTTPClient()
+	return opts, stdout
+}
+
+func Test_moveRun(t *testing.T) {
+	reg := &httpmock.Mocker{}
+	defer reg.Verify(t)
+
+	var body map[string]interface{}
+	reg.RegisterResponder("GET", "/api/v4/projects/other/project",
+		httpmock.NewStringResponse(200, `{"id": 77, "path_with_namespace": "other/project"}`))
+	reg.RegisterResponder("POST", "/api/v4/projects/owner/repo/issues/42/move",
+		func(req *http.Request) (*http.Response, error) {
+			b, err := ioutil.ReadAll(req.Body)
+			require.NoError(t, err)
+			require.NoError(t, json.Unmarshal(b, &body))
+			return httpmock.NewStringResponse(200, `{"id": 2, "iid": 9,
+				"web_url": "https://gitlab.com/other/project/-/issues/9"}`)(req)
+		})
+
+	opts, stdout := newOpts(reg, "42")
+
+	err := moveRun(opts)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]interface{}{"to_project_id": float64(77)}, body)
+	assert.Equal(t, "✓ Moved owner/repo#42 to other/project#9\nhttps://gitlab.com/other/project/-/issues/9\n", stdout.String())
+}
+
+func Test_moveRun_targetNotFound(t *testing.T) {
+	reg := &httpmock.Mocker{}
+	defer reg.Verify(t)
+
+	reg.RegisterResponder("GET", "/api/v4/projects/other/project",
+		httpmock.NewStringResponse(404, `{"message": "404 Project Not Found"}`))
+
+	opts, _ := newOpts(reg, "42")
+
+	err := moveRun(opts)
+	var exitErr *cmdutils.ExitError
+	require.True(t, errors.As(err, &exitErr))
+	assert.Equal(t, "failed to find project other/project", exitErr.Details)
+}
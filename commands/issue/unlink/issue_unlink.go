@@ -0,0 +1,113 @@
+package unlink
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/commands/issue/issueutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type UnlinkOpts struct {
+	Issue   string
+	Targets []string
+
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+	HTTPClient func() (*gitlab.Client, error)
+}
+
+func NewCmdUnlink(f *cmdutils.Factory, runE func(*UnlinkOpts) error) *cobra.Command {
+	opts := &UnlinkOpts{
+		IO: f.IO,
+	}
+
+	var issueUnlinkCmd = &cobra.Command{
+		Use:   "unlink <id> <target-id>...",
+		Short: `Remove links between issues`,
+		Long:  ``,
+		Example: heredoc.Doc(`
+			$ glab issue unlink 42 43
+			$ glab issue unlink 42 other-group/other-project#7
+		`),
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+			opts.HTTPClient = f.HttpClient
+			opts.Issue = args[0]
+			opts.Targets = args[1:]
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return unlinkRun(opts)
+		},
+	}
+
+	return issueUnlinkCmd
+}
+
+func unlinkRun(opts *UnlinkOpts) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	project, iid, err := issueutils.ParseIssueRef(opts.Issue, opts.BaseRepo)
+	if err != nil {
+		return err
+	}
+
+	links, err := api.ListIssueLinks(apiClient, project, iid)
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to get the links of the issue")
+	}
+
+	c := opts.IO.Color()
+	for _, target := range opts.Targets {
+		targetProject, targetIID, err := issueutils.ParseIssueRef(target, opts.BaseRepo)
+		if err != nil {
+			return err
+		}
+
+		link := findLink(links, targetProject, targetIID)
+		if link == nil {
+			return fmt.Errorf("#%d is not linked to %s", iid, target)
+		}
+
+		err = api.DeleteIssueLink(apiClient, project, iid, link.IssueLinkID)
+		if err != nil {
+			return cmdutils.WrapError(err, fmt.Sprintf("failed to unlink %s", target))
+		}
+
+		fmt.Fprintf(opts.IO.StdOut, "%s Removed the link between #%d and %s\n", c.RedCheck(), iid, target)
+	}
+	return nil
+}
+
+func findLink(links []*api.LinkedIssue, project string, iid int) *api.LinkedIssue {
+	for _, link := range links {
+		if link.IID != iid {
+			continue
+		}
+		if link.References != nil && link.References.Full != "" {
+			if strings.EqualFold(link.References.Full, fmt.Sprintf("%s#%d", project, iid)) {
+				return link
+			}
+			continue
+		}
+		// older instances do not return references
+		if strings.Contains(strings.ToLower(link.WebURL), "/"+strings.ToLower(project)+"/") {
+			return link
+		}
+	}
+	return nil
+}
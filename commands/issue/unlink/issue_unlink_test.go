@@ -0,0 +1,54 @@
+package unlink
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/httpmock"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xanzy/go-gitlab"
+)
+
+func Test_unlinkRun(t *testing.T) {
+	reg := &httpmock.Mocker{}
+	defer reg.Verify(t)
+
+	reg.RegisterResponder("GET", "/api/v4/projects/owner/repo/issues/42/links",
+		httpmock.NewStringResponse(200, `[
+			{"id": 1, "iid": 7, "project_id": 2, "issue_link_id": 10, "link_type": "relates_to",
+			 "references": {"full": "other/project#7"}},
+			{"id": 2, "iid": 7, "project_id": 1, "issue_link_id": 11, "link_type": "blocks",
+			 "references": {"full": "owner/repo#7"}}
+		]`))
+	reg.RegisterResponder("DELETE", "/api/v4/projects/owner/repo/issues/42/links/11",
+		httpmock.NewStringResponse(200, `{}`))
+
+	io, _, stdout, _ := iostreams.Test()
+	opts := &UnlinkOpts{
+		Issue:   "42",
+		Targets: []string{"#7"},
+		IO:      io,
+		BaseRepo: func() (glrepo.Interface, error) {
+			return glrepo.FromFullName("owner/repo")
+		},
+		HTTPClient: func() (*gitlab.Client, error) {
+			a, _ := api.TestClient(&http.Client{Transport: reg}, "", "gitlab.com", false)
+			return a.Lab(), nil
+		},
+	}
+	_, _ = opts.HTTPClient()
+
+	err := unlinkRun(opts)
+	require.NoError(t, err)
+	assert.Equal(t, "✓ Removed the link between #42 and #7\n", stdout.String())
+
+	opts.Targets = []string{"owner/repo#8"}
+	reg.RegisterResponder("GET", "/api/v4/projects/owner/repo/issues/42/links",
+		httpmock.NewStringResponse(200, `[]`))
+	err = unlinkRun(opts)
+	assert.EqualError(t, err, "#42 is not linked to owner/repo#8")
+}
@@ -1,6 +1,7 @@
 package create
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/MakeNowJust/heredoc"
@@ -21,6 +22,8 @@ func NewCmdCreate(f *cmdutils.Factory) *cobra.Command {
 			$ glab label create
 			$ glab label new
 			$ glab label create -R owner/repo
+			$ glab label create -n bug --priority 1
+			$ glab label create -n release -g mygroup
 		`),
 		Args: cobra.ExactArgs(0),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -32,9 +35,9 @@ func NewCmdCreate(f *cmdutils.Factory) *cobra.Command {
 				return err
 			}
 
-			repo, err := f.BaseRepo()
-			if err != nil {
-				return err
+			group, _ := cmd.Flags().GetString("group")
+			if group != "" && cmd.Flags().Changed("priority") {
+				return &cmdutils.FlagError{Err: errors.New("group labels have no priority")}
 			}
 
 			l := &gitlab.CreateLabelOptions{}
@@ -49,10 +52,34 @@ func NewCmdCreate(f *cmdutils.Factory) *cobra.Command {
 			if s, _ := cmd.Flags().GetString("description"); s != "" {
 				l.Description = gitlab.String(s)
 			}
+			if group != "" {
+				label, err := api.CreateGroupLabel(apiClient, group, (*gitlab.CreateGroupLabelOptions)(l))
+				if err != nil {
+					return err
+				}
+				fmt.Fprintf(f.IO.StdOut, "Created label: %s\nWith color: %s\n", label.Name, label.Color)
+				return nil
+			}
+
+			repo, err := f.BaseRepo()
+			if err != nil {
+				return err
+			}
+
 			label, err := api.CreateLabel(apiClient, repo.FullName(), l)
 			if err != nil {
 				return err
 			}
+			if cmd.Flags().Changed("priority") {
+				priority, _ := cmd.Flags().GetInt("priority")
+				// the label creation options do not include the priority
+				label, err = api.UpdateLabel(apiClient, repo.FullName(), label.Name, &api.UpdateLabelOptions{
+					Priority: gitlab.Int(priority),
+				})
+				if err != nil {
+					return err
+				}
+			}
 			fmt.Fprintf(f.IO.StdOut, "Created label: %s\nWith color: %s\n", label.Name, label.Color)
 
 			return nil
@@ -62,6 +89,8 @@ func NewCmdCreate(f *cmdutils.Factory) *cobra.Command {
 	_ = labelCreateCmd.MarkFlagRequired("name")
 	labelCreateCmd.Flags().StringP("color", "c", "#428BCA", "Color of label in plain or HEX code. (Default: #428BCA)")
 	labelCreateCmd.Flags().StringP("description", "d", "", "Label description")
+	labelCreateCmd.Flags().IntP("priority", "p", 0, "Label priority. Lower numbers have higher priority")
+	labelCreateCmd.Flags().StringP("group", "g", "", "Create a group label instead of a project label")
 
 	return labelCreateCmd
 }
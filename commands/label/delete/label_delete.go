@@ -0,0 +1,103 @@
+package delete
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/profclems/glab/pkg/prompt"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type DeleteOpts struct {
+	HTTPClient func() (*gitlab.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+
+	Names       []string
+	Group       string
+	ForceDelete bool
+}
+
+func NewCmdDelete(f *cmdutils.Factory, runE func(*DeleteOpts) error) *cobra.Command {
+	opts := &DeleteOpts{
+		IO: f.IO,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "delete <name>... [flags]",
+		Short:   `Delete labels from a project or group`,
+		Long:    `Delete labels. The labels are removed from all issues and merge requests they are assigned to.`,
+		Aliases: []string{"rm"},
+		Example: heredoc.Doc(`
+			$ glab label delete bug
+			$ glab label delete bug stale -g mygroup -y
+		`),
+		Args: cmdutils.MinimumArgs(1, "no label specified"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.HTTPClient = f.HttpClient
+			opts.BaseRepo = f.BaseRepo
+			opts.Names = args
+
+			if !opts.ForceDelete && !opts.IO.PromptEnabled() {
+				return &cmdutils.FlagError{Err: errors.New("--yes or -y flag is required when not running interactively")}
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return deleteRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Group, "group", "g", "", "Delete labels of a group instead of the project")
+	cmd.Flags().BoolVarP(&opts.ForceDelete, "yes", "y", false, "Skip the confirmation prompt")
+
+	return cmd
+}
+
+func deleteRun(opts *DeleteOpts) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	target, err := cmdutils.ResolveTarget(opts.Group, opts.BaseRepo)
+	if err != nil {
+		return err
+	}
+
+	if !opts.ForceDelete && opts.IO.PromptEnabled() {
+		err = prompt.Confirm(&opts.ForceDelete, fmt.Sprintf("Delete %s from %s?", strings.Join(opts.Names, ", "), target), false)
+		if err != nil {
+			return cmdutils.WrapError(err, "could not prompt")
+		}
+	}
+
+	if !opts.ForceDelete {
+		return cmdutils.CancelError()
+	}
+
+	c := opts.IO.Color()
+	for _, name := range opts.Names {
+		if target.Group != "" {
+			err = api.DeleteGroupLabel(apiClient, target.Group, name)
+		} else {
+			err = api.DeleteLabel(apiClient, target.Repo.FullName(), name)
+		}
+		if err != nil {
+			return cmdutils.WrapError(err, fmt.Sprintf("failed to delete label %q from %s", name, target))
+		}
+
+		fmt.Fprintf(opts.IO.StdOut, "%s Deleted label %s from %s\n", c.RedCheck(), name, target)
+	}
+
+	return nil
+}
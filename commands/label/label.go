@@ -3,7 +3,13 @@ package label
 import (
 	"github.com/profclems/glab/commands/cmdutils"
 	labelCreateCmd "github.com/profclems/glab/commands/label/create"
+	labelDeleteCmd "github.com/profclems/glab/commands/label/delete"
 	labelListCmd "github.com/profclems/glab/commands/label/list"
+	labelPromoteCmd "github.com/profclems/glab/commands/label/promote"
+	labelSubscribeCmd "github.com/profclems/glab/commands/label/subscribe"
+	labelSyncCmd "github.com/profclems/glab/commands/label/sync"
+	labelUnsubscribeCmd "github.com/profclems/glab/commands/label/unsubscribe"
+	labelUpdateCmd "github.com/profclems/glab/commands/label/update"
 	"github.com/spf13/cobra"
 )
 
@@ -18,5 +24,11 @@ func NewCmdLabel(f *cmdutils.Factory) *cobra.Command {
 
 	labelCmd.AddCommand(labelListCmd.NewCmdList(f))
 	labelCmd.AddCommand(labelCreateCmd.NewCmdCreate(f))
+	labelCmd.AddCommand(labelUpdateCmd.NewCmdUpdate(f, nil))
+	labelCmd.AddCommand(labelDeleteCmd.NewCmdDelete(f, nil))
+	labelCmd.AddCommand(labelSubscribeCmd.NewCmdSubscribe(f, nil))
+	labelCmd.AddCommand(labelUnsubscribeCmd.NewCmdUnsubscribe(f, nil))
+	labelCmd.AddCommand(labelPromoteCmd.NewCmdPromote(f, nil))
+	labelCmd.AddCommand(labelSyncCmd.NewCmdSync(f, nil))
 	return labelCmd
 }
@@ -0,0 +1,248 @@
+package labelutils
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/xanzy/go-gitlab"
+	"gopkg.in/yaml.v3"
+)
+
+// ListLabels returns all labels of the target. Labels inherited from
+// ancestor groups are only included when inherited is true.
+func ListLabels(client *gitlab.Client, target cmdutils.Target, inherited bool) ([]*gitlab.Label, error) {
+	var labels []*gitlab.Label
+
+	if target.Group != "" {
+		opts := &gitlab.ListGroupLabelsOptions{PerPage: 100}
+		for opts.Page = 1; ; opts.Page++ {
+			groupLabels, err := api.ListGroupLabels(client, target.Group, opts)
+			if err != nil {
+				return nil, err
+			}
+			for _, l := range groupLabels {
+				labels = append(labels, (*gitlab.Label)(l))
+			}
+			if len(groupLabels) < opts.PerPage {
+				break
+			}
+		}
+		return labels, nil
+	}
+
+	opts := &gitlab.ListLabelsOptions{IncludeAncestorGroups: gitlab.Bool(inherited)}
+	opts.PerPage = 100
+	for opts.Page = 1; ; opts.Page++ {
+		projectLabels, err := api.ListLabels(client, target.Repo.FullName(), opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, l := range projectLabels {
+			if inherited || l.IsProjectLabel {
+				labels = append(labels, l)
+			}
+		}
+		if len(projectLabels) < opts.PerPage {
+			break
+		}
+	}
+	return labels, nil
+}
+
+// Spec is the desired state of a label
+type Spec struct {
+	Name        string `yaml:"name"`
+	Color       string `yaml:"color"`
+	Description string `yaml:"description,omitempty"`
+	Priority    *int   `yaml:"priority,omitempty"`
+}
+
+func (s *Spec) String() string {
+	details := []string{"color " + s.Color}
+	if s.Description != "" {
+		details = append(details, fmt.Sprintf("description %q", s.Description))
+	}
+	if s.Priority != nil {
+		details = append(details, fmt.Sprintf("priority %d", *s.Priority))
+	}
+	return fmt.Sprintf("%s (%s)", s.Name, strings.Join(details, ", "))
+}
+
+// SpecFromLabel returns the spec of an existing label.
+// Labels without a priority have a zero priority in the API response.
+func SpecFromLabel(l *gitlab.Label) *Spec {
+	spec := &Spec{
+		Name:        l.Name,
+		Color:       l.Color,
+		Description: l.Description,
+	}
+	if l.Priority != 0 {
+		spec.Priority = gitlab.Int(l.Priority)
+	}
+	return spec
+}
+
+// ParseSpecs reads label specs from YAML. The labels are either a top level list
+// or a list under the "labels" key:
+//
+//	labels:
+//	  - name: bug
+//	    color: "#d9534f"
+//	    description: Something isn't working
+//	    priority: 1
+func ParseSpecs(data []byte) ([]*Spec, error) {
+	var file struct {
+		Labels []*Spec `yaml:"labels"`
+	}
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		var list []*Spec
+		if listErr := yaml.Unmarshal(data, &list); listErr != nil {
+			return nil, fmt.Errorf("invalid label file: %w", err)
+		}
+		file.Labels = list
+	}
+
+	seen := map[string]bool{}
+	for i, spec := range file.Labels {
+		if spec == nil || spec.Name == "" {
+			return nil, fmt.Errorf("invalid label file: label %d has no name", i+1)
+		}
+		if spec.Color == "" {
+			return nil, fmt.Errorf("invalid label file: label %q has no color", spec.Name)
+		}
+		key := strings.ToLower(spec.Name)
+		if seen[key] {
+			return nil, fmt.Errorf("invalid label file: label %q is defined more than once", spec.Name)
+		}
+		seen[key] = true
+	}
+	return file.Labels, nil
+}
+
+// Action is a change to a label
+type Action string
+
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+)
+
+// Change is a single step needed to reconcile the labels of a target with the desired specs
+type Change struct {
+	Action  Action
+	Spec    *Spec
+	Current *Spec
+}
+
+// Diff describes the fields which are changed by an update
+func (c *Change) Diff() []string {
+	var diff []string
+	if c.Current.Name != c.Spec.Name {
+		diff = append(diff, fmt.Sprintf("name %s → %s", c.Current.Name, c.Spec.Name))
+	}
+	if !strings.EqualFold(c.Current.Color, c.Spec.Color) {
+		diff = append(diff, fmt.Sprintf("color %s → %s", c.Current.Color, c.Spec.Color))
+	}
+	if c.Current.Description != c.Spec.Description {
+		diff = append(diff, fmt.Sprintf("description %q → %q", c.Current.Description, c.Spec.Description))
+	}
+	if c.Spec.Priority != nil && (c.Current.Priority == nil || *c.Current.Priority != *c.Spec.Priority) {
+		current := "none"
+		if c.Current.Priority != nil {
+			current = fmt.Sprint(*c.Current.Priority)
+		}
+		diff = append(diff, fmt.Sprintf("priority %s → %d", current, *c.Spec.Priority))
+	}
+	return diff
+}
+
+func (c *Change) String() string {
+	switch c.Action {
+	case ActionCreate:
+		return "+ " + c.Spec.String()
+	case ActionDelete:
+		return "- " + c.Current.Name
+	default:
+		return fmt.Sprintf("~ %s: %s", c.Spec.Name, strings.Join(c.Diff(), ", "))
+	}
+}
+
+// Plan returns the changes needed to turn the current labels into the desired ones.
+// Labels are matched by name, ignoring case. Labels which are not desired are only
+// deleted when prune is true. A label without a desired priority keeps its current priority.
+func Plan(desired, current []*Spec, prune bool) []*Change {
+	currentByName := map[string]*Spec{}
+	for _, c := range current {
+		currentByName[strings.ToLower(c.Name)] = c
+	}
+
+	var changes []*Change
+	wanted := map[string]bool{}
+	for _, spec := range desired {
+		key := strings.ToLower(spec.Name)
+		wanted[key] = true
+
+		cur, ok := currentByName[key]
+		if !ok {
+			changes = append(changes, &Change{Action: ActionCreate, Spec: spec})
+			continue
+		}
+		change := &Change{Action: ActionUpdate, Spec: spec, Current: cur}
+		if len(change.Diff()) > 0 {
+			changes = append(changes, change)
+		}
+	}
+
+	if prune {
+		var deletes []*Change
+		for key, cur := range currentByName {
+			if !wanted[key] {
+				deletes = append(deletes, &Change{Action: ActionDelete, Current: cur})
+			}
+		}
+		sort.Slice(deletes, func(i, j int) bool {
+			return deletes[i].Current.Name < deletes[j].Current.Name
+		})
+		changes = append(changes, deletes...)
+	}
+
+	return changes
+}
+
+// Apply makes a change to the labels of a project
+func Apply(client *gitlab.Client, project string, change *Change) error {
+	switch change.Action {
+	case ActionCreate:
+		_, err := api.CreateLabel(client, project, &gitlab.CreateLabelOptions{
+			Name:        gitlab.String(change.Spec.Name),
+			Color:       gitlab.String(change.Spec.Color),
+			Description: gitlab.String(change.Spec.Description),
+		})
+		if err != nil || change.Spec.Priority == nil {
+			return err
+		}
+		// the label creation options do not include the priority
+		_, err = api.UpdateLabel(client, project, change.Spec.Name, &api.UpdateLabelOptions{
+			Priority: change.Spec.Priority,
+		})
+		return err
+	case ActionUpdate:
+		opts := &api.UpdateLabelOptions{
+			Color:       gitlab.String(change.Spec.Color),
+			Description: gitlab.String(change.Spec.Description),
+			Priority:    change.Spec.Priority,
+		}
+		if change.Current.Name != change.Spec.Name {
+			opts.NewName = gitlab.String(change.Spec.Name)
+		}
+		_, err := api.UpdateLabel(client, project, change.Current.Name, opts)
+		return err
+	case ActionDelete:
+		return api.DeleteLabel(client, project, change.Current.Name)
+	}
+	return fmt.Errorf("unknown label change %q", change.Action)
+}
@@ -0,0 +1,64 @@
+package labelutils
+
+import (
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xanzy/go-gitlab"
+)
+
+func Test_ParseSpecs(t *testing.T) {
+	specs, err := ParseSpecs([]byte(heredoc.Doc(`
+		labels:
+		  - name: bug
+		    color: "#d9534f"
+		    description: Something isn't working
+		    priority: 1
+		  - name: feature
+		    color: "#5cb85c"
+	`)))
+	require.NoError(t, err)
+	require.Len(t, specs, 2)
+	assert.Equal(t, &Spec{Name: "bug", Color: "#d9534f", Description: "Something isn't working", Priority: gitlab.Int(1)}, specs[0])
+	assert.Equal(t, &Spec{Name: "feature", Color: "#5cb85c"}, specs[1])
+
+	specs, err = ParseSpecs([]byte("- name: bug\n  color: red\n"))
+	require.NoError(t, err)
+	assert.Equal(t, []*Spec{{Name: "bug", Color: "red"}}, specs)
+
+	_, err = ParseSpecs([]byte("- name: bug\n"))
+	assert.EqualError(t, err, `invalid label file: label "bug" has no color`)
+
+	_, err = ParseSpecs([]byte("- name: bug\n  color: red\n- name: Bug\n  color: blue\n"))
+	assert.EqualError(t, err, `invalid label file: label "Bug" is defined more than once`)
+}
+
+func Test_Plan(t *testing.T) {
+	desired := []*Spec{
+		{Name: "bug", Color: "#d9534f", Priority: gitlab.Int(1)},
+		{Name: "Feature", Color: "#5cb85c"},
+		{Name: "docs", Color: "#428bca", Description: "Documentation"},
+	}
+	current := []*Spec{
+		{Name: "bug", Color: "#D9534F", Priority: gitlab.Int(1)},
+		{Name: "feature", Color: "#5cb85c"},
+		{Name: "stale", Color: "#cccccc"},
+		{Name: "wontfix", Color: "#ffffff"},
+	}
+
+	changes := Plan(desired, current, false)
+	require.Len(t, changes, 2)
+	assert.Equal(t, "~ Feature: name feature → Feature", changes[0].String())
+	assert.Equal(t, `+ docs (color #428bca, description "Documentation")`, changes[1].String())
+
+	changes = Plan(desired, current, true)
+	require.Len(t, changes, 4)
+	assert.Equal(t, "- stale", changes[2].String())
+	assert.Equal(t, "- wontfix", changes[3].String())
+
+	changes = Plan([]*Spec{{Name: "bug", Color: "#ff0000", Priority: gitlab.Int(2)}}, current[:1], false)
+	require.Len(t, changes, 1)
+	assert.Equal(t, "~ bug: color #D9534F → #ff0000, priority 1 → 2", changes[0].String())
+}
@@ -23,6 +23,7 @@ func NewCmdList(f *cmdutils.Factory) *cobra.Command {
 			$ glab label list
 			$ glab label ls
 			$ glab label list -R owner/repository
+			$ glab label list -g mygroup
 		`),
 		Args: cobra.ExactArgs(0),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -33,6 +34,24 @@ func NewCmdList(f *cmdutils.Factory) *cobra.Command {
 				return err
 			}
 
+			if group, _ := cmd.Flags().GetString("group"); group != "" {
+				gl := &gitlab.ListGroupLabelsOptions{}
+				gl.Page, _ = cmd.Flags().GetInt("page")
+				gl.PerPage, _ = cmd.Flags().GetInt("per-page")
+
+				groupLabels, err := api.ListGroupLabels(apiClient, group, gl)
+				if err != nil {
+					return err
+				}
+				labels := make([]*gitlab.Label, 0, len(groupLabels))
+				for _, label := range groupLabels {
+					labels = append(labels, (*gitlab.Label)(label))
+				}
+				fmt.Fprintf(f.IO.StdOut, "Showing label %d of %d on %s\n\n", len(labels), len(labels), group)
+				fmt.Fprintln(f.IO.StdOut, utils.Indent(labelsInfo(labels), " "))
+				return nil
+			}
+
 			repo, err := f.BaseRepo()
 			if err != nil {
 				return err
@@ -55,15 +74,7 @@ func NewCmdList(f *cmdutils.Factory) *cobra.Command {
 				return err
 			}
 			fmt.Fprintf(f.IO.StdOut, "Showing label %d of %d on %s\n\n", len(labels), len(labels), repo.FullName())
-			var labelPrintInfo string
-			for _, label := range labels {
-				labelPrintInfo += label.Name
-				if label.Description != "" {
-					labelPrintInfo += " -> " + label.Description
-				}
-				labelPrintInfo += "\n"
-			}
-			fmt.Fprintln(f.IO.StdOut, utils.Indent(labelPrintInfo, " "))
+			fmt.Fprintln(f.IO.StdOut, utils.Indent(labelsInfo(labels), " "))
 
 			// Cache labels for host
 			//labelNames := make([]string, 0, len(labels))
@@ -82,6 +93,19 @@ func NewCmdList(f *cmdutils.Factory) *cobra.Command {
 
 	labelListCmd.Flags().IntP("page", "p", 1, "Page number")
 	labelListCmd.Flags().IntP("per-page", "P", 30, "Number of items to list per page")
+	labelListCmd.Flags().StringP("group", "g", "", "List labels of a group instead of the project")
 
 	return labelListCmd
 }
+
+func labelsInfo(labels []*gitlab.Label) string {
+	var labelPrintInfo string
+	for _, label := range labels {
+		labelPrintInfo += label.Name
+		if label.Description != "" {
+			labelPrintInfo += " -> " + label.Description
+		}
+		labelPrintInfo += "\n"
+	}
+	return labelPrintInfo
+}
@@ -0,0 +1,98 @@
+package promote
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/profclems/glab/pkg/prompt"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type PromoteOpts struct {
+	HTTPClient func() (*gitlab.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+
+	Name         string
+	ForcePromote bool
+}
+
+func NewCmdPromote(f *cmdutils.Factory, runE func(*PromoteOpts) error) *cobra.Command {
+	opts := &PromoteOpts{
+		IO: f.IO,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "promote <name> [flags]",
+		Short: `Promote a project label to a group label`,
+		Long: heredoc.Doc(`
+			Promote a project label to a label of the group the project belongs to.
+
+			Labels with the same name in other projects of the group are merged into the group label.
+			Promotion cannot be undone.
+		`),
+		Example: heredoc.Doc(`
+			$ glab label promote bug
+			$ glab label promote bug -R owner/repo -y
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.HTTPClient = f.HttpClient
+			opts.BaseRepo = f.BaseRepo
+			opts.Name = args[0]
+
+			if !opts.ForcePromote && !opts.IO.PromptEnabled() {
+				return &cmdutils.FlagError{Err: errors.New("--yes or -y flag is required when not running interactively")}
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return promoteRun(opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.ForcePromote, "yes", "y", false, "Skip the confirmation prompt")
+
+	return cmd
+}
+
+func promoteRun(opts *PromoteOpts) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	if !opts.ForcePromote && opts.IO.PromptEnabled() {
+		err = prompt.Confirm(&opts.ForcePromote, fmt.Sprintf("Promote label %s of %s to a group label? This cannot be undone", opts.Name, repo.FullName()), false)
+		if err != nil {
+			return cmdutils.WrapError(err, "could not prompt")
+		}
+	}
+
+	if !opts.ForcePromote {
+		return cmdutils.CancelError()
+	}
+
+	err = api.PromoteLabel(apiClient, repo.FullName(), opts.Name)
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to promote label %q", opts.Name))
+	}
+
+	c := opts.IO.Color()
+	fmt.Fprintf(opts.IO.StdOut, "%s Promoted label %s to a group label\n", c.GreenCheck(), opts.Name)
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package subscribe
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type SubscribeOpts struct {
+	HTTPClient func() (*gitlab.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+
+	Names []string
+	Group string
+}
+
+func NewCmdSubscribe(f *cmdutils.Factory, runE func(*SubscribeOpts) error) *cobra.Command {
+	opts := &SubscribeOpts{
+		IO: f.IO,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "subscribe <name>... [flags]",
+		Short: `Subscribe to notifications for labels`,
+		Long: heredoc.Doc(`
+			Subscribe to labels to be notified when they are added to issues and merge requests.
+			Subscribing to a label twice is not an error.
+		`),
+		Aliases: []string{"sub"},
+		Example: heredoc.Doc(`
+			$ glab label subscribe bug security
+			$ glab label subscribe release -g mygroup
+		`),
+		Args: cmdutils.MinimumArgs(1, "no label specified"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.HTTPClient = f.HttpClient
+			opts.BaseRepo = f.BaseRepo
+			opts.Names = args
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return subscribeRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Group, "group", "g", "", "Subscribe to labels of a group instead of the project")
+
+	return cmd
+}
+
+func subscribeRun(opts *SubscribeOpts) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	target, err := cmdutils.ResolveTarget(opts.Group, opts.BaseRepo)
+	if err != nil {
+		return err
+	}
+
+	c := opts.IO.Color()
+	for _, name := range opts.Names {
+		if target.Group != "" {
+			err = api.SubscribeToGroupLabel(apiClient, target.Group, name)
+		} else {
+			err = api.SubscribeToLabel(apiClient, target.Repo.FullName(), name)
+		}
+		if err != nil {
+			return cmdutils.WrapError(err, fmt.Sprintf("failed to subscribe to label %q on %s", name, target))
+		}
+		fmt.Fprintf(opts.IO.StdOut, "%s Subscribed to label %s on %s\n", c.GreenCheck(), name, target)
+	}
+
+	return nil
+}
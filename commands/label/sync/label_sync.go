@@ -0,0 +1,225 @@
+package sync
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/commands/label/labelutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/profclems/glab/pkg/prompt"
+	"github.com/profclems/glab/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type SyncOpts struct {
+	HTTPClient func() (*gitlab.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+
+	Projects  []string
+	From      string
+	File      string
+	Prune     bool
+	DryRun    bool
+	ForceSync bool
+}
+
+func NewCmdSync(f *cmdutils.Factory, runE func(*SyncOpts) error) *cobra.Command {
+	opts := &SyncOpts{
+		IO: f.IO,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "sync [<project>...] [flags]",
+		Short: `Copy or reconcile labels from a project or a YAML file`,
+		Long: heredoc.Docf(`
+			Synchronize the labels of one or more projects with the labels of a source project
+			or a YAML file. Defaults to the current project when no project is specified.
+
+			Labels are matched by name, ignoring case. Missing labels are created and the color,
+			description and priority of existing labels are updated. Labels which are not in the
+			source are kept unless --prune is used, which deletes them.
+
+			The YAML file contains a list of labels, either at the top level or under a "labels" key:
+
+			%[1]slabels:
+			  - name: bug
+			    color: "#d9534f"
+			    description: Something isn't working
+			    priority: 1
+			  - name: feature
+			    color: "#5cb85c"%[1]s
+
+			Use --dry-run to show the changes without making them.
+		`, "```"),
+		Example: heredoc.Doc(`
+			$ glab label sync --from owner/template
+			$ glab label sync owner/api owner/web --file labels.yml --dry-run
+			$ glab label sync owner/api --file labels.yml --prune -y
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.HTTPClient = f.HttpClient
+			opts.BaseRepo = f.BaseRepo
+			opts.Projects = args
+
+			if (opts.From == "") == (opts.File == "") {
+				return &cmdutils.FlagError{Err: errors.New("specify the source labels with either --from or --file")}
+			}
+
+			if opts.Prune && !opts.DryRun && !opts.ForceSync && !opts.IO.PromptEnabled() {
+				return &cmdutils.FlagError{Err: errors.New("--yes or -y flag is required to prune labels when not running interactively")}
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return syncRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.From, "from", "", "", "Project to copy the labels from")
+	cmd.Flags().StringVarP(&opts.File, "file", "f", "", "YAML file to read the labels from. Use - to read from stdin")
+	cmd.Flags().BoolVarP(&opts.Prune, "prune", "", false, "Delete project labels which are not in the source")
+	cmd.Flags().BoolVarP(&opts.DryRun, "dry-run", "", false, "Show the changes without making them")
+	cmd.Flags().BoolVarP(&opts.ForceSync, "yes", "y", false, "Skip the confirmation prompt when pruning labels")
+
+	return cmd
+}
+
+func syncRun(opts *SyncOpts) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	desired, err := sourceLabels(apiClient, opts)
+	if err != nil {
+		return err
+	}
+
+	var targets []glrepo.Interface
+	if len(opts.Projects) == 0 {
+		repo, err := opts.BaseRepo()
+		if err != nil {
+			return err
+		}
+		targets = append(targets, repo)
+	}
+	for _, project := range opts.Projects {
+		repo, err := glrepo.FromFullName(project)
+		if err != nil {
+			return err
+		}
+		targets = append(targets, repo)
+	}
+
+	c := opts.IO.Color()
+	for _, repo := range targets {
+		current, err := labelutils.ListLabels(apiClient, cmdutils.Target{Repo: repo}, false)
+		if err != nil {
+			return cmdutils.WrapError(err, fmt.Sprintf("failed to list labels of %s", repo.FullName()))
+		}
+
+		currentSpecs := make([]*labelutils.Spec, 0, len(current))
+		for _, l := range current {
+			currentSpecs = append(currentSpecs, labelutils.SpecFromLabel(l))
+		}
+
+		changes := labelutils.Plan(desired, currentSpecs, opts.Prune)
+		if len(changes) == 0 {
+			fmt.Fprintf(opts.IO.StdOut, "%s %s is up to date\n", c.GreenCheck(), repo.FullName())
+			continue
+		}
+
+		fmt.Fprintf(opts.IO.StdOut, "%s: %s\n", c.Bold(repo.FullName()), utils.Pluralize(len(changes), "change"))
+		for _, change := range changes {
+			fmt.Fprintf(opts.IO.StdOut, "  %s\n", colorChange(c, change))
+		}
+
+		if opts.DryRun {
+			continue
+		}
+
+		if deletes := countDeletes(changes); deletes > 0 && !opts.ForceSync {
+			confirmed := false
+			err = prompt.Confirm(&confirmed, fmt.Sprintf("Delete %s from %s?", utils.Pluralize(deletes, "label"), repo.FullName()), false)
+			if err != nil {
+				return cmdutils.WrapError(err, "could not prompt")
+			}
+			if !confirmed {
+				return cmdutils.CancelError()
+			}
+		}
+
+		for _, change := range changes {
+			if err := labelutils.Apply(apiClient, repo.FullName(), change); err != nil {
+				return cmdutils.WrapError(err, fmt.Sprintf("failed to %s label on %s", change.Action, repo.FullName()))
+			}
+		}
+		fmt.Fprintf(opts.IO.StdOut, "%s Synced labels of %s\n", c.GreenCheck(), repo.FullName())
+	}
+
+	if opts.DryRun {
+		fmt.Fprintln(opts.IO.StdOut, "\nDry run: no labels were changed")
+	}
+
+	return nil
+}
+
+func sourceLabels(client *gitlab.Client, opts *SyncOpts) ([]*labelutils.Spec, error) {
+	if opts.File != "" {
+		var data []byte
+		var err error
+		if opts.File == "-" {
+			data, err = ioutil.ReadAll(opts.IO.In)
+		} else {
+			data, err = ioutil.ReadFile(opts.File)
+		}
+		if err != nil {
+			return nil, cmdutils.WrapError(err, "failed to read label file")
+		}
+		return labelutils.ParseSpecs(data)
+	}
+
+	repo, err := glrepo.FromFullName(opts.From)
+	if err != nil {
+		return nil, err
+	}
+	labels, err := labelutils.ListLabels(client, cmdutils.Target{Repo: repo}, false)
+	if err != nil {
+		return nil, cmdutils.WrapError(err, fmt.Sprintf("failed to list labels of %s", opts.From))
+	}
+
+	specs := make([]*labelutils.Spec, 0, len(labels))
+	for _, l := range labels {
+		specs = append(specs, labelutils.SpecFromLabel(l))
+	}
+	return specs, nil
+}
+
+func colorChange(c *iostreams.ColorPalette, change *labelutils.Change) string {
+	switch change.Action {
+	case labelutils.ActionCreate:
+		return c.Green(change.String())
+	case labelutils.ActionDelete:
+		return c.Red(change.String())
+	default:
+		return c.Yellow(change.String())
+	}
+}
+
+func countDeletes(changes []*labelutils.Change) int {
+	n := 0
+	for _, change := range changes {
+		if change.Action == labelutils.ActionDelete {
+			n++
+		}
+	}
+	return n
+}
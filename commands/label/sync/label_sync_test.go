@@ -0,0 +1,113 @@
+package sync
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/httpmock"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xanzy/go-gitlab"
+)
+
+const labelFile = `
+labels:
+  - name: bug
+    color: "#d9534f"
+  - name: feature
+    color: "#5cb85c"
+    priority: 2
+`
+
+func newOpts(reg *httpmock.Mocker) (*SyncOpts, *bytes.Buffer) {
+	io, stdin, stdout, _ := iostreams.Test()
+	stdin.WriteString(labelFile)
+
+	opts := &SyncOpts{
+		HTTPClient: func() (*gitlab.Client, error) {
+			a, _ := api.TestClient(&http.Client{Transport: reg}, "", "gitlab.com", false)
+			return a.Lab(), nil
+		},
+		BaseRepo: func() (glrepo.Interface, error) {
+			return glrepo.FromFullName("owner/repo")
+		},
+		IO:   io,
+		File: "-",
+	}
+	_, _ = opts.HTTPClient()
+	return opts, stdout
+}
+
+func Test_syncRun_dryRun(t *testing.T) {
+	reg := &httpmock.Mocker{}
+	defer reg.Verify(t)
+
+	reg.RegisterResponder("GET", "/api/v4/projects/owner/repo/labels",
+		httpmock.NewStringResponse(200, `[
+			{"name": "bug", "color": "#d9534f", "is_project_label": true},
+			{"name": "stale", "color": "#cccccc", "is_project_label": true},
+			{"name": "group-label", "color": "#000000", "is_project_label": false}
+		]`))
+
+	opts, stdout := newOpts(reg)
+	opts.Prune = true
+	opts.DryRun = true
+
+	err := syncRun(opts)
+	require.NoError(t, err)
+
+	assert.Equal(t, heredoc.Doc(`
+		owner/repo: 2 changes
+		  + feature (color #5cb85c, priority 2)
+		  - stale
+
+		Dry run: no labels were changed
+	`), stdout.String())
+}
+
+func Test_syncRun(t *testing.T) {
+	reg := &httpmock.Mocker{}
+	defer reg.Verify(t)
+
+	reg.RegisterResponder("GET", "/api/v4/projects/owner/repo/labels",
+		httpmock.NewStringResponse(200, `[{"name": "Bug", "color": "#ff0000", "is_project_label": true}]`))
+
+	var requests []string
+	var bodies []map[string]interface{}
+	record := func(status int, resp string) httpmock.Responder {
+		return func(req *http.Request) (*http.Response, error) {
+			b, err := ioutil.ReadAll(req.Body)
+			require.NoError(t, err)
+			body := map[string]interface{}{}
+			require.NoError(t, json.Unmarshal(b, &body))
+			requests = append(requests, req.Method+" "+req.URL.Path)
+			bodies = append(bodies, body)
+			return httpmock.NewStringResponse(status, resp)(req)
+		}
+	}
+	reg.RegisterResponder("PUT", "/api/v4/projects/owner/repo/labels/Bug", record(200, `{"name": "bug"}`))
+	reg.RegisterResponder("POST", "/api/v4/projects/owner/repo/labels", record(201, `{"name": "feature"}`))
+	reg.RegisterResponder("PUT", "/api/v4/projects/owner/repo/labels/feature", record(200, `{"name": "feature"}`))
+
+	opts, _ := newOpts(reg)
+
+	err := syncRun(opts)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"PUT /api/v4/projects/owner/repo/labels/Bug",
+		"POST /api/v4/projects/owner/repo/labels",
+		"PUT /api/v4/projects/owner/repo/labels/feature",
+	}, requests)
+	assert.Equal(t, "bug", bodies[0]["new_name"])
+	assert.Equal(t, "#d9534f", bodies[0]["color"])
+	assert.Equal(t, "feature", bodies[1]["name"])
+	assert.Equal(t, float64(2), bodies[2]["priority"])
+}
@@ -0,0 +1,85 @@
+package unsubscribe
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type UnsubscribeOpts struct {
+	HTTPClient func() (*gitlab.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+
+	Names []string
+	Group string
+}
+
+func NewCmdUnsubscribe(f *cmdutils.Factory, runE func(*UnsubscribeOpts) error) *cobra.Command {
+	opts := &UnsubscribeOpts{
+		IO: f.IO,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "unsubscribe <name>... [flags]",
+		Short: `Unsubscribe from notifications for labels`,
+		Long: heredoc.Doc(`
+			Stop being notified when labels are added to issues and merge requests.
+			Unsubscribing from a label twice is not an error.
+		`),
+		Aliases: []string{"unsub"},
+		Example: heredoc.Doc(`
+			$ glab label unsubscribe bug security
+			$ glab label unsubscribe release -g mygroup
+		`),
+		Args: cmdutils.MinimumArgs(1, "no label specified"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.HTTPClient = f.HttpClient
+			opts.BaseRepo = f.BaseRepo
+			opts.Names = args
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return unsubscribeRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Group, "group", "g", "", "Unsubscribe from labels of a group instead of the project")
+
+	return cmd
+}
+
+func unsubscribeRun(opts *UnsubscribeOpts) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	target, err := cmdutils.ResolveTarget(opts.Group, opts.BaseRepo)
+	if err != nil {
+		return err
+	}
+
+	c := opts.IO.Color()
+	for _, name := range opts.Names {
+		if target.Group != "" {
+			err = api.UnsubscribeFromGroupLabel(apiClient, target.Group, name)
+		} else {
+			err = api.UnsubscribeFromLabel(apiClient, target.Repo.FullName(), name)
+		}
+		if err != nil {
+			return cmdutils.WrapError(err, fmt.Sprintf("failed to unsubscribe from label %q on %s", name, target))
+		}
+		fmt.Fprintf(opts.IO.StdOut, "%s Unsubscribed from label %s on %s\n", c.RedCheck(), name, target)
+	}
+
+	return nil
+}
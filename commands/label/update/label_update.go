@@ -0,0 +1,129 @@
+package update
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/commands/label/labelutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type UpdateOpts struct {
+	HTTPClient func() (*gitlab.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+
+	Name        string
+	Group       string
+	NewName     string
+	Color       string
+	Description string
+	Priority    int
+
+	priority    *int
+	description *string
+}
+
+func NewCmdUpdate(f *cmdutils.Factory, runE func(*UpdateOpts) error) *cobra.Command {
+	opts := &UpdateOpts{
+		IO: f.IO,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "update <name> [flags]",
+		Short:   `Update the name, color, description or priority of a label`,
+		Long:    ``,
+		Aliases: []string{"edit"},
+		Example: heredoc.Doc(`
+			$ glab label update bug --color "#d9534f"
+			$ glab label update bug --new-name defect --description "Something isn't working"
+			$ glab label update bug --priority 1
+			$ glab label update bug -g mygroup --color red
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.HTTPClient = f.HttpClient
+			opts.BaseRepo = f.BaseRepo
+			opts.Name = args[0]
+
+			if cmd.Flags().Changed("priority") {
+				if opts.Group != "" {
+					return &cmdutils.FlagError{Err: errors.New("group labels have no priority")}
+				}
+				if opts.Priority < 0 {
+					return &cmdutils.FlagError{Err: errors.New("the priority must be zero or a positive number")}
+				}
+				opts.priority = gitlab.Int(opts.Priority)
+			}
+
+			// an empty description clears it
+			if cmd.Flags().Changed("description") {
+				opts.description = gitlab.String(opts.Description)
+			}
+
+			if opts.NewName == "" && opts.Color == "" && opts.description == nil && opts.priority == nil {
+				return &cmdutils.FlagError{Err: errors.New("nothing to update. Specify --new-name, --color, --description or --priority")}
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return updateRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Group, "group", "g", "", "Update a label of a group instead of the project")
+	cmd.Flags().StringVarP(&opts.NewName, "new-name", "n", "", "New name of the label")
+	cmd.Flags().StringVarP(&opts.Color, "color", "c", "", "New color of the label in plain or HEX code")
+	cmd.Flags().StringVarP(&opts.Description, "description", "d", "", "New description of the label")
+	cmd.Flags().IntVarP(&opts.Priority, "priority", "p", 0, "New priority of the label. Lower numbers have higher priority")
+
+	return cmd
+}
+
+func updateRun(opts *UpdateOpts) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	target, err := cmdutils.ResolveTarget(opts.Group, opts.BaseRepo)
+	if err != nil {
+		return err
+	}
+
+	updateOpts := &api.UpdateLabelOptions{
+		Description: opts.description,
+		Priority:    opts.priority,
+	}
+	if opts.NewName != "" {
+		updateOpts.NewName = gitlab.String(opts.NewName)
+	}
+	if opts.Color != "" {
+		updateOpts.Color = gitlab.String(opts.Color)
+	}
+
+	var label *gitlab.Label
+	if target.Group != "" {
+		var groupLabel *gitlab.GroupLabel
+		groupLabel, err = api.UpdateGroupLabel(apiClient, target.Group, opts.Name, updateOpts)
+		label = (*gitlab.Label)(groupLabel)
+	} else {
+		label, err = api.UpdateLabel(apiClient, target.Repo.FullName(), opts.Name, updateOpts)
+	}
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to update label %q on %s", opts.Name, target))
+	}
+
+	c := opts.IO.Color()
+	fmt.Fprintf(opts.IO.StdOut, "%s Updated label %s\n", c.GreenCheck(), labelutils.SpecFromLabel(label))
+
+	return nil
+}
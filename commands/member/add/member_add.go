@@ -0,0 +1,125 @@
+package add
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/commands/member/memberutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type AddOpts struct {
+	HTTPClient func() (*gitlab.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+
+	Users       []string
+	Group       string
+	AccessLevel string
+	ExpiresAt   string
+
+	accessLevel gitlab.AccessLevelValue
+}
+
+func NewCmdAdd(f *cmdutils.Factory, runE func(*AddOpts) error) *cobra.Command {
+	opts := &AddOpts{
+		IO: f.IO,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "add <username>... [flags]",
+		Short: `Add users as members of a project or group`,
+		Long: heredoc.Doc(`
+			Add one or more existing GitLab users as members of a project or group.
+
+			Users can be specified by username or user ID.
+			Valid access levels: guest, reporter, developer, maintainer, owner
+		`),
+		Example: heredoc.Doc(`
+			$ glab member add johndoe
+			$ glab member add johndoe janedoe --access-level maintainer
+			$ glab member add johndoe -g mygroup -a reporter --expires-at 2022-12-31
+		`),
+		Args: cmdutils.MinimumArgs(1, "no user specified"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var err error
+
+			opts.HTTPClient = f.HttpClient
+			opts.BaseRepo = f.BaseRepo
+			opts.Users = args
+
+			opts.accessLevel, err = cmdutils.ParseAccessLevel(opts.AccessLevel)
+			if err != nil {
+				return &cmdutils.FlagError{Err: err}
+			}
+			if opts.ExpiresAt != "" {
+				if _, err := cmdutils.ParseExpiresAt(opts.ExpiresAt); err != nil {
+					return &cmdutils.FlagError{Err: err}
+				}
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return addRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Group, "group", "g", "", "Add members to a group instead of the project")
+	cmd.Flags().StringVarP(&opts.AccessLevel, "access-level", "a", "developer", "Access level of the new members")
+	cmd.Flags().StringVarP(&opts.ExpiresAt, "expires-at", "e", "", "Date the membership expires in the YYYY-MM-DD format")
+
+	return cmd
+}
+
+func addRun(opts *AddOpts) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	target, err := cmdutils.ResolveTarget(opts.Group, opts.BaseRepo)
+	if err != nil {
+		return err
+	}
+
+	var expiresAt *string
+	if opts.ExpiresAt != "" {
+		expiresAt = gitlab.String(opts.ExpiresAt)
+	}
+
+	c := opts.IO.Color()
+	for _, user := range opts.Users {
+		userID, err := memberutils.UserID(apiClient, user)
+		if err != nil {
+			return cmdutils.WrapError(err, fmt.Sprintf("failed to find user %s", user))
+		}
+
+		if target.Group != "" {
+			_, err = api.AddGroupMember(apiClient, target.Group, &gitlab.AddGroupMemberOptions{
+				UserID:      gitlab.Int(userID),
+				AccessLevel: gitlab.AccessLevel(opts.accessLevel),
+				ExpiresAt:   expiresAt,
+			})
+		} else {
+			_, err = api.AddProjectMember(apiClient, target.Repo.FullName(), &gitlab.AddProjectMemberOptions{
+				UserID:      userID,
+				AccessLevel: gitlab.AccessLevel(opts.accessLevel),
+				ExpiresAt:   expiresAt,
+			})
+		}
+		if err != nil {
+			return cmdutils.WrapError(err, fmt.Sprintf("failed to add %s to %s", user, target))
+		}
+
+		fmt.Fprintf(opts.IO.StdOut, "%s Added %s to %s as %s\n", c.GreenCheck(), user, target, cmdutils.AccessLevelName(opts.accessLevel))
+	}
+
+	return nil
+}
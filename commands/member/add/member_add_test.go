@@ -0,0 +1,85 @@
+package add
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/httpmock"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xanzy/go-gitlab"
+)
+
+func newOpts(reg *httpmock.Mocker, users ...string) (*AddOpts, *bytes.Buffer) {
+	io, _, stdout, _ := iostreams.Test()
+	opts := &AddOpts{
+		HTTPClient: func() (*gitlab.Client, error) {
+			a, _ := api.TestClient(&http.Client{Transport: reg}, "", "gitlab.com", false)
+			return a.Lab(), nil
+		},
+		BaseRepo: func() (glrepo.Interface, error) {
+			return glrepo.FromFullName("owner/repo")
+		},
+		IO:          io,
+		Users:       users,
+		accessLevel: gitlab.MaintainerPermissions,
+	}
+	_, _ = opts.HTTPClient()
+	return opts, stdout
+}
+
+func recordBody(t *testing.T, bodies *[]map[string]interface{}) httpmock.Responder {
+	return func(req *http.Request) (*http.Response, error) {
+		b, err := ioutil.ReadAll(req.Body)
+		require.NoError(t, err)
+		body := map[string]interface{}{}
+		require.NoError(t, json.Unmarshal(b, &body))
+		*bodies = append(*bodies, body)
+		return httpmock.NewStringResponse(201, `{"id": 1}`)(req)
+	}
+}
+
+func Test_addRun(t *testing.T) {
+	reg := &httpmock.Mocker{}
+	defer reg.Verify(t)
+
+	var bodies []map[string]interface{}
+	reg.RegisterResponder("GET", "/api/v4/users",
+		httpmock.NewStringResponse(200, `[{"id": 7, "username": "johndoe"}]`))
+	reg.RegisterResponder("POST", "/api/v4/projects/owner/repo/members", recordBody(t, &bodies))
+
+	opts, stdout := newOpts(reg, "@johndoe")
+	opts.ExpiresAt = "2022-12-31"
+
+	err := addRun(opts)
+	require.NoError(t, err)
+
+	assert.Equal(t, []map[string]interface{}{
+		{"user_id": float64(7), "access_level": float64(40), "expires_at": "2022-12-31"},
+	}, bodies)
+	assert.Equal(t, "✓ Added @johndoe to owner/repo as maintainer\n", stdout.String())
+}
+
+func Test_addRun_group(t *testing.T) {
+	reg := &httpmock.Mocker{}
+	defer reg.Verify(t)
+
+	var bodies []map[string]interface{}
+	reg.RegisterResponder("POST", "/api/v4/groups/mygroup/members", recordBody(t, &bodies))
+
+	opts, _ := newOpts(reg, "42")
+	opts.Group = "mygroup"
+
+	err := addRun(opts)
+	require.NoError(t, err)
+
+	assert.Equal(t, []map[string]interface{}{
+		{"user_id": float64(42), "access_level": float64(40), "expires_at": nil},
+	}, bodies)
+}
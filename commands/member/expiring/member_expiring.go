@@ -0,0 +1,123 @@
+package expiring
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/commands/member/memberutils"
+	"github.com/profclems/glab/commands/token/tokenutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/profclems/glab/pkg/tableprinter"
+	"github.com/profclems/glab/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type ExpiringOpts struct {
+	HTTPClient func() (*gitlab.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+
+	Group  string
+	Within string
+
+	within time.Duration
+	now    func() time.Time
+}
+
+func NewCmdExpiring(f *cmdutils.Factory, runE func(*ExpiringOpts) error) *cobra.Command {
+	opts := &ExpiringOpts{
+		IO:  f.IO,
+		now: time.Now,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "expiring [flags]",
+		Short: `Report members whose access expires soon`,
+		Long: heredoc.Doc(`
+			List the members of a project or group whose membership expires within the given period.
+
+			The period accepts days (d), weeks (w) or any Go duration, e.g. 30d, 2w or 72h.
+		`),
+		Example: heredoc.Doc(`
+			$ glab member expiring
+			$ glab member expiring --within 2w
+			$ glab member expiring -g mygroup --within 90d
+		`),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var err error
+
+			opts.HTTPClient = f.HttpClient
+			opts.BaseRepo = f.BaseRepo
+
+			opts.within, err = utils.ParseDuration(opts.Within)
+			if err != nil {
+				return &cmdutils.FlagError{Err: err}
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return expiringRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Group, "group", "g", "", "Report on a group instead of the project")
+	cmd.Flags().StringVarP(&opts.Within, "within", "w", "30d", "Report memberships expiring within this period")
+
+	return cmd
+}
+
+func expiringRun(opts *ExpiringOpts) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	target, err := cmdutils.ResolveTarget(opts.Group, opts.BaseRepo)
+	if err != nil {
+		return err
+	}
+
+	members, err := memberutils.ListMembers(apiClient, target, "")
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to list members")
+	}
+
+	now := opts.now()
+	deadline := now.Add(opts.within)
+
+	var expiring []*memberutils.Member
+	for _, m := range members {
+		if m.ExpiresAt != nil && time.Time(*m.ExpiresAt).Before(deadline) {
+			expiring = append(expiring, m)
+		}
+	}
+
+	if len(expiring) == 0 {
+		fmt.Fprintf(opts.IO.StdOut, "No memberships on %s expire within %s\n", target, opts.Within)
+		return nil
+	}
+
+	sort.SliceStable(expiring, func(i, j int) bool {
+		return time.Time(*expiring[i].ExpiresAt).Before(time.Time(*expiring[j].ExpiresAt))
+	})
+
+	c := opts.IO.Color()
+	table := tableprinter.NewTablePrinter()
+	table.AddRow("USERNAME", "NAME", "ACCESS LEVEL", "EXPIRES", "")
+	for _, m := range expiring {
+		remaining := tokenutils.ExpiryState(c, time.Time(*m.ExpiresAt), now)
+		table.AddRow("@"+m.Username, m.Name, cmdutils.AccessLevelName(m.AccessLevel), m.ExpiresAt.String(), remaining)
+	}
+
+	fmt.Fprintf(opts.IO.StdOut, "%s on %s expiring within %s\n\n", utils.Pluralize(len(expiring), "membership"), target, opts.Within)
+	fmt.Fprint(opts.IO.StdOut, table.Render())
+	return nil
+}
@@ -0,0 +1,58 @@
+package expiring
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/httpmock"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/xanzy/go-gitlab"
+)
+
+func Test_expiringRun(t *testing.T) {
+	reg := &httpmock.Mocker{}
+	defer reg.Verify(t)
+
+	reg.RegisterResponder("GET", "/api/v4/projects/owner/repo/members/all",
+		httpmock.NewStringResponse(200, `[
+			{"id": 1, "username": "alice", "name": "Alice", "access_level": 40, "expires_at": null},
+			{"id": 2, "username": "bob", "name": "Bob", "access_level": 30, "expires_at": "2021-11-20"},
+			{"id": 3, "username": "carol", "name": "Carol", "access_level": 20, "expires_at": "2021-11-05"},
+			{"id": 4, "username": "dave", "name": "Dave", "access_level": 30, "expires_at": "2022-06-01"}
+		]`))
+
+	opts := &ExpiringOpts{
+		HTTPClient: func() (*gitlab.Client, error) {
+			a, _ := api.TestClient(&http.Client{Transport: reg}, "", "gitlab.com", false)
+			return a.Lab(), nil
+		},
+		BaseRepo: func() (glrepo.Interface, error) {
+			return glrepo.FromFullName("owner/repo")
+		},
+		Within: "30d",
+		within: 30 * 24 * time.Hour,
+		now: func() time.Time {
+			return time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC)
+		},
+	}
+	_, _ = opts.HTTPClient()
+
+	io, _, stdout, _ := iostreams.Test()
+	opts.IO = io
+
+	err := expiringRun(opts)
+	assert.NoError(t, err)
+
+	out := stdout.String()
+	assert.Contains(t, out, "2 memberships on owner/repo expiring within 30d")
+	assert.Regexp(t, `@carol\s+Carol\s+reporter\s+2021-11-05\s+in 4 days`, out)
+	assert.Regexp(t, `@bob\s+Bob\s+developer\s+2021-11-20\s+in 19 days`, out)
+	assert.NotContains(t, out, "alice")
+	assert.NotContains(t, out, "dave")
+	assert.Less(t, strings.Index(out, "carol"), strings.Index(out, "bob"))
+}
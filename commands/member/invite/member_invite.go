@@ -0,0 +1,142 @@
+package invite
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/profclems/glab/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type InviteOpts struct {
+	HTTPClient func() (*gitlab.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+
+	Emails      []string
+	Group       string
+	AccessLevel string
+	ExpiresAt   string
+
+	accessLevel gitlab.AccessLevelValue
+}
+
+func NewCmdInvite(f *cmdutils.Factory, runE func(*InviteOpts) error) *cobra.Command {
+	opts := &InviteOpts{
+		IO: f.IO,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "invite <email>... [flags]",
+		Short: `Invite people to a project or group by email`,
+		Long: heredoc.Doc(`
+			Send an invitation email to join a project or group.
+			The invitees do not need to have a GitLab account yet.
+		`),
+		Example: heredoc.Doc(`
+			$ glab member invite john@example.com
+			$ glab member invite john@example.com jane@example.com -a reporter --expires-at 2022-12-31
+			$ glab member invite john@example.com -g mygroup
+		`),
+		Args: cmdutils.MinimumArgs(1, "no email address specified"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var err error
+
+			opts.HTTPClient = f.HttpClient
+			opts.BaseRepo = f.BaseRepo
+			opts.Emails = args
+
+			for _, email := range opts.Emails {
+				if !strings.Contains(email, "@") {
+					return &cmdutils.FlagError{Err: fmt.Errorf("invalid email address %q", email)}
+				}
+			}
+
+			opts.accessLevel, err = cmdutils.ParseAccessLevel(opts.AccessLevel)
+			if err != nil {
+				return &cmdutils.FlagError{Err: err}
+			}
+			if opts.ExpiresAt != "" {
+				if _, err := cmdutils.ParseExpiresAt(opts.ExpiresAt); err != nil {
+					return &cmdutils.FlagError{Err: err}
+				}
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return inviteRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Group, "group", "g", "", "Invite to a group instead of the project")
+	cmd.Flags().StringVarP(&opts.AccessLevel, "access-level", "a", "developer", "Access level of the invitees")
+	cmd.Flags().StringVarP(&opts.ExpiresAt, "expires-at", "e", "", "Date the membership expires in the YYYY-MM-DD format")
+
+	return cmd
+}
+
+func inviteRun(opts *InviteOpts) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	target, err := cmdutils.ResolveTarget(opts.Group, opts.BaseRepo)
+	if err != nil {
+		return err
+	}
+
+	inviteOpts := &gitlab.InvitesOptions{
+		Email:       gitlab.String(strings.Join(opts.Emails, ",")),
+		AccessLevel: gitlab.AccessLevel(opts.accessLevel),
+	}
+	if opts.ExpiresAt != "" {
+		expiresAt, _ := cmdutils.ParseExpiresAt(opts.ExpiresAt)
+		inviteOpts.ExpiresAt = (*gitlab.ISOTime)(&expiresAt)
+	}
+
+	var result *gitlab.InvitesResult
+	if target.Group != "" {
+		result, err = api.InviteGroupMembers(apiClient, target.Group, inviteOpts)
+	} else {
+		result, err = api.InviteProjectMembers(apiClient, target.Repo.FullName(), inviteOpts)
+	}
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to invite members to %s", target))
+	}
+
+	c := opts.IO.Color()
+	for _, email := range opts.Emails {
+		if msg, failed := result.Message[email]; failed {
+			fmt.Fprintf(opts.IO.StdErr, "%s Could not invite %s: %s\n", c.FailedIcon(), email, msg)
+			continue
+		}
+		fmt.Fprintf(opts.IO.StdOut, "%s Invited %s to %s as %s\n", c.GreenCheck(), email, target, cmdutils.AccessLevelName(opts.accessLevel))
+	}
+
+	if result.Status != "success" {
+		// report errors for addresses which were not part of the request, e.g. normalized emails
+		var others []string
+		for email, msg := range result.Message {
+			if !utils.PresentInStringSlice(opts.Emails, email) {
+				others = append(others, fmt.Sprintf("%s: %s", email, msg))
+			}
+		}
+		sort.Strings(others)
+		for _, o := range others {
+			fmt.Fprintf(opts.IO.StdErr, "%s %s\n", c.FailedIcon(), o)
+		}
+		return cmdutils.SilentError
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package invite
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/httpmock"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xanzy/go-gitlab"
+)
+
+func newOpts(reg *httpmock.Mocker, emails ...string) (*InviteOpts, *bytes.Buffer, *bytes.Buffer) {
+	io, _, stdout, stderr := iostreams.Test()
+	opts := &InviteOpts{
+		HTTPClient: func() (*gitlab.Client, error) {
+			a, _ := api.TestClient(&http.Client{Transport: reg}, "", "gitlab.com", false)
+			return a.Lab(), nil
+		},
+		BaseRepo: func() (glrepo.Interface, error) {
+			return glrepo.FromFullName("owner/repo")
+		},
+		IO:          io,
+		Emails:      emails,
+		accessLevel: gitlab.ReporterPermissions,
+	}
+	_, _ = opts.HTTPClient()
+	return opts, stdout, stderr
+}
+
+func Test_inviteRun(t *testing.T) {
+	reg := &httpmock.Mocker{}
+	defer reg.Verify(t)
+
+	var body map[string]interface{}
+	reg.RegisterResponder("POST", "/api/v4/projects/owner/repo/invitations",
+		func(req *http.Request) (*http.Response, error) {
+			b, err := ioutil.ReadAll(req.Body)
+			require.NoError(t, err)
+			require.NoError(t, json.Unmarshal(b, &body))
+			return httpmock.NewStringResponse(201, `{"status": "success"}`)(req)
+		})
+
+	opts, stdout, _ := newOpts(reg, "john@example.com", "jane@example.com")
+	opts.ExpiresAt = "2022-12-31"
+
+	err := inviteRun(opts)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]interface{}{
+		"email":        "john@example.com,jane@example.com",
+		"access_level": float64(20),
+		"expires_at":   "2022-12-31",
+	}, body)
+	assert.Equal(t, "✓ Invited john@example.com to owner/repo as reporter\n✓ Invited jane@example.com to owner/repo as reporter\n", stdout.String())
+}
+
+func Test_inviteRun_partialFailure(t *testing.T) {
+	reg := &httpmock.Mocker{}
+	defer reg.Verify(t)
+
+	reg.RegisterResponder("POST", "/api/v4/groups/mygroup/invitations",
+		httpmock.NewStringResponse(201, `{"status": "error", "message": {
+			"jane@example.com": "Already a member",
+			"smith@example.com": "Invite email is invalid"
+		}}`))
+
+	opts, stdout, stderr := newOpts(reg, "john@example.com", "jane@example.com")
+	opts.Group = "mygroup"
+
+	err := inviteRun(opts)
+	assert.Equal(t, cmdutils.SilentError, err)
+
+	assert.Equal(t, "✓ Invited john@example.com to mygroup as reporter\n", stdout.String())
+	assert.Equal(t, "x Could not invite jane@example.com: Already a member\nx smith@example.com: Invite email is invalid\n", stderr.String())
+}
@@ -0,0 +1,124 @@
+package list
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/commands/member/memberutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/profclems/glab/pkg/tableprinter"
+	"github.com/profclems/glab/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type ListOpts struct {
+	HTTPClient func() (*gitlab.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+
+	Group          string
+	Search         string
+	MinAccessLevel string
+}
+
+func NewCmdList(f *cmdutils.Factory, runE func(*ListOpts) error) *cobra.Command {
+	opts := &ListOpts{
+		IO: f.IO,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list [flags]",
+		Short:   `List members of a project or group`,
+		Long:    `List direct and inherited members of a project or group with their access level and expiry date`,
+		Aliases: []string{"ls"},
+		Example: heredoc.Doc(`
+			$ glab member list
+			$ glab member list --search john
+			$ glab member list -g mygroup --access-level maintainer
+		`),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.HTTPClient = f.HttpClient
+			opts.BaseRepo = f.BaseRepo
+
+			if opts.MinAccessLevel != "" {
+				if _, err := cmdutils.ParseAccessLevel(opts.MinAccessLevel); err != nil {
+					return &cmdutils.FlagError{Err: err}
+				}
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return listRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Group, "group", "g", "", "List members of a group instead of the project")
+	cmd.Flags().StringVarP(&opts.Search, "search", "s", "", "Filter members by name or username")
+	cmd.Flags().StringVarP(&opts.MinAccessLevel, "access-level", "a", "", "Only list members with at least this access level")
+
+	return cmd
+}
+
+func listRun(opts *ListOpts) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	target, err := cmdutils.ResolveTarget(opts.Group, opts.BaseRepo)
+	if err != nil {
+		return err
+	}
+
+	members, err := memberutils.ListMembers(apiClient, target, opts.Search)
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to list members")
+	}
+
+	if opts.MinAccessLevel != "" {
+		minLevel, _ := cmdutils.ParseAccessLevel(opts.MinAccessLevel)
+		filtered := members[:0]
+		for _, m := range members {
+			if m.AccessLevel >= minLevel {
+				filtered = append(filtered, m)
+			}
+		}
+		members = filtered
+	}
+
+	if len(members) == 0 {
+		fmt.Fprintf(opts.IO.StdOut, "No members found on %s\n", target)
+		return nil
+	}
+
+	fmt.Fprintf(opts.IO.StdOut, "Showing %s on %s\n\n", utils.Pluralize(len(members), "member"), target)
+	fmt.Fprint(opts.IO.StdOut, DisplayMembers(opts.IO, members))
+	return nil
+}
+
+// DisplayMembers renders a table of members with their access level and expiry date
+func DisplayMembers(streams *iostreams.IOStreams, members []*memberutils.Member) string {
+	c := streams.Color()
+	table := tableprinter.NewTablePrinter()
+	table.AddRow("USERNAME", "NAME", "ACCESS LEVEL", "EXPIRES")
+
+	for _, m := range members {
+		expires := "never"
+		if m.ExpiresAt != nil {
+			expires = m.ExpiresAt.String()
+		}
+		state := ""
+		if m.State != "" && m.State != "active" {
+			state = c.Gray(" (" + m.State + ")")
+		}
+		table.AddRow("@"+m.Username+state, m.Name, cmdutils.AccessLevelName(m.AccessLevel), c.Gray(expires))
+	}
+
+	return table.Render()
+}
@@ -0,0 +1,40 @@
+package member
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/profclems/glab/commands/cmdutils"
+	memberAddCmd "github.com/profclems/glab/commands/member/add"
+	memberExpiringCmd "github.com/profclems/glab/commands/member/expiring"
+	memberInviteCmd "github.com/profclems/glab/commands/member/invite"
+	memberListCmd "github.com/profclems/glab/commands/member/list"
+	memberRemoveCmd "github.com/profclems/glab/commands/member/remove"
+	memberUpdateCmd "github.com/profclems/glab/commands/member/update"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdMember(f *cmdutils.Factory) *cobra.Command {
+	var memberCmd = &cobra.Command{
+		Use:   "member <command> [flags]",
+		Short: `Manage project and group members`,
+		Long: heredoc.Doc(`
+			Manage the members of a project or group.
+			Commands act on the current project unless a group is given with --group.
+		`),
+		Example: heredoc.Doc(`
+			$ glab member list
+			$ glab member add johndoe --access-level maintainer --expires-at 2022-12-31
+			$ glab member invite jane@example.com -g mygroup
+			$ glab member expiring --within 2w
+		`),
+	}
+
+	cmdutils.EnableRepoOverride(memberCmd, f)
+
+	memberCmd.AddCommand(memberListCmd.NewCmdList(f, nil))
+	memberCmd.AddCommand(memberAddCmd.NewCmdAdd(f, nil))
+	memberCmd.AddCommand(memberUpdateCmd.NewCmdUpdate(f, nil))
+	memberCmd.AddCommand(memberRemoveCmd.NewCmdRemove(f, nil))
+	memberCmd.AddCommand(memberInviteCmd.NewCmdInvite(f, nil))
+	memberCmd.AddCommand(memberExpiringCmd.NewCmdExpiring(f, nil))
+	return memberCmd
+}
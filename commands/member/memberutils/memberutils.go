@@ -0,0 +1,157 @@
+package memberutils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/xanzy/go-gitlab"
+)
+
+// Member is a member of either a project or a group
+type Member struct {
+	ID          int
+	Username    string
+	Name        string
+	State       string
+	AccessLevel gitlab.AccessLevelValue
+	ExpiresAt   *gitlab.ISOTime
+	WebURL      string
+}
+
+// ListMembers returns all members of the target, including inherited members
+func ListMembers(client *gitlab.Client, target cmdutils.Target, query string) ([]*Member, error) {
+	return listMembers(client, target, query, true)
+}
+
+// ListDirectMembers returns the members of the target, without inherited members
+func ListDirectMembers(client *gitlab.Client, target cmdutils.Target, query string) ([]*Member, error) {
+	return listMembers(client, target, query, false)
+}
+
+func listMembers(client *gitlab.Client, target cmdutils.Target, query string, inherited bool) ([]*Member, error) {
+	var members []*Member
+
+	if target.Group != "" {
+		listGroupMembers := api.ListDirectGroupMembers
+		if inherited {
+			listGroupMembers = api.ListGroupMembers
+		}
+
+		opts := &gitlab.ListGroupMembersOptions{}
+		opts.PerPage = 100
+		if query != "" {
+			opts.Query = gitlab.String(query)
+		}
+		for opts.Page = 1; ; opts.Page++ {
+			groupMembers, err := listGroupMembers(client, target.Group, opts)
+			if err != nil {
+				return nil, err
+			}
+			for _, m := range groupMembers {
+				members = append(members, &Member{
+					ID:          m.ID,
+					Username:    m.Username,
+					Name:        m.Name,
+					State:       m.State,
+					AccessLevel: m.AccessLevel,
+					ExpiresAt:   m.ExpiresAt,
+					WebURL:      m.WebURL,
+				})
+			}
+			if len(groupMembers) < opts.PerPage {
+				break
+			}
+		}
+		return members, nil
+	}
+
+	listProjectMembers := api.ListDirectProjectMembers
+	if inherited {
+		listProjectMembers = api.ListProjectMembers
+	}
+
+	opts := &gitlab.ListProjectMembersOptions{}
+	opts.PerPage = 100
+	if query != "" {
+		opts.Query = gitlab.String(query)
+	}
+	for opts.Page = 1; ; opts.Page++ {
+		projectMembers, err := listProjectMembers(client, target.Repo.FullName(), opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range projectMembers {
+			members = append(members, &Member{
+				ID:          m.ID,
+				Username:    m.Username,
+				Name:        m.Name,
+				State:       m.State,
+				AccessLevel: m.AccessLevel,
+				ExpiresAt:   m.ExpiresAt,
+				WebURL:      m.WebURL,
+			})
+		}
+		if len(projectMembers) < opts.PerPage {
+			break
+		}
+	}
+	return members, nil
+}
+
+// UserID resolves a username or a numeric user ID to a user ID
+func UserID(client *gitlab.Client, user string) (int, error) {
+	if id, err := strconv.Atoi(user); err == nil {
+		return id, nil
+	}
+	if user != "@me" {
+		user = strings.TrimPrefix(user, "@")
+	}
+	u, err := api.UserByName(client, user)
+	if err != nil {
+		return 0, err
+	}
+	return u.ID, nil
+}
+
+// FindMember returns the direct member of the target matching the given username or user ID.
+// Inherited members can only be changed in the group they are inherited from, so an error
+// is returned for them.
+func FindMember(client *gitlab.Client, target cmdutils.Target, user string) (*Member, error) {
+	var query string
+	id, err := strconv.Atoi(user)
+	if user == "@me" {
+		id, err = UserID(client, user)
+		if err != nil {
+			return nil, err
+		}
+	} else if err != nil {
+		query = strings.TrimPrefix(user, "@")
+	}
+	matches := func(m *Member) bool {
+		return (query == "" && m.ID == id) || (query != "" && strings.EqualFold(m.Username, query))
+	}
+
+	members, err := ListDirectMembers(client, target, query)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range members {
+		if matches(m) {
+			return m, nil
+		}
+	}
+
+	members, err = ListMembers(client, target, query)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range members {
+		if matches(m) {
+			return nil, fmt.Errorf("%s is inherited from a parent group of %s. Update or remove them in that group instead", user, target)
+		}
+	}
+	return nil, fmt.Errorf("%s is not a member of %s", user, target)
+}
@@ -0,0 +1,109 @@
+package remove
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/commands/member/memberutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/profclems/glab/pkg/prompt"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type RemoveOpts struct {
+	HTTPClient func() (*gitlab.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+
+	Users       []string
+	Group       string
+	ForceRemove bool
+}
+
+func NewCmdRemove(f *cmdutils.Factory, runE func(*RemoveOpts) error) *cobra.Command {
+	opts := &RemoveOpts{
+		IO: f.IO,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "remove <username>... [flags]",
+		Short:   `Remove members from a project or group`,
+		Long:    ``,
+		Aliases: []string{"rm", "delete"},
+		Example: heredoc.Doc(`
+			$ glab member remove johndoe
+			$ glab member remove johndoe janedoe -g mygroup -y
+		`),
+		Args: cmdutils.MinimumArgs(1, "no user specified"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.HTTPClient = f.HttpClient
+			opts.BaseRepo = f.BaseRepo
+			opts.Users = args
+
+			if !opts.ForceRemove && !opts.IO.PromptEnabled() {
+				return &cmdutils.FlagError{Err: errors.New("--yes or -y flag is required when not running interactively")}
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return removeRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Group, "group", "g", "", "Remove members from a group instead of the project")
+	cmd.Flags().BoolVarP(&opts.ForceRemove, "yes", "y", false, "Skip the confirmation prompt")
+
+	return cmd
+}
+
+func removeRun(opts *RemoveOpts) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	target, err := cmdutils.ResolveTarget(opts.Group, opts.BaseRepo)
+	if err != nil {
+		return err
+	}
+
+	if !opts.ForceRemove && opts.IO.PromptEnabled() {
+		err = prompt.Confirm(&opts.ForceRemove, fmt.Sprintf("Remove %s from %s?", strings.Join(opts.Users, ", "), target), false)
+		if err != nil {
+			return cmdutils.WrapError(err, "could not prompt")
+		}
+	}
+
+	if !opts.ForceRemove {
+		return cmdutils.CancelError()
+	}
+
+	c := opts.IO.Color()
+	for _, user := range opts.Users {
+		member, err := memberutils.FindMember(apiClient, target, user)
+		if err != nil {
+			return err
+		}
+
+		if target.Group != "" {
+			err = api.RemoveGroupMember(apiClient, target.Group, member.ID)
+		} else {
+			err = api.DeleteProjectMember(apiClient, target.Repo.FullName(), member.ID)
+		}
+		if err != nil {
+			return cmdutils.WrapError(err, fmt.Sprintf("failed to remove %s from %s", user, target))
+		}
+
+		fmt.Fprintf(opts.IO.StdOut, "%s Removed %s from %s\n", c.RedCheck(), user, target)
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package remove
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/httpmock"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xanzy/go-gitlab"
+)
+
+func newOpts(reg *httpmock.Mocker, users ...string) (*RemoveOpts, *bytes.Buffer) {
+	io, _, stdout, _ := iostreams.Test()
+	opts := &RemoveOpts{
+		HTTPClient: func() (*gitlab.Client, error) {
+			a, _ := api.TestClient(&http.Client{Transport: reg}, "", "gitlab.com", false)
+			return a.Lab(), nil
+		},
+		BaseRepo: func() (glrepo.Interface, error) {
+			return glrepo.FromFullName("owner/repo")
+		},
+		IO:          io,
+		Users:       users,
+		ForceRemove: true,
+	}
+	_, _ = opts.HTTPClient()
+	return opts, stdout
+}
+
+func Test_removeRun(t *testing.T) {
+	reg := &httpmock.Mocker{}
+	defer reg.Verify(t)
+
+	reg.RegisterResponder("GET", "/api/v4/projects/owner/repo/members",
+		httpmock.NewStringResponse(200, `[{"id": 2, "username": "bob", "access_level": 30}]`))
+	reg.RegisterResponder("DELETE", "/api/v4/projects/owner/repo/members/2",
+		httpmock.NewStringResponse(204, ``))
+
+	opts, stdout := newOpts(reg, "@bob")
+
+	err := removeRun(opts)
+	require.NoError(t, err)
+
+	assert.Equal(t, "✓ Removed @bob from owner/repo\n", stdout.String())
+}
+
+func Test_removeRun_group(t *testing.T) {
+	reg := &httpmock.Mocker{}
+	defer reg.Verify(t)
+
+	reg.RegisterResponder("GET", "/api/v4/groups/mygroup/members",
+		httpmock.NewStringResponse(200, `[{"id": 2, "username": "bob", "access_level": 30}]`))
+	reg.RegisterResponder("DELETE", "/api/v4/groups/mygroup/members/2",
+		httpmock.NewStringResponse(204, ``))
+
+	opts, stdout := newOpts(reg, "2")
+	opts.Group = "mygroup"
+
+	err := removeRun(opts)
+	require.NoError(t, err)
+
+	assert.Equal(t, "✓ Removed 2 from mygroup\n", stdout.String())
+}
+
+func Test_removeRun_inherited(t *testing.T) {
+	reg := &httpmock.Mocker{}
+	defer reg.Verify(t)
+
+	reg.RegisterResponder("GET", "/api/v4/projects/owner/repo/members",
+		httpmock.NewStringResponse(200, `[]`))
+	reg.RegisterResponder("GET", "/api/v4/projects/owner/repo/members/all",
+		httpmock.NewStringResponse(200, `[{"id": 2, "username": "bob", "access_level": 30}]`))
+
+	opts, _ := newOpts(reg, "bob")
+
+	err := removeRun(opts)
+	assert.EqualError(t, err, "bob is inherited from a parent group of owner/repo. Update or remove them in that group instead")
+}
+
+func Test_removeRun_cancelled(t *testing.T) {
+	reg := &httpmock.Mocker{}
+	defer reg.Verify(t)
+
+	opts, _ := newOpts(reg, "bob")
+	opts.ForceRemove = false
+
+	err := removeRun(opts)
+	assert.Equal(t, cmdutils.CancelError(), err)
+}
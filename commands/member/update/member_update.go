@@ -0,0 +1,137 @@
+package update
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/commands/member/memberutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type UpdateOpts struct {
+	HTTPClient func() (*gitlab.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+
+	User        string
+	Group       string
+	AccessLevel string
+	ExpiresAt   string
+	NoExpiry    bool
+}
+
+func NewCmdUpdate(f *cmdutils.Factory, runE func(*UpdateOpts) error) *cobra.Command {
+	opts := &UpdateOpts{
+		IO: f.IO,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "update <username> [flags]",
+		Short: `Update the access level or expiry date of a member`,
+		Long:  ``,
+		Example: heredoc.Doc(`
+			$ glab member update johndoe --access-level maintainer
+			$ glab member update johndoe --expires-at 2022-12-31
+			$ glab member update johndoe -g mygroup --no-expiry
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.HTTPClient = f.HttpClient
+			opts.BaseRepo = f.BaseRepo
+			opts.User = args[0]
+
+			if opts.AccessLevel == "" && opts.ExpiresAt == "" && !opts.NoExpiry {
+				return &cmdutils.FlagError{Err: errors.New("nothing to update. Specify --access-level, --expires-at or --no-expiry")}
+			}
+			if opts.ExpiresAt != "" && opts.NoExpiry {
+				return &cmdutils.FlagError{Err: errors.New("--expires-at and --no-expiry are mutually exclusive")}
+			}
+			if opts.AccessLevel != "" {
+				if _, err := cmdutils.ParseAccessLevel(opts.AccessLevel); err != nil {
+					return &cmdutils.FlagError{Err: err}
+				}
+			}
+			if opts.ExpiresAt != "" {
+				if _, err := cmdutils.ParseExpiresAt(opts.ExpiresAt); err != nil {
+					return &cmdutils.FlagError{Err: err}
+				}
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return updateRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Group, "group", "g", "", "Update a member of a group instead of the project")
+	cmd.Flags().StringVarP(&opts.AccessLevel, "access-level", "a", "", "New access level of the member")
+	cmd.Flags().StringVarP(&opts.ExpiresAt, "expires-at", "e", "", "New date the membership expires in the YYYY-MM-DD format")
+	cmd.Flags().BoolVarP(&opts.NoExpiry, "no-expiry", "", false, "Remove the expiry date of the membership")
+
+	return cmd
+}
+
+func updateRun(opts *UpdateOpts) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	target, err := cmdutils.ResolveTarget(opts.Group, opts.BaseRepo)
+	if err != nil {
+		return err
+	}
+
+	member, err := memberutils.FindMember(apiClient, target, opts.User)
+	if err != nil {
+		return err
+	}
+
+	// the API requires the access level and clears the expiry date when it is omitted
+	// so the current values are sent for the fields which are not being updated
+	accessLevel := member.AccessLevel
+	if opts.AccessLevel != "" {
+		accessLevel, _ = cmdutils.ParseAccessLevel(opts.AccessLevel)
+	}
+
+	var expiresAt *string
+	if opts.ExpiresAt != "" {
+		expiresAt = gitlab.String(opts.ExpiresAt)
+	} else if !opts.NoExpiry && member.ExpiresAt != nil {
+		expiresAt = gitlab.String(member.ExpiresAt.String())
+	}
+
+	if target.Group != "" {
+		_, err = api.EditGroupMember(apiClient, target.Group, member.ID, &gitlab.EditGroupMemberOptions{
+			AccessLevel: gitlab.AccessLevel(accessLevel),
+			ExpiresAt:   expiresAt,
+		})
+	} else {
+		_, err = api.EditProjectMember(apiClient, target.Repo.FullName(), member.ID, &gitlab.EditProjectMemberOptions{
+			AccessLevel: gitlab.AccessLevel(accessLevel),
+			ExpiresAt:   expiresAt,
+		})
+	}
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to update %s on %s", opts.User, target))
+	}
+
+	expiry := "never"
+	if expiresAt != nil {
+		expiry = *expiresAt
+	}
+
+	c := opts.IO.Color()
+	fmt.Fprintf(opts.IO.StdOut, "%s Updated @%s on %s: access level %s, expires %s\n",
+		c.GreenCheck(), member.Username, target, cmdutils.AccessLevelName(accessLevel), expiry)
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package update
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/httpmock"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xanzy/go-gitlab"
+)
+
+func newOpts(reg *httpmock.Mocker, user string) (*UpdateOpts, *bytes.Buffer) {
+	io, _, stdout, _ := iostreams.Test()
+	opts := &UpdateOpts{
+		HTTPClient: func() (*gitlab.Client, error) {
+			a, _ := api.TestClient(&http.Client{Transport: reg}, "", "gitlab.com", false)
+			return a.Lab(), nil
+		},
+		BaseRepo: func() (glrepo.Interface, error) {
+			return glrepo.FromFullName("owner/repo")
+		},
+		IO:   io,
+		User: user,
+	}
+	_, _ = opts.HTTPClient()
+	return opts, stdout
+}
+
+func Test_updateRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		opts       UpdateOpts
+		wantBody   map[string]interface{}
+		wantOutput string
+	}{
+		{
+			name:       "access level keeps the expiry date",
+			opts:       UpdateOpts{AccessLevel: "maintainer"},
+			wantBody:   map[string]interface{}{"access_level": float64(40), "expires_at": "2022-12-31"},
+			wantOutput: "✓ Updated @bob on owner/repo: access level maintainer, expires 2022-12-31\n",
+		},
+		{
+			name:       "expiry date keeps the access level",
+			opts:       UpdateOpts{ExpiresAt: "2023-06-30"},
+			wantBody:   map[string]interface{}{"access_level": float64(30), "expires_at": "2023-06-30"},
+			wantOutput: "✓ Updated @bob on owner/repo: access level developer, expires 2023-06-30\n",
+		},
+		{
+			name:       "no expiry",
+			opts:       UpdateOpts{NoExpiry: true},
+			wantBody:   map[string]interface{}{"access_level": float64(30), "expires_at": nil},
+			wantOutput: "✓ Updated @bob on owner/repo: access level developer, expires never\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Mocker{}
+			defer reg.Verify(t)
+
+			var body map[string]interface{}
+			reg.RegisterResponder("GET", "/api/v4/projects/owner/repo/members",
+				httpmock.NewStringResponse(200, `[
+					{"id": 2, "username": "bob", "access_level": 30, "expires_at": "2022-12-31"}
+				]`))
+			reg.RegisterResponder("PUT", "/api/v4/projects/owner/repo/members/2",
+				func(req *http.Request) (*http.Response, error) {
+					b, err := ioutil.ReadAll(req.Body)
+					require.NoError(t, err)
+					require.NoError(t, json.Unmarshal(b, &body))
+					return httpmock.NewStringResponse(200, `{"id": 2}`)(req)
+				})
+
+			opts, stdout := newOpts(reg, "bob")
+			opts.AccessLevel = tt.opts.AccessLevel
+			opts.ExpiresAt = tt.opts.ExpiresAt
+			opts.NoExpiry = tt.opts.NoExpiry
+
+			err := updateRun(opts)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantBody, body)
+			assert.Equal(t, tt.wantOutput, stdout.String())
+		})
+	}
+}
+
+func Test_updateRun_inherited(t *testing.T) {
+	reg := &httpmock.Mocker{}
+	defer reg.Verify(t)
+
+	reg.RegisterResponder("GET", "/api/v4/projects/owner/repo/members",
+		httpmock.NewStringResponse(200, `[]`))
+	reg.RegisterResponder("GET", "/api/v4/projects/owner/repo/members/all",
+		httpmock.NewStringResponse(200, `[{"id": 2, "username": "bob", "access_level": 30}]`))
+
+	opts, _ := newOpts(reg, "bob")
+	opts.AccessLevel = "maintainer"
+
+	err := updateRun(opts)
+	assert.EqualError(t, err, "bob is inherited from a parent group of owner/repo. Update or remove them in that group instead")
+}
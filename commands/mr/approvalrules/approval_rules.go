@@ -0,0 +1,32 @@
+package approvalrules
+
+import (
+	"github.com/profclems/glab/commands/cmdutils"
+	rulesCreateCmd "github.com/profclems/glab/commands/mr/approvalrules/create"
+	rulesDeleteCmd "github.com/profclems/glab/commands/mr/approvalrules/delete"
+	rulesListCmd "github.com/profclems/glab/commands/mr/approvalrules/list"
+	rulesUpdateCmd "github.com/profclems/glab/commands/mr/approvalrules/update"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdApprovalRules(f *cmdutils.Factory) *cobra.Command {
+	var approvalRulesCmd = &cobra.Command{
+		Use:   "approval-rules <command> [flags]",
+		Short: `Manage the approval rules of merge requests and projects`,
+		Long: heredoc.Doc(`
+			Manage the approval rules of a merge request, or of the project with --project.
+
+			An approval rule requires a number of approvals from its eligible users and
+			members of its eligible groups before a merge request can be merged.
+		`),
+		Aliases: []string{"rules"},
+	}
+
+	approvalRulesCmd.AddCommand(rulesCreateCmd.NewCmdCreate(f, nil))
+	approvalRulesCmd.AddCommand(rulesDeleteCmd.NewCmdDelete(f, nil))
+	approvalRulesCmd.AddCommand(rulesListCmd.NewCmdList(f, nil))
+	approvalRulesCmd.AddCommand(rulesUpdateCmd.NewCmdUpdate(f, nil))
+	return approvalRulesCmd
+}
@@ -0,0 +1,128 @@
+package create
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/commands/mr/approvalrules/rulesutils"
+	"github.com/profclems/glab/commands/mr/mrutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type CreateOpts struct {
+	Project bool
+	Rule    rulesutils.RuleFlags
+
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+	HTTPClient func() (*gitlab.Client, error)
+	MR         func() (*gitlab.MergeRequest, glrepo.Interface, error)
+}
+
+func NewCmdCreate(f *cmdutils.Factory, runE func(*CreateOpts) error) *cobra.Command {
+	opts := &CreateOpts{
+		IO: f.IO,
+	}
+
+	var createCmd = &cobra.Command{
+		Use:   "create [<id> | <branch>] [flags]",
+		Short: `Create an approval rule for a merge request or project`,
+		Long: heredoc.Doc(`
+			Create an approval rule for a merge request, or for the project with --project.
+
+			Project rules apply to the merge requests of every branch, or only to those
+			targeting the protected branches given with --branch.
+		`),
+		Example: heredoc.Doc(`
+			$ glab mr approval-rules create 235 --name Security --approvals 1 --group my-org/security
+			$ glab mr approval-rules create --project --name Backend --approvals 2 --user alice,bob --branch main
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+			opts.HTTPClient = f.HttpClient
+			opts.MR = func() (*gitlab.MergeRequest, glrepo.Interface, error) {
+				return mrutils.MRFromArgs(f, args, "opened")
+			}
+
+			if opts.Project && len(args) > 0 {
+				return &cmdutils.FlagError{Err: errors.New("a merge request cannot be given with --project")}
+			}
+			if opts.Rule.Name == "" {
+				return &cmdutils.FlagError{Err: errors.New("--name is required")}
+			}
+			if err := opts.Rule.Validate(opts.Project); err != nil {
+				return err
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return createRun(opts)
+		},
+	}
+
+	opts.Rule.AddFlags(createCmd.Flags())
+	createCmd.Flags().BoolVarP(&opts.Project, "project", "p", false, "Create a rule for the project")
+
+	return createCmd
+}
+
+func createRun(opts *CreateOpts) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	var mr *gitlab.MergeRequest
+	var repo glrepo.Interface
+	if opts.Project {
+		repo, err = opts.BaseRepo()
+	} else {
+		mr, repo, err = opts.MR()
+	}
+	if err != nil {
+		return err
+	}
+
+	userIDs, groupIDs, branchIDs, err := opts.Rule.Members(apiClient, repo)
+	if err != nil {
+		return err
+	}
+
+	c := opts.IO.Color()
+	if opts.Project {
+		rule, err := api.CreateProjectApprovalRule(apiClient, repo.FullName(), &gitlab.CreateProjectLevelRuleOptions{
+			Name:               gitlab.String(opts.Rule.Name),
+			ApprovalsRequired:  gitlab.Int(opts.Rule.Approvals),
+			UserIDs:            userIDs,
+			GroupIDs:           groupIDs,
+			ProtectedBranchIDs: branchIDs,
+		})
+		if err != nil {
+			return cmdutils.WrapError(err, "failed to create the approval rule")
+		}
+		fmt.Fprintf(opts.IO.StdOut, "%s Created approval rule %q (%d) for %s\n", c.GreenCheck(), rule.Name, rule.ID, repo.FullName())
+		return nil
+	}
+
+	rule, err := api.CreateMRApprovalRule(apiClient, repo.FullName(), mr.IID, &gitlab.CreateMergeRequestApprovalRuleOptions{
+		Name:              gitlab.String(opts.Rule.Name),
+		ApprovalsRequired: gitlab.Int(opts.Rule.Approvals),
+		UserIDs:           userIDs,
+		GroupIDs:          groupIDs,
+	})
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to create the approval rule")
+	}
+	fmt.Fprintf(opts.IO.StdOut, "%s Created approval rule %q (%d) for !%d\n", c.GreenCheck(), rule.Name, rule.ID, mr.IID)
+	return nil
+}
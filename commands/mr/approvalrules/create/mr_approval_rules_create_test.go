@@ -0,0 +1,70 @@
+package create
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/mr/approvalrules/rulesutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/httpmock"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xanzy/go-gitlab"
+)
+
+func Test_createRun_project(t *testing.T) {
+	reg := &httpmock.Mocker{}
+	defer reg.Verify(t)
+
+	var body map[string]interface{}
+	reg.RegisterResponder("GET", "/api/v4/users",
+		httpmock.NewStringResponse(200, `[{"id": 7, "username": "alice"}]`))
+	reg.RegisterResponder("GET", "/api/v4/groups/org/backend",
+		httpmock.NewStringResponse(200, `{"id": 20, "full_path": "org/backend"}`))
+	reg.RegisterResponder("GET", "/api/v4/projects/OWNER/REPO/protected_branches/main",
+		httpmock.NewStringResponse(200, `{"id": 3, "name": "main"}`))
+	reg.RegisterResponder("POST", "/api/v4/projects/OWNER/REPO/approval_rules",
+		func(req *http.Request) (*http.Response, error) {
+			b, err := ioutil.ReadAll(req.Body)
+			require.NoError(t, err)
+			require.NoError(t, json.Unmarshal(b, &body))
+			return httpmock.NewStringResponse(201, `{"id": 12, "name": "Backend"}`)(req)
+		})
+
+	io, _, stdout, _ := iostreams.Test()
+	opts := &CreateOpts{
+		Project: true,
+		Rule: rulesutils.RuleFlags{
+			Name:      "Backend",
+			Approvals: 2,
+			Users:     []string{"alice"},
+			Groups:    []string{"org/backend"},
+			Branches:  []string{"main"},
+		},
+		IO: io,
+		BaseRepo: func() (glrepo.Interface, error) {
+			return glrepo.New("OWNER", "REPO"), nil
+		},
+		HTTPClient: func() (*gitlab.Client, error) {
+			a, _ := api.TestClient(&http.Client{Transport: reg}, "", "gitlab.com", false)
+			return a.Lab(), nil
+		},
+	}
+	_, _ = opts.HTTPClient()
+
+	err := createRun(opts)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]interface{}{
+		"name":                 "Backend",
+		"approvals_required":   float64(2),
+		"user_ids":             []interface{}{float64(7)},
+		"group_ids":            []interface{}{float64(20)},
+		"protected_branch_ids": []interface{}{float64(3)},
+	}, body)
+	assert.Equal(t, "✓ Created approval rule \"Backend\" (12) for OWNER/REPO\n", stdout.String())
+}
@@ -0,0 +1,116 @@
+package delete
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/commands/mr/approvalrules/rulesutils"
+	"github.com/profclems/glab/commands/mr/mrutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type DeleteOpts struct {
+	Project bool
+	RuleArg string
+
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+	HTTPClient func() (*gitlab.Client, error)
+	MR         func() (*gitlab.MergeRequest, glrepo.Interface, error)
+}
+
+func NewCmdDelete(f *cmdutils.Factory, runE func(*DeleteOpts) error) *cobra.Command {
+	opts := &DeleteOpts{
+		IO: f.IO,
+	}
+
+	var deleteCmd = &cobra.Command{
+		Use:   "delete <rule> [<id> | <branch>] [flags]",
+		Short: `Delete an approval rule of a merge request or project`,
+		Long: heredoc.Doc(`
+			Delete an approval rule of a merge request, or of the project with --project.
+			The rule is given by its ID or name.
+		`),
+		Aliases: []string{"rm"},
+		Example: heredoc.Doc(`
+			$ glab mr approval-rules delete Security 235
+			$ glab mr approval-rules delete 12 --project
+		`),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+			opts.HTTPClient = f.HttpClient
+			opts.RuleArg = args[0]
+			opts.MR = func() (*gitlab.MergeRequest, glrepo.Interface, error) {
+				return mrutils.MRFromArgs(f, args[1:], "opened")
+			}
+
+			if opts.Project && len(args) > 1 {
+				return &cmdutils.FlagError{Err: errors.New("a merge request cannot be given with --project")}
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return deleteRun(opts)
+		},
+	}
+
+	deleteCmd.Flags().BoolVarP(&opts.Project, "project", "p", false, "Delete a rule of the project")
+
+	return deleteCmd
+}
+
+func deleteRun(opts *DeleteOpts) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	c := opts.IO.Color()
+	if opts.Project {
+		repo, err := opts.BaseRepo()
+		if err != nil {
+			return err
+		}
+		rules, err := rulesutils.ListProjectRules(apiClient, repo)
+		if err != nil {
+			return cmdutils.WrapError(err, "failed to list the approval rules")
+		}
+		rule, err := rulesutils.Find(rules, opts.RuleArg)
+		if err != nil {
+			return err
+		}
+		if err := api.DeleteProjectApprovalRule(apiClient, repo.FullName(), rule.ID); err != nil {
+			return cmdutils.WrapError(err, fmt.Sprintf("failed to delete approval rule %q", rule.Name))
+		}
+		fmt.Fprintf(opts.IO.StdOut, "%s Deleted approval rule %q of %s\n", c.GreenCheck(), rule.Name, repo.FullName())
+		return nil
+	}
+
+	mr, repo, err := opts.MR()
+	if err != nil {
+		return err
+	}
+	rules, err := rulesutils.ListMRRules(apiClient, repo, mr.IID)
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to list the approval rules")
+	}
+	rule, err := rulesutils.Find(rules, opts.RuleArg)
+	if err != nil {
+		return err
+	}
+	if err := api.DeleteMRApprovalRule(apiClient, repo.FullName(), mr.IID, rule.ID); err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to delete approval rule %q", rule.Name))
+	}
+	fmt.Fprintf(opts.IO.StdOut, "%s Deleted approval rule %q of !%d\n", c.GreenCheck(), rule.Name, mr.IID)
+	return nil
+}
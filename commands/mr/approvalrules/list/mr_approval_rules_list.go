@@ -0,0 +1,123 @@
+package list
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/commands/mr/approvalrules/rulesutils"
+	"github.com/profclems/glab/commands/mr/mrutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/profclems/glab/pkg/tableprinter"
+	"github.com/profclems/glab/pkg/utils"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type ListOpts struct {
+	Project bool
+
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+	HTTPClient func() (*gitlab.Client, error)
+	MR         func() (*gitlab.MergeRequest, glrepo.Interface, error)
+}
+
+func NewCmdList(f *cmdutils.Factory, runE func(*ListOpts) error) *cobra.Command {
+	opts := &ListOpts{
+		IO: f.IO,
+	}
+
+	var listCmd = &cobra.Command{
+		Use:   "list [<id> | <branch>] [flags]",
+		Short: `List the approval rules of a merge request or project`,
+		Long: heredoc.Doc(`
+			List the approval rules of a merge request, and whether they are satisfied, or the
+			approval rules of the project with --project.
+		`),
+		Aliases: []string{"ls"},
+		Example: heredoc.Doc(`
+			$ glab mr approval-rules list 235
+			$ glab mr approval-rules list --project
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+			opts.HTTPClient = f.HttpClient
+			opts.MR = func() (*gitlab.MergeRequest, glrepo.Interface, error) {
+				return mrutils.MRFromArgs(f, args, "any")
+			}
+
+			if opts.Project && len(args) > 0 {
+				return &cmdutils.FlagError{Err: errors.New("a merge request cannot be given with --project")}
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return listRun(opts)
+		},
+	}
+
+	listCmd.Flags().BoolVarP(&opts.Project, "project", "p", false, "List the approval rules of the project")
+
+	return listCmd
+}
+
+func listRun(opts *ListOpts) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	c := opts.IO.Color()
+	table := tableprinter.NewTablePrinter()
+
+	if opts.Project {
+		repo, err := opts.BaseRepo()
+		if err != nil {
+			return err
+		}
+		rules, err := rulesutils.ListProjectRules(apiClient, repo)
+		if err != nil {
+			return cmdutils.WrapError(err, "failed to list the approval rules")
+		}
+		if len(rules) == 0 {
+			fmt.Fprintf(opts.IO.StdErr, "%s has no approval rules\n", repo.FullName())
+			return nil
+		}
+		for _, rule := range rules {
+			table.AddRow(rule.ID, rule.Name, utils.Pluralize(rule.ApprovalsRequired, "approval")+" required", rulesutils.Describe(rule))
+		}
+		fmt.Fprintf(opts.IO.StdOut, "Approval rules of %s\n%s", repo.FullName(), table.String())
+		return nil
+	}
+
+	mr, repo, err := opts.MR()
+	if err != nil {
+		return err
+	}
+	rules, err := rulesutils.ListMRRules(apiClient, repo, mr.IID)
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to list the approval rules")
+	}
+	if len(rules) == 0 {
+		fmt.Fprintf(opts.IO.StdErr, "!%d has no approval rules\n", mr.IID)
+		return nil
+	}
+	for _, rule := range rules {
+		icon, approvals := c.FailedIcon(), fmt.Sprintf("%d/%d approved", len(rule.ApprovedBy), rule.ApprovalsRequired)
+		if rule.Approved {
+			icon, approvals = c.GreenCheck(), c.Green(approvals)
+		} else {
+			approvals = c.Yellow(approvals)
+		}
+		table.AddRow(icon, rule.ID, rule.Name, approvals, rulesutils.Describe(rule))
+	}
+	fmt.Fprintf(opts.IO.StdOut, "Approval rules of !%d\n%s", mr.IID, table.String())
+	return nil
+}
@@ -0,0 +1,187 @@
+package rulesutils
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/spf13/pflag"
+	"github.com/xanzy/go-gitlab"
+)
+
+// Rule is an approval rule of a project or of a merge request
+type Rule struct {
+	ID                int
+	Name              string
+	RuleType          string
+	ApprovalsRequired int
+	Users             []string
+	Groups            []string
+	Branches          []string
+	ApprovedBy        []string
+	Approved          bool
+}
+
+// FromProjectRule converts a project approval rule
+func FromProjectRule(r *gitlab.ProjectApprovalRule) *Rule {
+	rule := &Rule{
+		ID:                r.ID,
+		Name:              r.Name,
+		RuleType:          r.RuleType,
+		ApprovalsRequired: r.ApprovalsRequired,
+		Users:             usernames(r.Users),
+		Groups:            groupPaths(r.Groups),
+	}
+	for _, branch := range r.ProtectedBranches {
+		rule.Branches = append(rule.Branches, branch.Name)
+	}
+	return rule
+}
+
+// FromMRRule converts an approval rule of a merge request, with its approval state
+// when it comes from the approval state of the merge request
+func FromMRRule(r *gitlab.MergeRequestApprovalRule) *Rule {
+	return &Rule{
+		ID:                r.ID,
+		Name:              r.Name,
+		RuleType:          r.RuleType,
+		ApprovalsRequired: r.ApprovalsRequired,
+		Users:             usernames(r.Users),
+		Groups:            groupPaths(r.Groups),
+		ApprovedBy:        usernames(r.ApprovedBy),
+		Approved:          r.Approved,
+	}
+}
+
+func usernames(users []*gitlab.BasicUser) []string {
+	var names []string
+	for _, user := range users {
+		names = append(names, user.Username)
+	}
+	return names
+}
+
+func groupPaths(groups []*gitlab.Group) []string {
+	var paths []string
+	for _, group := range groups {
+		paths = append(paths, group.FullPath)
+	}
+	return paths
+}
+
+// ListProjectRules returns the approval rules of the project
+func ListProjectRules(client *gitlab.Client, repo glrepo.Interface) ([]*Rule, error) {
+	projectRules, err := api.ListProjectApprovalRules(client, repo.FullName())
+	if err != nil {
+		return nil, err
+	}
+	var rules []*Rule
+	for _, r := range projectRules {
+		rules = append(rules, FromProjectRule(r))
+	}
+	return rules, nil
+}
+
+// ListMRRules returns the approval rules of the merge request and whether they are satisfied
+func ListMRRules(client *gitlab.Client, repo glrepo.Interface, mrIID int) ([]*Rule, error) {
+	state, err := api.GetMRApprovalState(client, repo.FullName(), mrIID)
+	if err != nil {
+		return nil, err
+	}
+	var rules []*Rule
+	for _, r := range state.Rules {
+		rules = append(rules, FromMRRule(r))
+	}
+	return rules, nil
+}
+
+// Find returns the rule with the given ID or name. Names are matched case-insensitively.
+func Find(rules []*Rule, arg string) (*Rule, error) {
+	id, _ := strconv.Atoi(arg)
+	for _, rule := range rules {
+		if rule.ID == id || strings.EqualFold(rule.Name, arg) {
+			return rule, nil
+		}
+	}
+	return nil, fmt.Errorf("no approval rule with the ID or name %q", arg)
+}
+
+// RuleFlags are the flags which set up an approval rule
+type RuleFlags struct {
+	Name      string
+	Approvals int
+	Users     []string
+	Groups    []string
+	Branches  []string
+}
+
+// AddFlags registers the rule flags on the flag set
+func (f *RuleFlags) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVarP(&f.Name, "name", "n", "", "Name of the rule")
+	fs.IntVarP(&f.Approvals, "approvals", "a", 0, "Number of approvals required")
+	fs.StringSliceVarP(&f.Users, "user", "u", []string{}, "Username of an eligible approver. Can be repeated or comma-separated")
+	fs.StringSliceVarP(&f.Groups, "group", "g", []string{}, "Path of a group whose members are eligible approvers. Can be repeated or comma-separated")
+	fs.StringSliceVarP(&f.Branches, "branch", "b", []string{}, "Protected branch the rule applies to, for project rules. Can be repeated or comma-separated")
+}
+
+// Validate checks the flags against the level of the rule
+func (f *RuleFlags) Validate(project bool) error {
+	if f.Approvals < 0 {
+		return &cmdutils.FlagError{Err: errors.New("--approvals cannot be negative")}
+	}
+	if !project && len(f.Branches) > 0 {
+		return &cmdutils.FlagError{Err: errors.New("--branch can only be used with --project, merge request rules apply to the target branch")}
+	}
+	return nil
+}
+
+// Members resolves the eligible approvers and protected branches of the flags to their IDs
+func (f *RuleFlags) Members(client *gitlab.Client, repo glrepo.Interface) (userIDs, groupIDs, branchIDs []int, err error) {
+	users, err := api.UsersByNames(client, f.Users)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	for _, user := range users {
+		userIDs = append(userIDs, user.ID)
+	}
+	for _, path := range f.Groups {
+		group, err := api.GetGroup(client, path)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to find group %q: %w", path, err)
+		}
+		groupIDs = append(groupIDs, group.ID)
+	}
+	for _, name := range f.Branches {
+		branch, err := api.GetProtectedBranch(client, repo.FullName(), name)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to find protected branch %q: %w", name, err)
+		}
+		branchIDs = append(branchIDs, branch.ID)
+	}
+	return userIDs, groupIDs, branchIDs, nil
+}
+
+// Describe summarises the approvers and branches of a rule
+func Describe(rule *Rule) string {
+	var parts []string
+	if len(rule.Users) > 0 {
+		parts = append(parts, "users: "+strings.Join(rule.Users, ", "))
+	}
+	if len(rule.Groups) > 0 {
+		parts = append(parts, "groups: "+strings.Join(rule.Groups, ", "))
+	}
+	if len(rule.Branches) > 0 {
+		parts = append(parts, "branches: "+strings.Join(rule.Branches, ", "))
+	}
+	if len(parts) == 0 {
+		if rule.RuleType == "any_approver" {
+			return "any eligible user"
+		}
+		return "-"
+	}
+	return strings.Join(parts, "; ")
+}
@@ -0,0 +1,43 @@
+package rulesutils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xanzy/go-gitlab"
+)
+
+func TestFind(t *testing.T) {
+	rules := []*Rule{
+		{ID: 12, Name: "Backend"},
+		{ID: 13, Name: "Security"},
+	}
+
+	rule, err := Find(rules, "13")
+	require.NoError(t, err)
+	assert.Equal(t, "Security", rule.Name)
+
+	rule, err = Find(rules, "backend")
+	require.NoError(t, err)
+	assert.Equal(t, 12, rule.ID)
+
+	_, err = Find(rules, "Frontend")
+	assert.EqualError(t, err, `no approval rule with the ID or name "Frontend"`)
+}
+
+func TestDescribe(t *testing.T) {
+	rule := FromProjectRule(&gitlab.ProjectApprovalRule{
+		ID:                12,
+		Name:              "Backend",
+		RuleType:          "regular",
+		ApprovalsRequired: 2,
+		Users:             []*gitlab.BasicUser{{Username: "alice"}, {Username: "bob"}},
+		Groups:            []*gitlab.Group{{FullPath: "org/backend"}},
+		ProtectedBranches: []*gitlab.ProtectedBranch{{Name: "main"}},
+	})
+	assert.Equal(t, "users: alice, bob; groups: org/backend; branches: main", Describe(rule))
+
+	assert.Equal(t, "any eligible user", Describe(&Rule{RuleType: "any_approver"}))
+	assert.Equal(t, "-", Describe(&Rule{RuleType: "regular"}))
+}
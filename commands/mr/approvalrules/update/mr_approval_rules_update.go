@@ -0,0 +1,154 @@
+package update
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/commands/mr/approvalrules/rulesutils"
+	"github.com/profclems/glab/commands/mr/mrutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type UpdateOpts struct {
+	Project     bool
+	RuleArg     string
+	Rule        rulesutils.RuleFlags
+	ApprovalSet bool
+
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+	HTTPClient func() (*gitlab.Client, error)
+	MR         func() (*gitlab.MergeRequest, glrepo.Interface, error)
+}
+
+func NewCmdUpdate(f *cmdutils.Factory, runE func(*UpdateOpts) error) *cobra.Command {
+	opts := &UpdateOpts{
+		IO: f.IO,
+	}
+
+	var updateCmd = &cobra.Command{
+		Use:   "update <rule> [<id> | <branch>] [flags]",
+		Short: `Update an approval rule of a merge request or project`,
+		Long: heredoc.Doc(`
+			Update an approval rule of a merge request, or of the project with --project.
+
+			The rule is given by its ID or name. Eligible users, groups and protected branches
+			replace the current ones of the rule when given.
+		`),
+		Example: heredoc.Doc(`
+			$ glab mr approval-rules update Security 235 --approvals 2
+			$ glab mr approval-rules update Backend --project --user alice,carol
+		`),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+			opts.HTTPClient = f.HttpClient
+			opts.RuleArg = args[0]
+			opts.MR = func() (*gitlab.MergeRequest, glrepo.Interface, error) {
+				return mrutils.MRFromArgs(f, args[1:], "opened")
+			}
+			opts.ApprovalSet = cmd.Flags().Changed("approvals")
+
+			if opts.Project && len(args) > 1 {
+				return &cmdutils.FlagError{Err: errors.New("a merge request cannot be given with --project")}
+			}
+			if opts.Rule.Name == "" && !opts.ApprovalSet && len(opts.Rule.Users) == 0 &&
+				len(opts.Rule.Groups) == 0 && len(opts.Rule.Branches) == 0 {
+				return &cmdutils.FlagError{Err: errors.New("nothing to update. Use --name, --approvals, --user, --group or --branch")}
+			}
+			if err := opts.Rule.Validate(opts.Project); err != nil {
+				return err
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return updateRun(opts)
+		},
+	}
+
+	opts.Rule.AddFlags(updateCmd.Flags())
+	updateCmd.Flags().BoolVarP(&opts.Project, "project", "p", false, "Update a rule of the project")
+
+	return updateCmd
+}
+
+func updateRun(opts *UpdateOpts) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	var mr *gitlab.MergeRequest
+	var repo glrepo.Interface
+	var rules []*rulesutils.Rule
+	if opts.Project {
+		repo, err = opts.BaseRepo()
+		if err != nil {
+			return err
+		}
+		rules, err = rulesutils.ListProjectRules(apiClient, repo)
+	} else {
+		mr, repo, err = opts.MR()
+		if err != nil {
+			return err
+		}
+		rules, err = rulesutils.ListMRRules(apiClient, repo, mr.IID)
+	}
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to list the approval rules")
+	}
+	rule, err := rulesutils.Find(rules, opts.RuleArg)
+	if err != nil {
+		return err
+	}
+
+	userIDs, groupIDs, branchIDs, err := opts.Rule.Members(apiClient, repo)
+	if err != nil {
+		return err
+	}
+	var name *string
+	if opts.Rule.Name != "" {
+		name = gitlab.String(opts.Rule.Name)
+	}
+	var approvals *int
+	if opts.ApprovalSet {
+		approvals = gitlab.Int(opts.Rule.Approvals)
+	}
+
+	c := opts.IO.Color()
+	if opts.Project {
+		updated, err := api.UpdateProjectApprovalRule(apiClient, repo.FullName(), rule.ID, &gitlab.UpdateProjectLevelRuleOptions{
+			Name:               name,
+			ApprovalsRequired:  approvals,
+			UserIDs:            userIDs,
+			GroupIDs:           groupIDs,
+			ProtectedBranchIDs: branchIDs,
+		})
+		if err != nil {
+			return cmdutils.WrapError(err, fmt.Sprintf("failed to update approval rule %q", rule.Name))
+		}
+		fmt.Fprintf(opts.IO.StdOut, "%s Updated approval rule %q of %s\n", c.GreenCheck(), updated.Name, repo.FullName())
+		return nil
+	}
+
+	updated, err := api.UpdateMRApprovalRule(apiClient, repo.FullName(), mr.IID, rule.ID, &gitlab.UpdateMergeRequestApprovalRuleOptions{
+		Name:              name,
+		ApprovalsRequired: approvals,
+		UserIDs:           userIDs,
+		GroupIDs:          groupIDs,
+	})
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to update approval rule %q", rule.Name))
+	}
+	fmt.Fprintf(opts.IO.StdOut, "%s Updated approval rule %q of !%d\n", c.GreenCheck(), updated.Name, mr.IID)
+	return nil
+}
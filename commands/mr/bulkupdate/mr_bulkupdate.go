@@ -0,0 +1,176 @@
+package bulkupdate
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/commands/mr/mrutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/profclems/glab/pkg/utils"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type BulkUpdateOptions struct {
+	IIDs      []int
+	FromStdin bool
+
+	Filter mrutils.MRFilter
+
+	Changes cmdutils.BulkChanges
+	DryRun  bool
+	Yes     bool
+
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+	HTTPClient func() (*gitlab.Client, error)
+}
+
+func NewCmdBulkUpdate(f *cmdutils.Factory, runE func(opts *BulkUpdateOptions) error) *cobra.Command {
+	opts := &BulkUpdateOptions{
+		IO: f.IO,
+	}
+
+	var mrBulkUpdateCmd = &cobra.Command{
+		Use:   "bulk-update [<id>... | -] [flags]",
+		Short: `Update several merge requests at once`,
+		Long: heredoc.Doc(`
+			Update several merge requests at once.
+
+			The merge requests are given as arguments, read from standard input when "-" is passed,
+			or selected with the same filters as "glab mr list". When reading from standard
+			input, only the first field of each line is used, so the output of "glab mr list"
+			can be piped in.
+
+			The merge requests and the changes are shown before anything is updated.
+		`),
+		Example: heredoc.Doc(`
+			$ glab mr bulk-update --target-branch release-1.0 --add-label backport
+			$ glab mr bulk-update --draft --author @me --set-milestone 1.1 --dry-run
+			$ glab mr bulk-update 12 15 18 --close --yes
+			$ glab mr list --label stale | glab mr bulk-update - --close --yes
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+			opts.HTTPClient = f.HttpClient
+
+			for _, arg := range args {
+				if arg == "-" {
+					opts.FromStdin = true
+					continue
+				}
+				iid := utils.StringToInt(arg)
+				if iid <= 0 {
+					return &cmdutils.FlagError{Err: fmt.Errorf("invalid merge request ID %q", arg)}
+				}
+				opts.IIDs = append(opts.IIDs, iid)
+			}
+
+			if err := opts.Changes.Validate(); err != nil {
+				return &cmdutils.FlagError{Err: err}
+			}
+
+			hasFilter := opts.Filter.IsSet()
+			if (len(opts.IIDs) > 0 || opts.FromStdin) && (hasFilter || opts.Filter.Closed || opts.Filter.Merged || opts.Filter.All) {
+				return &cmdutils.FlagError{Err: errors.New("merge request IDs and filters can't be used together")}
+			}
+			if len(opts.IIDs) == 0 && !opts.FromStdin && !hasFilter {
+				return &cmdutils.FlagError{Err: errors.New("specify the merge requests to update with IDs, \"-\" or at least one filter")}
+			}
+			if err := opts.Filter.Validate(); err != nil {
+				return &cmdutils.FlagError{Err: err}
+			}
+			if !opts.Yes && !opts.DryRun && !opts.IO.PromptEnabled() {
+				return &cmdutils.FlagError{Err: errors.New("--yes or --dry-run required when not running interactively")}
+			}
+			if opts.FromStdin && !opts.Yes && !opts.DryRun {
+				return &cmdutils.FlagError{Err: errors.New("--yes or --dry-run required when reading merge request IDs from standard input")}
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return bulkUpdateRun(opts)
+		},
+	}
+
+	fl := mrBulkUpdateCmd.Flags()
+	opts.Filter.AddFlags(fl)
+	opts.Changes.AddFlags(fl)
+	fl.BoolVar(&opts.DryRun, "dry-run", false, "Show the merge requests and changes without updating anything")
+	fl.BoolVarP(&opts.Yes, "yes", "y", false, "Update the merge requests without asking for confirmation")
+
+	return mrBulkUpdateCmd
+}
+
+func bulkUpdateRun(opts *BulkUpdateOptions) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	mergeRequests, err := selectMRs(apiClient, repo, opts)
+	if err != nil {
+		return err
+	}
+
+	if err := opts.Changes.Resolve(apiClient, repo); err != nil {
+		return err
+	}
+
+	byIID := make(map[int]*gitlab.MergeRequest, len(mergeRequests))
+	items := make([]cmdutils.BulkItem, 0, len(mergeRequests))
+	for _, mr := range mergeRequests {
+		byIID[mr.IID] = mr
+		items = append(items, cmdutils.BulkItem{
+			IID:   mr.IID,
+			Title: mr.Title,
+			Ref:   fmt.Sprintf("!%d", mr.IID),
+		})
+	}
+
+	return cmdutils.RunBulkUpdate(opts.IO, "merge request", items, opts.Changes.Describe(), opts.DryRun, opts.Yes, func(item cmdutils.BulkItem) error {
+		l, err := opts.Changes.MergeRequestOptions(apiClient, byIID[item.IID])
+		if err != nil {
+			return err
+		}
+		_, err = api.UpdateMR(apiClient, repo.FullName(), item.IID, l)
+		return err
+	})
+}
+
+func selectMRs(apiClient *gitlab.Client, repo glrepo.Interface, opts *BulkUpdateOptions) ([]*gitlab.MergeRequest, error) {
+	iids := opts.IIDs
+	if opts.FromStdin {
+		read, err := cmdutils.ReadIIDs(opts.IO.In)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read merge request IDs: %w", err)
+		}
+		iids = append(iids, read...)
+	}
+
+	if len(iids) > 0 {
+		var mergeRequests []*gitlab.MergeRequest
+		for _, iid := range iids {
+			mr, err := api.GetMR(apiClient, repo.FullName(), iid, &gitlab.GetMergeRequestsOptions{})
+			if err != nil {
+				return nil, fmt.Errorf("failed to get merge request !%d: %w", iid, err)
+			}
+			mergeRequests = append(mergeRequests, mr)
+		}
+		return mergeRequests, nil
+	}
+
+	return mrutils.ListFilteredMRs(apiClient, repo.FullName(), &opts.Filter)
+}
@@ -0,0 +1,117 @@
+package bulkupdate
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/httpmock"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xanzy/go-gitlab"
+)
+
+func newOpts(reg *httpmock.Mocker, stdin string) (*BulkUpdateOptions, *bytes.Buffer, *bytes.Buffer) {
+	io, in, stdout, stderr := iostreams.Test()
+	in.WriteString(stdin)
+
+	opts := &BulkUpdateOptions{
+		HTTPClient: func() (*gitlab.Client, error) {
+			a, _ := api.TestClient(&http.Client{Transport: reg}, "", "gitlab.com", false)
+			return a.Lab(), nil
+		},
+		BaseRepo: func() (glrepo.Interface, error) {
+			return glrepo.FromFullName("owner/repo")
+		},
+		IO: io,
+	}
+	_, _ = opts.HTTPClient()
+	return opts, stdout, stderr
+}
+
+func Test_bulkUpdateRun_dryRun(t *testing.T) {
+	reg := &httpmock.Mocker{}
+	defer reg.Verify(t)
+
+	var query url.Values
+	reg.RegisterResponder("GET", "/api/v4/projects/owner/repo/merge_requests",
+		func(req *http.Request) (*http.Response, error) {
+			query = req.URL.Query()
+			return httpmock.NewStringResponse(200, `[
+				{"id": 1, "iid": 12, "title": "First"},
+				{"id": 2, "iid": 15, "title": "Second"}
+			]`)(req)
+		})
+
+	opts, stdout, _ := newOpts(reg, "")
+	opts.Filter.TargetBranch = "release-1.0"
+	opts.Filter.Draft = true
+	opts.Filter.Merged = true
+	opts.Changes = cmdutils.BulkChanges{
+		AddLabels: []string{"backport"},
+	}
+	require.NoError(t, opts.Changes.Validate())
+	opts.DryRun = true
+
+	err := bulkUpdateRun(opts)
+	require.NoError(t, err)
+
+	assert.Equal(t, "release-1.0", query.Get("target_branch"))
+	assert.Equal(t, "yes", query.Get("wip"))
+	assert.Equal(t, "merged", query.Get("state"))
+	assert.Equal(t, heredoc.Doc(`
+		2 merge requests will be updated:
+		  !12 First
+		  !15 Second
+
+		Changes:
+		  - add labels backport
+
+		Dry run: nothing was updated
+	`), stdout.String())
+}
+
+func Test_bulkUpdateRun_fromStdin(t *testing.T) {
+	reg := &httpmock.Mocker{}
+	defer reg.Verify(t)
+
+	reg.RegisterResponder("GET", "/api/v4/projects/owner/repo/merge_requests/12",
+		httpmock.NewStringResponse(200, `{"id": 1, "iid": 12, "title": "First"}`))
+	reg.RegisterResponder("GET", "/api/v4/projects/owner/repo/merge_requests/15",
+		httpmock.NewStringResponse(200, `{"id": 2, "iid": 15, "title": "Second"}`))
+
+	var bodies []string
+	update := func(req *http.Request) (*http.Response, error) {
+		b, err := ioutil.ReadAll(req.Body)
+		require.NoError(t, err)
+		bodies = append(bodies, string(b))
+		return httpmock.NewStringResponse(200, `{"id": 1}`)(req)
+	}
+	reg.RegisterResponder("PUT", "/api/v4/projects/owner/repo/merge_requests/12", update)
+	reg.RegisterResponder("PUT", "/api/v4/projects/owner/repo/merge_requests/15", update)
+
+	opts, stdout, _ := newOpts(reg, "!12\tFirst\t(main) ← (first)\n!15\tSecond\t(main) ← (second)\n")
+	opts.FromStdin = true
+	opts.Changes = cmdutils.BulkChanges{
+		RemoveLabels: []string{"stale"},
+		Close:        true,
+	}
+	require.NoError(t, opts.Changes.Validate())
+	opts.Yes = true
+
+	err := bulkUpdateRun(opts)
+	require.NoError(t, err)
+
+	require.Len(t, bodies, 2)
+	for _, body := range bodies {
+		assert.JSONEq(t, `{"remove_labels": "stale", "state_event": "close"}`, body)
+	}
+	assert.Contains(t, stdout.String(), "✓ Updated !12\n✓ Updated !15\n")
+}
@@ -0,0 +1,113 @@
+package check
+
+import (
+	"fmt"
+
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/commands/mr/mrutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/profclems/glab/pkg/utils"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type CheckOpts struct {
+	IO         *iostreams.IOStreams
+	HTTPClient func() (*gitlab.Client, error)
+	MR         func() (*gitlab.MergeRequest, glrepo.Interface, error)
+}
+
+func NewCmdCheck(f *cmdutils.Factory, runE func(*CheckOpts) error) *cobra.Command {
+	opts := &CheckOpts{
+		IO: f.IO,
+	}
+
+	var mrCheckCmd = &cobra.Command{
+		Use:   "check [<id> | <branch>]",
+		Short: `Check if a merge request is ready to be merged`,
+		Long: heredoc.Doc(`
+			Check if a merge request is ready to be merged.
+
+			Prints a checklist of everything which can block merging: the draft status, the
+			pipeline, merge conflicts, unresolved threads, approvals and the merge status reported
+			by GitLab. Exits with a non-zero status if any of the checks fails.
+		`),
+		Example: heredoc.Doc(`
+			$ glab mr check 123
+			$ glab mr check    # Checks the open merge request of the current branch
+			$ glab mr check 123 && glab mr merge 123
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.HTTPClient = f.HttpClient
+			opts.MR = func() (*gitlab.MergeRequest, glrepo.Interface, error) {
+				return mrutils.MRFromArgs(f, args, "opened")
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return checkRun(opts)
+		},
+	}
+
+	return mrCheckCmd
+}
+
+func checkRun(opts *CheckOpts) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+	mr, repo, err := opts.MR()
+	if err != nil {
+		return err
+	}
+
+	if err = mrutils.MRCheckErrors(mr, mrutils.MRCheckErrOptions{
+		Merged: true,
+		Closed: true,
+	}); err != nil {
+		return err
+	}
+
+	approvals, err := api.GetMRApprovals(apiClient, repo.FullName(), mr.IID)
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to get the approvals of the merge request")
+	}
+
+	checks := mrutils.MRChecklist(mr, mrutils.MRCheckErrOptions{
+		WorkInProgress: true,
+		Pipeline:       true,
+		Conflict:       true,
+		Discussions:    true,
+		Approvals:      approvals,
+		MergeStatus:    true,
+	})
+
+	c := opts.IO.Color()
+	fmt.Fprintf(opts.IO.StdOut, "%s %s\n\n", mrutils.MRState(c, mr), mr.Title)
+
+	failed := 0
+	for _, check := range checks {
+		if check.Err == nil {
+			fmt.Fprintf(opts.IO.StdOut, "%s %s\n", c.GreenCheck(), check.Name)
+			continue
+		}
+		failed++
+		fmt.Fprintf(opts.IO.StdOut, "%s %s: %s\n", c.FailedIcon(), check.Name, check.Err)
+	}
+
+	if failed == 0 {
+		fmt.Fprintf(opts.IO.StdOut, "\n%s !%d is ready to be merged\n", c.GreenCheck(), mr.IID)
+		return nil
+	}
+
+	fmt.Fprintf(opts.IO.StdOut, "\n%s Merging !%d is blocked by %s\n", c.WarnIcon(), mr.IID, utils.Pluralize(failed, "check"))
+	return cmdutils.SilentError
+}
@@ -0,0 +1,103 @@
+package check
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/httpmock"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xanzy/go-gitlab"
+)
+
+func testOpts(reg *httpmock.Mocker, mr *gitlab.MergeRequest) (*CheckOpts, *bytes.Buffer) {
+	io, _, stdout, _ := iostreams.Test()
+	opts := &CheckOpts{
+		IO: io,
+		HTTPClient: func() (*gitlab.Client, error) {
+			a, _ := api.TestClient(&http.Client{Transport: reg}, "", "gitlab.com", false)
+			return a.Lab(), nil
+		},
+		MR: func() (*gitlab.MergeRequest, glrepo.Interface, error) {
+			return mr, glrepo.New("OWNER", "REPO"), nil
+		},
+	}
+	_, _ = opts.HTTPClient()
+	return opts, stdout
+}
+
+func Test_checkRun(t *testing.T) {
+	t.Run("ready", func(t *testing.T) {
+		reg := &httpmock.Mocker{}
+		defer reg.Verify(t)
+		reg.RegisterResponder("GET", "/api/v4/projects/OWNER/REPO/merge_requests/12/approvals",
+			httpmock.NewStringResponse(200, `{"approved": true, "approvals_left": 0}`))
+
+		opts, stdout := testOpts(reg, &gitlab.MergeRequest{
+			IID:                         12,
+			Title:                       "Fix the login page",
+			State:                       "opened",
+			MergeStatus:                 "can_be_merged",
+			BlockingDiscussionsResolved: true,
+			HeadPipeline:                &gitlab.Pipeline{Status: "success"},
+		})
+
+		require.NoError(t, checkRun(opts))
+		assert.Equal(t, `!12 Fix the login page
+
+✓ Ready for review
+✓ Pipeline succeeded
+✓ No merge conflicts
+✓ Threads resolved
+✓ Approved
+✓ Mergeable
+
+✓ !12 is ready to be merged
+`, stdout.String())
+	})
+
+	t.Run("blocked", func(t *testing.T) {
+		reg := &httpmock.Mocker{}
+		defer reg.Verify(t)
+		reg.RegisterResponder("GET", "/api/v4/projects/OWNER/REPO/merge_requests/12/approvals",
+			httpmock.NewStringResponse(200, `{"approved": false, "approvals_left": 1}`))
+
+		opts, stdout := testOpts(reg, &gitlab.MergeRequest{
+			IID:                         12,
+			Title:                       "Fix the login page",
+			State:                       "opened",
+			WorkInProgress:              true,
+			MergeStatus:                 "can_be_merged",
+			BlockingDiscussionsResolved: false,
+			HeadPipeline:                &gitlab.Pipeline{Status: "failed"},
+		})
+
+		err := checkRun(opts)
+		assert.Equal(t, cmdutils.SilentError, err)
+		assert.Equal(t, "!12 Fix the login page\n\n"+
+			"x Ready for review: this merge request is still a work in progress. Run `glab mr update 12 --ready` to mark it as ready for review\n"+
+			"x Pipeline succeeded: pipeline for this merge request did not succeed (failed)\n"+
+			"✓ No merge conflicts\n"+
+			"x Threads resolved: there are unresolved threads. Resolve them before merging\n"+
+			"x Approved: this merge request needs 1 more approval\n"+
+			"✓ Mergeable\n\n"+
+			"! Merging !12 is blocked by 4 checks\n", stdout.String())
+	})
+
+	t.Run("merged", func(t *testing.T) {
+		reg := &httpmock.Mocker{}
+		defer reg.Verify(t)
+
+		opts, _ := testOpts(reg, &gitlab.MergeRequest{
+			IID:   12,
+			State: "merged",
+		})
+
+		assert.EqualError(t, checkRun(opts), "this merge request has already been merged")
+	})
+}
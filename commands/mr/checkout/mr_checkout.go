@@ -3,22 +3,28 @@ package checkout
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/MakeNowJust/heredoc"
-	"github.com/profclems/glab/api"
 	"github.com/profclems/glab/commands/cmdutils"
 	"github.com/profclems/glab/commands/mr/mrutils"
 	"github.com/profclems/glab/pkg/git"
+	"github.com/profclems/glab/pkg/iostreams"
 	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
 )
 
 type mrCheckoutConfig struct {
 	branch   string
 	track    bool
 	upstream string
+	worktree string
 }
 
+// defaultWorktree is the value of --worktree when no path is given
+const defaultWorktree = "auto"
+
 var (
 	mrCheckoutCfg mrCheckoutConfig
 )
@@ -34,12 +40,18 @@ func NewCmdCheckout(f *cmdutils.Factory) *cobra.Command {
 			$ glab mr checkout 12 --branch todo-fix
 			$ glab mr checkout new-feature --set-upstream-to=upstream/trunk
 			$ glab mr checkout   # use checked out branch
+			$ glab mr checkout 12 --worktree
+			$ glab mr checkout 12 --worktree=../review-12
 		`),
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			var err error
 			var upstream string
 
+			if cmd.Flags().Changed("worktree") && (mrCheckoutCfg.track || mrCheckoutCfg.upstream != "") {
+				return &cmdutils.FlagError{Err: errors.New("--worktree cannot be used with --track or --set-upstream-to")}
+			}
+
 			if mrCheckoutCfg.upstream != "" {
 				// Make sure we don't have the mutually exclusive flags --track and --set-upstream-to
 				if mrCheckoutCfg.track {
@@ -77,16 +89,11 @@ func NewCmdCheckout(f *cmdutils.Factory) *cobra.Command {
 			fetchToRef := mrCheckoutCfg.branch
 
 			if mrCheckoutCfg.track {
-				if _, err := git.Config("remote." + mr.Author.Username + ".url"); err != nil {
-					mrProject, err := api.GetProject(apiClient, mr.SourceProjectID)
-					if err != nil {
-						return err
-					}
-					if _, err := git.AddRemote(mr.Author.Username, mrProject.SSHURLToRepo); err != nil {
-						return err
-					}
+				authorRemote, err := mrutils.AuthorRemote(apiClient, mr)
+				if err != nil {
+					return err
 				}
-				fetchToRef = fmt.Sprintf("refs/remotes/%s/%s", mr.Author.Username, mr.SourceBranch)
+				fetchToRef = fmt.Sprintf("refs/remotes/%s/%s", authorRemote, mr.SourceBranch)
 			}
 			remotes, err := f.Remotes()
 			if err != nil {
@@ -98,6 +105,10 @@ func NewCmdCheckout(f *cmdutils.Factory) *cobra.Command {
 				fmt.Println(err)
 			}
 
+			if cmd.Flags().Changed("worktree") {
+				return checkoutWorktree(f.IO, mr, repoRemote.Name, mrCheckoutCfg.branch, mrCheckoutCfg.worktree)
+			}
+
 			mrRef := fmt.Sprintf("refs/merge-requests/%d/head", mr.IID)
 			fetchRefSpec := fmt.Sprintf("%s:%s", mrRef, fetchToRef)
 			if err := git.RunCmd([]string{"fetch", repoRemote.Name, fetchRefSpec}); err != nil {
@@ -127,5 +138,57 @@ func NewCmdCheckout(f *cmdutils.Factory) *cobra.Command {
 	mrCheckoutCmd.Flags().StringVarP(&mrCheckoutCfg.branch, "branch", "b", "", "checkout merge request with <branch> name")
 	mrCheckoutCmd.Flags().BoolVarP(&mrCheckoutCfg.track, "track", "t", false, "set checked out branch to track remote branch, adds remote if needed")
 	mrCheckoutCmd.Flags().StringVarP(&mrCheckoutCfg.upstream, "set-upstream-to", "u", "", "set tracking of checked out branch to [REMOTE/]BRANCH")
+	mrCheckoutCmd.Flags().StringVarP(&mrCheckoutCfg.worktree, "worktree", "w", "", "check out in a new git worktree at <path> instead of switching branches (default: ../<repo>-mr-<id>)")
+	mrCheckoutCmd.Flags().Lookup("worktree").NoOptDefVal = defaultWorktree
 	return mrCheckoutCmd
 }
+
+// checkoutWorktree checks out the merge request in a new worktree so that the work in
+// progress in the current one is left alone. The local branch is created or fast-forwarded
+// to the merge request head, and a warning is shown if it has diverged from it.
+func checkoutWorktree(io *iostreams.IOStreams, mr *gitlab.MergeRequest, remote, branch, path string) error {
+	worktrees, err := git.ListWorktrees()
+	if err != nil {
+		return err
+	}
+	for _, wt := range worktrees {
+		if wt.Branch == branch {
+			return fmt.Errorf("%s is already checked out in %s", branch, wt.Path)
+		}
+	}
+	if path == defaultWorktree {
+		path = mrutils.DefaultWorktreePath(worktrees[0].Path, mr.IID)
+	}
+
+	mrRef := fmt.Sprintf("refs/merge-requests/%d/head", mr.IID)
+	if err := git.Fetch(remote, []string{mrRef}, io.StdOut, io.StdErr); err != nil {
+		return err
+	}
+
+	c := io.Color()
+	sync, err := mrutils.UpdateLocalBranch(mr, branch, "", io.StdErr, io.StdErr)
+	if err != nil {
+		return err
+	}
+	switch sync {
+	case mrutils.BranchBehind:
+		fmt.Fprintf(io.StdErr, "Updated the local branch %s to the head of !%d\n", branch, mr.IID)
+	case mrutils.BranchAhead, mrutils.BranchDiverged:
+		relation := "has diverged from"
+		if sync == mrutils.BranchAhead {
+			relation = "is ahead of"
+		}
+		fmt.Fprintf(io.StdErr, "%s The local branch %s %s the head of !%d (%s). Use --branch to check out the merge request as another branch\n",
+			c.WarnIcon(), branch, relation, mr.IID, mrutils.ShortSHA(mr.SHA))
+	}
+
+	if err := git.AddWorktree(path, branch, "", io.StdOut, io.StdErr); err != nil {
+		return err
+	}
+	if err := git.SetBranchConfig(branch, mrutils.WorktreeConfigKey, strconv.Itoa(mr.IID)); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(io.StdOut, "%s Checked out !%d in %s\n", c.GreenCheck(), mr.IID, path)
+	return nil
+}
@@ -0,0 +1,128 @@
+package cherrypick
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/commands/mr/mrutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type CherryPickOpts struct {
+	Target       string
+	EachCommit   bool
+	CreateMR     bool
+	SourceBranch string
+	Yes          bool
+
+	IO         *iostreams.IOStreams
+	HTTPClient func() (*gitlab.Client, error)
+	MR         func() (*gitlab.MergeRequest, glrepo.Interface, error)
+}
+
+func NewCmdCherryPick(f *cmdutils.Factory, runE func(*CherryPickOpts) error) *cobra.Command {
+	opts := &CherryPickOpts{
+		IO: f.IO,
+	}
+
+	var mrCherryPickCmd = &cobra.Command{
+		Use:   "cherry-pick [<id> | <branch>] --to <branch>",
+		Short: `Cherry-pick the changes of a merge request into another branch`,
+		Long: heredoc.Doc(`
+			Cherry-pick the changes of a merge request into another branch on GitLab.
+
+			The merge commit, or the squash commit, of a merged merge request is cherry-picked.
+			Use --each-commit to cherry-pick the commits of the merge request one by one instead,
+			which also works for merge requests which have not been merged.
+
+			With --create-mr, the commits are cherry-picked into a new branch created from the
+			target branch and a merge request is opened against the target branch. Otherwise,
+			you are asked to confirm before the commits are cherry-picked directly into the
+			target branch.
+		`),
+		Example: heredoc.Doc(`
+			$ glab mr cherry-pick 123 --to release-1.2
+			$ glab mr cherry-pick 123 --to release-1.2 --create-mr
+			$ glab mr cherry-pick 123 --to release-1.2 --each-commit --yes
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.SourceBranch != "" && !opts.CreateMR {
+				return &cmdutils.FlagError{Err: errors.New("--source-branch can only be used with --create-mr")}
+			}
+			if !opts.CreateMR && !opts.Yes && !opts.IO.PromptEnabled() {
+				return &cmdutils.FlagError{Err: errors.New("--yes or -y flag is required when not running interactively")}
+			}
+
+			opts.HTTPClient = f.HttpClient
+			opts.MR = func() (*gitlab.MergeRequest, glrepo.Interface, error) {
+				return mrutils.MRFromArgs(f, args, "any")
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return cherryPickRun(opts)
+		},
+	}
+
+	mrCherryPickCmd.Flags().StringVarP(&opts.Target, "to", "t", "", "The branch to cherry-pick the changes into")
+	mrCherryPickCmd.Flags().BoolVarP(&opts.EachCommit, "each-commit", "e", false, "Cherry-pick each commit of the merge request instead of its merge commit")
+	mrCherryPickCmd.Flags().BoolVarP(&opts.CreateMR, "create-mr", "m", false, "Cherry-pick into a new branch and open a merge request against the target branch")
+	mrCherryPickCmd.Flags().StringVarP(&opts.SourceBranch, "source-branch", "s", "", "Name of the branch to create for --create-mr (default: cherry-pick-<commit>)")
+	mrCherryPickCmd.Flags().BoolVarP(&opts.Yes, "yes", "y", false, "Skip the confirmation prompt when cherry-picking directly into the branch")
+	_ = mrCherryPickCmd.MarkFlagRequired("to")
+
+	return mrCherryPickCmd
+}
+
+func cherryPickRun(opts *CherryPickOpts) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+	mr, repo, err := opts.MR()
+	if err != nil {
+		return err
+	}
+
+	shas, err := mrutils.BackportCommits(apiClient, repo, mr, opts.EachCommit)
+	if err != nil {
+		return err
+	}
+
+	sourceBranch := opts.SourceBranch
+	if sourceBranch == "" {
+		sourceBranch = "cherry-pick-" + mrutils.ShortSHA(shas[len(shas)-1])
+	}
+
+	backport := &mrutils.Backport{
+		Client:       apiClient,
+		Repo:         repo,
+		MR:           mr,
+		SHAs:         shas,
+		Target:       opts.Target,
+		CreateMR:     opts.CreateMR,
+		SourceBranch: sourceBranch,
+		Yes:          opts.Yes,
+		Verb:         "cherry-pick",
+		Past:         "cherry-picked",
+		Preposition:  "into",
+		Title:        fmt.Sprintf("%s (cherry-pick of !%d into %s)", mr.Title, mr.IID, opts.Target),
+		Reference:    "cherry picked from %s",
+		Apply: func(sha, branch string) (*gitlab.Commit, error) {
+			return api.CherryPickCommit(apiClient, repo.FullName(), sha, &gitlab.CherryPickCommitOptions{
+				Branch: gitlab.String(branch),
+			})
+		},
+	}
+	return backport.Run(opts.IO)
+}
@@ -0,0 +1,165 @@
+package cherrypick
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/httpmock"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xanzy/go-gitlab"
+)
+
+func Test_cherryPickRun_createMR(t *testing.T) {
+	reg := &httpmock.Mocker{}
+	defer reg.Verify(t)
+
+	var branchBody, pickBody string
+	var mrBody map[string]interface{}
+	reg.RegisterResponder("POST", "/api/v4/projects/OWNER/REPO/repository/branches",
+		func(req *http.Request) (*http.Response, error) {
+			b, _ := ioutil.ReadAll(req.Body)
+			branchBody = string(b)
+			return httpmock.NewStringResponse(201, `{"name": "cherry-pick-0123abcd"}`)(req)
+		})
+	reg.RegisterResponder("POST", "/api/v4/projects/OWNER/REPO/repository/commits/0123abcdef/cherry_pick",
+		func(req *http.Request) (*http.Response, error) {
+			b, _ := ioutil.ReadAll(req.Body)
+			pickBody = string(b)
+			return httpmock.NewStringResponse(201, `{"id": "fedcba9876", "short_id": "fedcba98", "title": "Fix the login page"}`)(req)
+		})
+	reg.RegisterResponder("POST", "/api/v4/projects/OWNER/REPO/merge_requests",
+		func(req *http.Request) (*http.Response, error) {
+			b, _ := ioutil.ReadAll(req.Body)
+			require.NoError(t, json.Unmarshal(b, &mrBody))
+			return httpmock.NewStringResponse(201, `{"id": 2, "iid": 12, "state": "opened", "web_url": "https://gitlab.com/OWNER/REPO/-/merge_requests/12"}`)(req)
+		})
+
+	io, _, stdout, _ := iostreams.Test()
+	opts := &CherryPickOpts{
+		Target:   "release-1.2",
+		CreateMR: true,
+		IO:       io,
+		HTTPClient: func() (*gitlab.Client, error) {
+			a, _ := api.TestClient(&http.Client{Transport: reg}, "", "gitlab.com", false)
+			return a.Lab(), nil
+		},
+		MR: func() (*gitlab.MergeRequest, glrepo.Interface, error) {
+			return &gitlab.MergeRequest{
+				IID:            10,
+				Title:          "Fix the login page",
+				State:          "merged",
+				TargetBranch:   "main",
+				MergeCommitSHA: "0123abcdef",
+			}, glrepo.New("OWNER", "REPO"), nil
+		},
+	}
+
+	_, _ = opts.HTTPClient()
+
+	require.NoError(t, cherryPickRun(opts))
+
+	assert.JSONEq(t, `{"branch": "cherry-pick-0123abcd", "ref": "release-1.2"}`, branchBody)
+	assert.JSONEq(t, `{"branch": "cherry-pick-0123abcd"}`, pickBody)
+	assert.Equal(t, "Fix the login page (cherry-pick of !10 into release-1.2)", mrBody["title"])
+	assert.Equal(t, "Cherry-picks !10 into `release-1.2`.\n\n- Fix the login page (cherry picked from 0123abcdef)\n", mrBody["description"])
+	assert.Equal(t, "cherry-pick-0123abcd", mrBody["source_branch"])
+	assert.Equal(t, "release-1.2", mrBody["target_branch"])
+	assert.Equal(t, "✓ Cherry-picked 0123abcd into cherry-pick-0123abcd as fedcba98\nhttps://gitlab.com/OWNER/REPO/-/merge_requests/12\n", stdout.String())
+}
+
+func Test_cherryPickRun_notMerged(t *testing.T) {
+	io, _, _, _ := iostreams.Test()
+	opts := &CherryPickOpts{
+		Target: "release-1.2",
+		IO:     io,
+		HTTPClient: func() (*gitlab.Client, error) {
+			return nil, nil
+		},
+		MR: func() (*gitlab.MergeRequest, glrepo.Interface, error) {
+			return &gitlab.MergeRequest{IID: 10, State: "opened"}, glrepo.New("OWNER", "REPO"), nil
+		},
+	}
+
+	err := cherryPickRun(opts)
+	assert.EqualError(t, err, "!10 has not been merged. Use --each-commit to use its commits instead")
+}
+
+func Test_cherryPickRun_partialFailure(t *testing.T) {
+	reg := &httpmock.Mocker{}
+	defer reg.Verify(t)
+
+	reg.RegisterResponder("GET", "/api/v4/projects/OWNER/REPO/merge_requests/10/commits",
+		httpmock.NewStringResponse(200, `[{"id": "2222222222"}, {"id": "1111111111"}]`))
+	reg.RegisterResponder("POST", "/api/v4/projects/OWNER/REPO/repository/commits/1111111111/cherry_pick",
+		httpmock.NewStringResponse(201, `{"short_id": "aaaaaaaa"}`))
+	reg.RegisterResponder("POST", "/api/v4/projects/OWNER/REPO/repository/commits/2222222222/cherry_pick",
+		httpmock.NewStringResponse(400, `{"message": "Sorry, we cannot cherry-pick this commit automatically."}`))
+
+	io, _, stdout, stderr := iostreams.Test()
+	opts := &CherryPickOpts{
+		Target:     "release-1.2",
+		EachCommit: true,
+		Yes:        true,
+		IO:         io,
+		HTTPClient: func() (*gitlab.Client, error) {
+			a, _ := api.TestClient(&http.Client{Transport: reg}, "", "gitlab.com", false)
+			return a.Lab(), nil
+		},
+		MR: func() (*gitlab.MergeRequest, glrepo.Interface, error) {
+			return &gitlab.MergeRequest{IID: 10, State: "opened"}, glrepo.New("OWNER", "REPO"), nil
+		},
+	}
+
+	_, _ = opts.HTTPClient()
+
+	require.Error(t, cherryPickRun(opts))
+	assert.Equal(t, "✓ Cherry-picked 11111111 into release-1.2 as aaaaaaaa\n", stdout.String())
+	assert.Equal(t, "! release-1.2 was left partially cherry-picked. Already cherry-picked: 11111111 as aaaaaaaa\n", stderr.String())
+}
+
+func Test_NewCmdCherryPick_yesRequired(t *testing.T) {
+	io, _, _, _ := iostreams.Test()
+	f := &cmdutils.Factory{IO: io}
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "into the target branch",
+			args:    []string{"10", "--to", "release-1.2"},
+			wantErr: "--yes or -y flag is required when not running interactively",
+		},
+		{
+			name: "with --yes",
+			args: []string{"10", "--to", "release-1.2", "--yes"},
+		},
+		{
+			name: "with --create-mr",
+			args: []string{"10", "--to", "release-1.2", "--create-mr"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := NewCmdCherryPick(f, func(*CherryPickOpts) error { return nil })
+			cmd.SetArgs(tt.args)
+			cmd.SetOut(ioutil.Discard)
+			cmd.SetErr(ioutil.Discard)
+
+			_, err := cmd.ExecuteC()
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,276 @@
+package create
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/internal/config"
+	"github.com/profclems/glab/pkg/git"
+	"github.com/profclems/glab/pkg/utils"
+)
+
+// descriptionTemplateKey is the config key holding the path of a custom Go template
+// for the generated merge request descriptions
+const descriptionTemplateKey = "mr_description_template"
+
+// defaultDescriptionTemplate lists the commits grouped by type, followed by the
+// merge request template of the project and the issues the merge request closes
+const defaultDescriptionTemplate = `
+{{- range .Groups}}
+### {{.Heading}}
+
+{{range .Commits}}- {{if .Scope}}**{{.Scope}}:** {{end}}{{.Subject}}
+{{end}}
+{{end}}
+{{- if .Breaking}}
+### Breaking changes
+
+{{range .Breaking}}- {{.Subject}}
+{{end}}
+{{end}}
+{{- if .Template}}
+{{.Template}}
+
+{{end}}
+{{- range .Closes}}Closes #{{.}}
+{{end}}`
+
+// commitTypes are the conventional commit types in the order their groups are shown
+var commitTypes = []struct {
+	Type    string
+	Heading string
+}{
+	{"feat", "Features"},
+	{"fix", "Bug fixes"},
+	{"perf", "Performance improvements"},
+	{"refactor", "Refactoring"},
+	{"docs", "Documentation"},
+	{"test", "Tests"},
+	{"build", "Build system"},
+	{"ci", "Continuous integration"},
+	{"style", "Style"},
+	{"chore", "Chores"},
+	{"revert", "Reverts"},
+}
+
+const otherChangesHeading = "Other changes"
+
+var (
+	conventionalCommitRE = regexp.MustCompile(`^(\w+)(?:\(([^)]+)\))?(!)?:\s+(.+)$`)
+	breakingChangeRE     = regexp.MustCompile(`(?m)^BREAKING[ -]CHANGE:\s*(.+)$`)
+	closingReferenceRE   = regexp.MustCompile(`(?i)\b(?:close[sd]?|fix(?:e[sd])?|resolve[sd]?|implement(?:s|ed)?)(?:\s*:)?\s+#(\d+)\b`)
+	branchIssueRE        = regexp.MustCompile(`^(\d+)-\D|(?:^|/)issue[-_]?(\d+)(?:[-_/]|$)`)
+)
+
+// DescriptionCommit is a commit as seen by the description templates
+type DescriptionCommit struct {
+	SHA  string
+	Type string
+	// Scope is the optional scope of a conventional commit, as in "fix(api): ..."
+	Scope    string
+	Subject  string
+	Body     string
+	Breaking bool
+}
+
+// CommitGroup holds the commits of one conventional commit type
+type CommitGroup struct {
+	Type    string
+	Heading string
+	Commits []*DescriptionCommit
+}
+
+// DescriptionData is the data given to the description templates
+type DescriptionData struct {
+	SourceBranch string
+	TargetBranch string
+	// Commits are ordered from the oldest to the newest
+	Commits  []*DescriptionCommit
+	Groups   []*CommitGroup
+	Breaking []*DescriptionCommit
+	// Template is the merge request template of the project which was chosen, if any
+	Template string
+	// Closes are the issues referenced by the branch name and the commit messages
+	Closes []int
+}
+
+func parseCommit(sha, title, body string) *DescriptionCommit {
+	commit := &DescriptionCommit{SHA: sha, Subject: title, Body: body}
+	if m := conventionalCommitRE.FindStringSubmatch(title); m != nil {
+		commit.Type = strings.ToLower(m[1])
+		commit.Scope = m[2]
+		commit.Breaking = m[3] == "!"
+		commit.Subject = m[4]
+	}
+	if breakingChangeRE.MatchString(body) {
+		commit.Breaking = true
+	}
+	return commit
+}
+
+func groupCommits(commits []*DescriptionCommit) []*CommitGroup {
+	byType := map[string]*CommitGroup{}
+	other := &CommitGroup{Heading: otherChangesHeading}
+	for _, t := range commitTypes {
+		byType[t.Type] = &CommitGroup{Type: t.Type, Heading: t.Heading}
+	}
+	for _, commit := range commits {
+		group, ok := byType[commit.Type]
+		if !ok {
+			group = other
+		}
+		group.Commits = append(group.Commits, commit)
+	}
+
+	var groups []*CommitGroup
+	for _, t := range commitTypes {
+		if group := byType[t.Type]; len(group.Commits) > 0 {
+			groups = append(groups, group)
+		}
+	}
+	if len(other.Commits) > 0 {
+		groups = append(groups, other)
+	}
+	return groups
+}
+
+// issueReferences returns the issues to close: the issue number of a branch name like
+// "123-fix-login" or "feature/issue-123", and the issues referenced with a
+// closing keyword in the commit messages. Issues already closed by the text are left out.
+func issueReferences(branch string, commits []*DescriptionCommit, text string) []int {
+	existing := map[int]bool{}
+	for _, m := range closingReferenceRE.FindAllStringSubmatch(text, -1) {
+		n, _ := strconv.Atoi(m[1])
+		existing[n] = true
+	}
+
+	seen := map[int]bool{}
+	var issues []int
+	add := func(ref string) {
+		n, err := strconv.Atoi(ref)
+		if err != nil || n == 0 || seen[n] || existing[n] {
+			return
+		}
+		seen[n] = true
+		issues = append(issues, n)
+	}
+
+	if m := branchIssueRE.FindStringSubmatch(branch); m != nil {
+		add(m[1] + m[2])
+	}
+	for _, commit := range commits {
+		for _, m := range closingReferenceRE.FindAllStringSubmatch(commit.Subject+"\n"+commit.Body, -1) {
+			add(m[1])
+		}
+	}
+	sort.Ints(issues)
+	return issues
+}
+
+// renderDescription executes the description template with the data
+func renderDescription(tmplText string, data *DescriptionData) (string, error) {
+	tmpl, err := template.New("description").Parse(tmplText)
+	if err != nil {
+		return "", fmt.Errorf("invalid description template: %w", err)
+	}
+	var b bytes.Buffer
+	if err := tmpl.Execute(&b, data); err != nil {
+		return "", fmt.Errorf("could not render the description template: %w", err)
+	}
+	description := regexp.MustCompile(`\n{3,}`).ReplaceAllString(b.String(), "\n\n")
+	return strings.TrimSpace(description), nil
+}
+
+// descriptionTemplate returns the Go template set with the mr_description_template config,
+// or the default one. Relative paths are relative to the root of the repository.
+func descriptionTemplate(cfg config.Config) (string, error) {
+	path, _ := cfg.Get("", descriptionTemplateKey)
+	if path == "" {
+		return defaultDescriptionTemplate, nil
+	}
+	if !filepath.IsAbs(path) {
+		dir, err := git.ToplevelDir()
+		if err != nil {
+			return "", err
+		}
+		path = filepath.Join(dir, path)
+	}
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("could not read the description template set in %s: %w", descriptionTemplateKey, err)
+	}
+	return string(content), nil
+}
+
+// generateTitleAndDescription builds the title and description of the merge request from
+// the commits of the source branch, merged with the project template templateContents
+func generateTitleAndDescription(opts *CreateOpts, templateContents string) (string, string, error) {
+	gitCommits, err := git.Commits(opts.TargetTrackingBranch, opts.SourceBranch)
+	if err != nil {
+		return "", "", err
+	}
+
+	data := &DescriptionData{
+		SourceBranch: opts.SourceBranch,
+		TargetBranch: opts.TargetBranch,
+		Template:     templateContents,
+	}
+	// git.Commits lists the newest commit first
+	for i := len(gitCommits) - 1; i >= 0; i-- {
+		body, err := git.CommitBody(gitCommits[i].Sha)
+		if err != nil {
+			return "", "", err
+		}
+		data.Commits = append(data.Commits, parseCommit(gitCommits[i].Sha, gitCommits[i].Title, strings.TrimSpace(body)))
+	}
+	data.Groups = groupCommits(data.Commits)
+	for _, commit := range data.Commits {
+		if commit.Breaking {
+			data.Breaking = append(data.Breaking, commit)
+		}
+	}
+	data.Closes = issueReferences(opts.SourceBranch, data.Commits, templateContents)
+
+	title := utils.Humanize(opts.SourceBranch)
+	if len(gitCommits) == 1 {
+		title = gitCommits[0].Title
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return "", "", err
+	}
+	tmplText, err := descriptionTemplate(cfg)
+	if err != nil {
+		return "", "", err
+	}
+	description, err := renderDescription(tmplText, data)
+	if err != nil {
+		return "", "", err
+	}
+	return title, description, nil
+}
+
+// projectTemplate loads a merge request template of the project. Without a name, the
+// "Default" template is used if the project has one.
+func projectTemplate(name string) (string, error) {
+	if name == "" {
+		name = "Default"
+	}
+	content, err := cmdutils.LoadGitLabTemplate(cmdutils.MergeRequestTemplate, name)
+	if err != nil {
+		return "", fmt.Errorf("failed to get template contents: %w", err)
+	}
+	if content == "" && name != "Default" {
+		return "", fmt.Errorf("merge request template %q not found in .gitlab/%s", name, cmdutils.MergeRequestTemplate)
+	}
+	return content, nil
+}
@@ -0,0 +1,84 @@
+package create
+
+import (
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseCommit(t *testing.T) {
+	assert.Equal(t, &DescriptionCommit{SHA: "a1", Type: "feat", Scope: "api", Subject: "add pagination"},
+		parseCommit("a1", "feat(api): add pagination", ""))
+	assert.Equal(t, &DescriptionCommit{SHA: "a2", Type: "fix", Subject: "drop the v1 endpoint", Breaking: true},
+		parseCommit("a2", "fix!: drop the v1 endpoint", ""))
+	assert.Equal(t, &DescriptionCommit{SHA: "a3", Type: "refactor", Subject: "rename the client", Body: "BREAKING CHANGE: Client is now API", Breaking: true},
+		parseCommit("a3", "refactor: rename the client", "BREAKING CHANGE: Client is now API"))
+	assert.Equal(t, &DescriptionCommit{SHA: "a4", Subject: "Update README.md"},
+		parseCommit("a4", "Update README.md", ""))
+}
+
+func Test_issueReferences(t *testing.T) {
+	commits := []*DescriptionCommit{
+		{Subject: "fix login", Body: "Fixes #12 and relates to #13"},
+		{Subject: "add docs (closes #9)"},
+		{Subject: "resolve: #42"},
+	}
+
+	assert.Equal(t, []int{9, 12, 123}, issueReferences("123-fix-login", commits, "Resolves #42"))
+	assert.Equal(t, []int{7, 9, 12, 42}, issueReferences("feature/issue-7-login", commits, ""))
+	assert.Equal(t, []int{9, 12, 42}, issueReferences("v2-login", commits, ""))
+	assert.Equal(t, []int{9, 12, 42}, issueReferences("1-2-stable", commits, ""))
+	assert.Equal(t, []int{9, 12, 42}, issueReferences("hotfix/2021-10-x", commits, ""))
+}
+
+func Test_renderDescription(t *testing.T) {
+	commits := []*DescriptionCommit{
+		parseCommit("a1", "feat(api): add pagination", ""),
+		parseCommit("a2", "fix: handle empty pages", "Closes #4"),
+		parseCommit("a3", "feat!: remove the v1 endpoints", ""),
+		parseCommit("a4", "Update README.md", ""),
+	}
+	data := &DescriptionData{
+		Commits:  commits,
+		Groups:   groupCommits(commits),
+		Breaking: []*DescriptionCommit{commits[2]},
+		Template: "## Checklist\n\n- [ ] Tests added",
+		Closes:   []int{4, 15},
+	}
+
+	description, err := renderDescription(defaultDescriptionTemplate, data)
+	require.NoError(t, err)
+	assert.Equal(t, heredoc.Doc(`
+		### Features
+
+		- **api:** add pagination
+		- remove the v1 endpoints
+
+		### Bug fixes
+
+		- handle empty pages
+
+		### Other changes
+
+		- Update README.md
+
+		### Breaking changes
+
+		- remove the v1 endpoints
+
+		## Checklist
+
+		- [ ] Tests added
+
+		Closes #4
+		Closes #15`), description)
+
+	description, err = renderDescription("{{len .Commits}} commits into {{.TargetBranch}}", &DescriptionData{Commits: commits, TargetBranch: "main"})
+	require.NoError(t, err)
+	assert.Equal(t, "4 commits into main", description)
+
+	_, err = renderDescription("{{.Missing", data)
+	assert.EqualError(t, err, "invalid description template: template: description:1: unclosed action")
+}
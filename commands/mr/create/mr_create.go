@@ -21,6 +21,7 @@ import (
 	"github.com/profclems/glab/api"
 	"github.com/profclems/glab/commands/cmdutils"
 	"github.com/profclems/glab/commands/mr/mrutils"
+	"github.com/profclems/glab/commands/stack/stackutils"
 	"github.com/profclems/glab/pkg/git"
 	"github.com/profclems/glab/pkg/utils"
 	"github.com/spf13/cobra"
@@ -48,6 +49,10 @@ type CreateOpts struct {
 	AllowCollaboration bool
 	SquashBeforeMerge  bool
 
+	GenerateDescription bool
+	Template            string
+	Preview             bool
+
 	Autofill       bool
 	FillCommitBody bool
 	IsDraft        bool
@@ -94,6 +99,8 @@ func NewCmdCreate(f *cmdutils.Factory, runE func(opts *CreateOpts) error) *cobra
 			$ glab mr create -f --draft --label RFC
 			$ glab mr create --fill --yes --web
 			$ glab mr create --fill --fill-commit-body --yes
+			$ glab mr create --fill --generate-description --preview
+			$ glab mr create --fill --generate-description --template Feature --yes
 		`),
 		Args: cobra.ExactArgs(0),
 		PreRun: func(cmd *cobra.Command, args []string) {
@@ -140,6 +147,23 @@ func NewCmdCreate(f *cmdutils.Factory, runE func(opts *CreateOpts) error) *cobra
 				return &cmdutils.FlagError{Err: errors.New("--web already skips all prompts currently skipped by --yes")}
 			}
 
+			if opts.GenerateDescription && (opts.FillCommitBody || hasDescription) {
+				return &cmdutils.FlagError{Err: errors.New("--generate-description cannot be used with --fill-commit-body or --description")}
+			}
+			if opts.GenerateDescription && opts.RelatedIssue != "" {
+				return &cmdutils.FlagError{Err: errors.New("--generate-description cannot be used with --related-issue")}
+			}
+			if opts.Template != "" && !opts.GenerateDescription {
+				return &cmdutils.FlagError{Err: errors.New("--template can only be used with --generate-description")}
+			}
+			if opts.Preview && opts.Web {
+				return &cmdutils.FlagError{Err: errors.New("specify either of --preview or --web")}
+			}
+			if opts.Preview && opts.RelatedIssue != "" {
+				// --related-issue creates the source branch on GitLab
+				return &cmdutils.FlagError{Err: errors.New("--preview cannot be used with --related-issue")}
+			}
+
 			if opts.CopyIssueLabels && opts.RelatedIssue == "" {
 				return &cmdutils.FlagError{Err: errors.New("--copy-issue-labels can only be used with --related-issue")}
 			}
@@ -153,6 +177,9 @@ func NewCmdCreate(f *cmdutils.Factory, runE func(opts *CreateOpts) error) *cobra
 	}
 	mrCreateCmd.Flags().BoolVarP(&opts.Autofill, "fill", "f", false, "Do not prompt for title/description and just use commit info")
 	mrCreateCmd.Flags().BoolVarP(&opts.FillCommitBody, "fill-commit-body", "", false, "Fill description with each commit body when multiple commits. Can only be used with --fill")
+	mrCreateCmd.Flags().BoolVarP(&opts.GenerateDescription, "generate-description", "g", false, "Generate the description from the commits, grouped by conventional commit type, and the issues they reference")
+	mrCreateCmd.Flags().StringVarP(&opts.Template, "template", "", "", "Merge request template of the project to include in the generated description (default: \"Default\" if it exists)")
+	mrCreateCmd.Flags().BoolVarP(&opts.Preview, "preview", "", false, "Print the title and description of the merge request instead of creating it")
 	mrCreateCmd.Flags().BoolVarP(&opts.IsDraft, "draft", "", false, "Mark merge request as a draft")
 	mrCreateCmd.Flags().BoolVarP(&opts.IsWIP, "wip", "", false, "Mark merge request as a work in progress. Alternative to --draft")
 	mrCreateCmd.Flags().BoolVarP(&opts.ShouldPush, "push", "", false, "Push committed changes after creating merge request. Make sure you have committed changes")
@@ -162,7 +189,7 @@ func NewCmdCreate(f *cmdutils.Factory, runE func(opts *CreateOpts) error) *cobra
 	mrCreateCmd.Flags().StringSliceVarP(&opts.Assignees, "assignee", "a", []string{}, "Assign merge request to people by their `usernames`")
 	mrCreateCmd.Flags().StringSliceVarP(&opts.Reviewers, "reviewer", "", []string{}, "Request review from users by their `usernames`")
 	mrCreateCmd.Flags().StringVarP(&opts.SourceBranch, "source-branch", "s", "", "The Branch you are creating the merge request. Default is the current branch.")
-	mrCreateCmd.Flags().StringVarP(&opts.TargetBranch, "target-branch", "b", "", "The target or base branch into which you want your code merged")
+	mrCreateCmd.Flags().StringVarP(&opts.TargetBranch, "target-branch", "b", "", "The target or base branch into which you want your code merged (default: the branch before it in a stack, or the default branch)")
 	mrCreateCmd.Flags().BoolVarP(&opts.CreateSourceBranch, "create-source-branch", "", false, "Create source branch if it does not exist")
 	mrCreateCmd.Flags().StringVarP(&opts.MilestoneFlag, "milestone", "m", "", "The global ID or title of a milestone to assign")
 	mrCreateCmd.Flags().BoolVarP(&opts.AllowCollaboration, "allow-collaboration", "", false, "Allow commits from other members")
@@ -274,6 +301,10 @@ func createRun(opts *CreateOpts) error {
 		}
 	}
 
+	if opts.TargetBranch == "" && opts.SourceBranch != "" {
+		// a branch of a stack targets the branch it is based on
+		opts.TargetBranch, _ = stackutils.Parent(opts.SourceBranch)
+	}
 	if opts.TargetBranch == "" {
 		opts.TargetBranch = getTargetBranch(baseRepoRemote)
 	}
@@ -370,8 +401,16 @@ func createRun(opts *CreateOpts) error {
 				}
 			}
 
+			var generatedTitle string
+			if opts.GenerateDescription && opts.Description == "" {
+				generatedTitle, templateContents, err = generateTitleAndDescription(opts, templateContents)
+				if err != nil {
+					return err
+				}
+			}
+
 			if opts.Title == "" {
-				err = prompt.AskQuestionWithInput(&opts.Title, "title", "Title:", "", true)
+				err = prompt.AskQuestionWithInput(&opts.Title, "title", "Title:", generatedTitle, true)
 				if err != nil {
 					return err
 				}
@@ -407,6 +446,11 @@ func createRun(opts *CreateOpts) error {
 			opts.Title = "WIP: " + opts.Title
 		}
 	}
+
+	if opts.Preview {
+		fmt.Fprintf(out, "%s\n\n%s\n", c.Bold(opts.Title), opts.Description)
+		return nil
+	}
 	mrCreateOpts.Title = &opts.Title
 	mrCreateOpts.Description = &opts.Description
 	mrCreateOpts.SourceBranch = &opts.SourceBranch
@@ -558,6 +602,22 @@ func createRun(opts *CreateOpts) error {
 }
 
 func mrBodyAndTitle(opts *CreateOpts) error {
+	if opts.GenerateDescription {
+		templateContents, err := projectTemplate(opts.Template)
+		if err != nil {
+			return err
+		}
+		title, description, err := generateTitleAndDescription(opts, templateContents)
+		if err != nil {
+			return err
+		}
+		if opts.Title == "" {
+			opts.Title = title
+		}
+		opts.Description = description
+		return nil
+	}
+
 	// TODO: detect forks
 	commits, err := git.Commits(opts.TargetTrackingBranch, opts.SourceBranch)
 	if err != nil {
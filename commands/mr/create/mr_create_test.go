@@ -6,6 +6,8 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -24,6 +26,7 @@ import (
 	"github.com/profclems/glab/pkg/httpmock"
 	"github.com/profclems/glab/test"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/xanzy/go-gitlab"
 )
 
@@ -143,6 +146,7 @@ func TestNewCmdCreate_tty(t *testing.T) {
 
 	cs, csTeardown := test.InitCmdStubber()
 	defer csTeardown()
+	cs.Stub("") // git config --get-regexp: not a stacked branch
 	cs.Stub("HEAD branch: master\n")
 	cs.Stub(heredoc.Doc(`
 		deadbeef HEAD
@@ -213,6 +217,14 @@ func TestMRCreate_nontty_insufficient_flags(t *testing.T) {
 	assert.Equal(t, "--title or --fill required for non-interactive mode", err.Error())
 }
 
+func TestMRCreate_previewWithRelatedIssue(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+
+	_, err := runCommand(fakeHTTP, nil, nil, "test-br", false, "--fill --related-issue 5 --preview")
+	assert.EqualError(t, err, "--preview cannot be used with --related-issue")
+}
+
 func TestMrBodyAndTitle(t *testing.T) {
 	opts := &CreateOpts{
 		SourceBranch:         "mr-autofill-test-br",
@@ -311,4 +323,43 @@ closes 1234
 
 `, opts.Description)
 	})
+	t.Run("generate-description", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, ".gitlab", "merge_request_templates"), 0755))
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, ".gitlab", "merge_request_templates", "Default.md"), []byte("## Checklist\n"), 0644))
+
+		cs, csTeardown := test.InitCmdStubber()
+		defer csTeardown()
+
+		cs.Stub(dir)                                                                // git rev-parse --show-toplevel
+		cs.Stub("d2asa3,fix(parser): handle comments\nd1sd2e,feat: add the parser") // git log
+		cs.Stub("")                                                                 // git show d1sd2e
+		cs.Stub("Fixes #4\n")                                                       // git show d2asa3
+
+		opts := &CreateOpts{
+			SourceBranch:         "12-parser",
+			TargetBranch:         "master",
+			TargetTrackingBranch: "origin/master",
+			GenerateDescription:  true,
+			Config: func() (config.Config, error) {
+				return config.NewBlankConfig(), nil
+			},
+		}
+		require.NoError(t, mrBodyAndTitle(opts))
+
+		assert.Equal(t, "12 parser", opts.Title)
+		assert.Equal(t, heredoc.Doc(`
+			### Features
+
+			- add the parser
+
+			### Bug fixes
+
+			- **parser:** handle comments
+
+			## Checklist
+
+			Closes #4
+			Closes #12`), opts.Description)
+	})
 }
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"path"
 	"strings"
 	"syscall"
 
@@ -15,6 +16,7 @@ import (
 	"github.com/MakeNowJust/heredoc"
 	"github.com/profclems/glab/commands/cmdutils"
 	"github.com/profclems/glab/commands/mr/mrutils"
+	"github.com/profclems/glab/internal/glrepo"
 	"github.com/xanzy/go-gitlab"
 
 	"github.com/spf13/cobra"
@@ -26,6 +28,18 @@ type DiffOptions struct {
 
 	Args     []string
 	UseColor string
+
+	SideBySide bool
+	WordDiff   bool
+	Syntax     bool
+	NameOnly   bool
+	Stat       bool
+	Paths      []string
+
+	ListVersions bool
+	FromVersion  int
+	ToVersion    int
+	SinceReview  bool
 }
 
 func NewCmdDiff(f *cmdutils.Factory, runF func(*DiffOptions) error) *cobra.Command {
@@ -42,6 +56,12 @@ func NewCmdDiff(f *cmdutils.Factory, runF func(*DiffOptions) error) *cobra.Comma
 			$ glab mr diff branch
 			$ glab mr diff  # get from current branch
 			$ glab mr diff 123 --color=never
+			$ glab mr diff 123 --side-by-side --word-diff
+			$ glab mr diff 123 --stat
+			$ glab mr diff 123 --path '*.go' --path docs/
+			$ glab mr diff 123 --versions
+			$ glab mr diff 123 --from-version 2 --to-version 3
+			$ glab mr diff 123 --since-review
 		`),
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -58,6 +78,32 @@ func NewCmdDiff(f *cmdutils.Factory, runF func(*DiffOptions) error) *cobra.Comma
 				return &cmdutils.FlagError{Err: fmt.Errorf("did not understand color: %q. Expected one of always, never, or auto", opts.UseColor)}
 			}
 
+			if opts.NameOnly && opts.Stat {
+				return &cmdutils.FlagError{Err: errors.New("specify only one of --name-only or --stat")}
+			}
+
+			if (opts.NameOnly || opts.Stat) && (opts.SideBySide || opts.WordDiff || opts.Syntax) {
+				return &cmdutils.FlagError{Err: errors.New("--name-only and --stat cannot be used with --side-by-side, --word-diff or --syntax")}
+			}
+
+			if opts.FromVersion < 0 || opts.ToVersion < 0 {
+				return &cmdutils.FlagError{Err: errors.New("version numbers must be positive")}
+			}
+
+			if opts.SinceReview && opts.FromVersion > 0 {
+				return &cmdutils.FlagError{Err: errors.New("specify only one of --since-review or --from-version")}
+			}
+
+			if opts.ListVersions && (opts.FromVersion > 0 || opts.ToVersion > 0 || opts.SinceReview || opts.NameOnly || opts.Stat || len(opts.Paths) > 0) {
+				return &cmdutils.FlagError{Err: errors.New("--versions cannot be used with other diff options")}
+			}
+
+			for _, p := range opts.Paths {
+				if _, err := path.Match(p, ""); err != nil {
+					return &cmdutils.FlagError{Err: fmt.Errorf("invalid --path pattern %q: %w", p, err)}
+				}
+			}
+
 			if opts.UseColor == "auto" && !opts.IO.IsaTTY {
 				opts.UseColor = "never"
 			}
@@ -70,6 +116,16 @@ func NewCmdDiff(f *cmdutils.Factory, runF func(*DiffOptions) error) *cobra.Comma
 	}
 
 	cmd.Flags().StringVar(&opts.UseColor, "color", "auto", "Use color in diff output: {always|never|auto}")
+	cmd.Flags().BoolVarP(&opts.SideBySide, "side-by-side", "s", false, "Show the old and new versions of the changes side by side")
+	cmd.Flags().BoolVarP(&opts.WordDiff, "word-diff", "w", false, "Highlight the changed words within modified lines")
+	cmd.Flags().BoolVar(&opts.Syntax, "syntax", false, "Highlight the syntax of the changed code when using color")
+	cmd.Flags().BoolVar(&opts.NameOnly, "name-only", false, "Show only the names of the changed files")
+	cmd.Flags().BoolVar(&opts.Stat, "stat", false, "Show a summary of the lines changed in each file")
+	cmd.Flags().StringSliceVar(&opts.Paths, "path", nil, "Only show changes to files matching the glob pattern or directory. Can be used multiple times")
+	cmd.Flags().BoolVar(&opts.ListVersions, "versions", false, "List the diff versions of the merge request")
+	cmd.Flags().IntVar(&opts.FromVersion, "from-version", 0, "Show the changes made since the given version of the merge request")
+	cmd.Flags().IntVar(&opts.ToVersion, "to-version", 0, "Show the changes up to the given version of the merge request (default: latest)")
+	cmd.Flags().BoolVar(&opts.SinceReview, "since-review", false, "Show the changes made since you last commented on or approved the merge request")
 
 	return cmd
 }
@@ -89,28 +145,45 @@ func diffRun(opts *DiffOptions) error {
 		return fmt.Errorf("could not find merge request diffs: %w", err)
 	}
 
-	diffOut := &bytes.Buffer{}
-	for _, diff := range diffs {
-		// the diffs are not included in the GetMergeRequestDiffVersions so we query for each diff version
-		diffVersion, _, err := apiClient.MergeRequests.GetSingleMergeRequestDiffVersion(baseRepo.FullName(), mr.IID, diff.ID)
+	versions := sortVersions(diffs)
+	if opts.ListVersions {
+		return listVersions(opts, mr, versions)
+	}
+
+	var fileDiffs []*gitlab.Diff
+	if opts.FromVersion > 0 || opts.ToVersion > 0 || opts.SinceReview {
+		fileDiffs, err = versionDiffs(opts, apiClient, baseRepo, mr, versions)
+		if err != nil || fileDiffs == nil {
+			return err
+		}
+	} else if len(versions) > 0 {
+		// the diffs are not included in the GetMergeRequestDiffVersions so we query the
+		// latest version, which holds the whole diff of the merge request
+		latest := versions[len(versions)-1]
+		diffVersion, _, err := apiClient.MergeRequests.GetSingleMergeRequestDiffVersion(baseRepo.FullName(), mr.IID, latest.ID)
 		if err != nil {
 			return fmt.Errorf("could not find merge request diff: %w", err)
 		}
-		for _, diffLine := range diffVersion.Diffs {
-			if diffLine.RenamedFile {
-				diffOut.WriteString("-" + diffLine.OldPath + "\n")
-			}
-			if diffLine.NewFile || diffLine.RenamedFile {
-				diffOut.WriteString("+" + diffLine.NewPath + "\n")
-			} else {
-				diffOut.WriteString(diffLine.OldPath + "\n")
-			}
+		fileDiffs = diffVersion.Diffs
+	}
+	fileDiffs = filterDiffs(fileDiffs, opts.Paths)
 
-			diffOut.WriteString(diffLine.Diff)
-		}
+	r := &renderer{
+		out:      opts.IO.StdOut,
+		color:    opts.UseColor != "never",
+		wordDiff: opts.WordDiff,
+		syntax:   opts.Syntax,
+		width:    opts.IO.TerminalWidth(),
 	}
 
-	defer diffOut.Reset()
+	switch {
+	case opts.NameOnly:
+		r.nameOnly(fileDiffs)
+		return nil
+	case opts.Stat:
+		r.stat(fileDiffs)
+		return nil
+	}
 
 	err = opts.IO.StartPager()
 	if err != nil {
@@ -118,6 +191,31 @@ func diffRun(opts *DiffOptions) error {
 	}
 	defer opts.IO.StopPager()
 
+	switch {
+	case opts.SideBySide:
+		r.sideBySide(fileDiffs)
+		return nil
+	case opts.WordDiff || opts.Syntax:
+		r.unified(fileDiffs)
+		return nil
+	}
+
+	diffOut := &bytes.Buffer{}
+	for _, diffLine := range fileDiffs {
+		if diffLine.RenamedFile {
+			diffOut.WriteString("-" + diffLine.OldPath + "\n")
+		}
+		if diffLine.NewFile || diffLine.RenamedFile {
+			diffOut.WriteString("+" + diffLine.NewPath + "\n")
+		} else {
+			diffOut.WriteString(diffLine.OldPath + "\n")
+		}
+
+		diffOut.WriteString(diffLine.Diff)
+	}
+
+	defer diffOut.Reset()
+
 	if opts.UseColor == "never" {
 		_, err = io.Copy(opts.IO.StdOut, diffOut)
 		if errors.Is(err, syscall.EPIPE) {
@@ -148,6 +246,56 @@ func diffRun(opts *DiffOptions) error {
 	return nil
 }
 
+// versionDiffs returns the diffs selected by --from-version, --to-version and --since-review.
+// A nil result without an error means there is nothing to show.
+func versionDiffs(opts *DiffOptions, apiClient *gitlab.Client, repo glrepo.Interface, mr *gitlab.MergeRequest, versions []*gitlab.MergeRequestDiffVersion) ([]*gitlab.Diff, error) {
+	toNumber := len(versions)
+	if opts.ToVersion > 0 {
+		toNumber = opts.ToVersion
+	}
+	to, err := findVersion(versions, toNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	fromNumber := opts.FromVersion
+	if opts.SinceReview {
+		fromNumber, err = reviewedVersion(apiClient, repo, mr, versions)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if fromNumber == 0 {
+		diffVersion, _, err := apiClient.MergeRequests.GetSingleMergeRequestDiffVersion(repo.FullName(), mr.IID, to.ID)
+		if err != nil {
+			return nil, fmt.Errorf("could not find merge request diff: %w", err)
+		}
+		return diffVersion.Diffs, nil
+	}
+
+	from, err := findVersion(versions, fromNumber)
+	if err != nil {
+		return nil, err
+	}
+	if from.HeadCommitSHA == to.HeadCommitSHA {
+		fmt.Fprintf(opts.IO.StdErr, "No changes between version %d and version %d\n", fromNumber, toNumber)
+		return nil, nil
+	}
+
+	if opts.IO.IsErrTTY {
+		fmt.Fprintf(opts.IO.StdErr, "Showing changes from version %d to version %d\n", fromNumber, toNumber)
+	}
+	diffs, err := interdiff(apiClient, repo, from, to)
+	if err != nil {
+		return nil, err
+	}
+	if diffs == nil {
+		diffs = []*gitlab.Diff{}
+	}
+	return diffs, nil
+}
+
 var diffHeaderPrefixes = []string{"+++", "---", "diff", "index"}
 
 func isHeaderLine(dl string) bool {
@@ -69,6 +69,36 @@ func Test_NewCmdDiff(t *testing.T) {
 			isTTY:   true,
 			wantErr: `did not understand color: "doublerainbow". Expected one of always, never, or auto`,
 		},
+		{
+			name:    "--name-only with --stat",
+			args:    "--name-only --stat",
+			isTTY:   true,
+			wantErr: "specify only one of --name-only or --stat",
+		},
+		{
+			name:    "--stat with --side-by-side",
+			args:    "--stat --side-by-side",
+			isTTY:   true,
+			wantErr: "--name-only and --stat cannot be used with --side-by-side, --word-diff or --syntax",
+		},
+		{
+			name:    "--since-review with --from-version",
+			args:    "--since-review --from-version 2",
+			isTTY:   true,
+			wantErr: "specify only one of --since-review or --from-version",
+		},
+		{
+			name:    "--versions with --stat",
+			args:    "--versions --stat",
+			isTTY:   true,
+			wantErr: "--versions cannot be used with other diff options",
+		},
+		{
+			name:    "invalid --path pattern",
+			args:    "--path [",
+			isTTY:   true,
+			wantErr: `invalid --path pattern "[": syntax error in pattern`,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -351,3 +381,102 @@ func DiffTest() string {
 	)
 	return "--- /dev/null\n+++ b/LICENSE\n@@ -0,0 +1,21 @@\n+The MIT License (MIT)\n+\n+Copyright (c) 2018 Administrator\n+\n+Permission is hereby granted, free of charge, to any person obtaining a copy\n+of this software and associated documentation files (the \"Software\"), to deal\n+in the Software without restriction, including without limitation the rights\n+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n+copies of the Software, and to permit persons to whom the Software is\n+furnished to do so, subject to the following conditions:\n+\n+The above copyright notice and this permission notice shall be included in all\n+copies or substantial portions of the Software.\n+\n+THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE\n+SOFTWARE.\n"
 }
+
+func registerVersions() {
+	httpmock.RegisterResponder("GET", `https://gitlab.com/api/v4/projects/OWNER%2FREPO/merge_requests/123`,
+		httpmock.NewStringResponder(200, `{"id": 123, "iid": 123, "project_id": 3, "title": "test1", "state": "opened"}`))
+	httpmock.RegisterResponder("GET", `https://gitlab.com/api/v4/projects/OWNER%2FREPO/merge_requests/123/versions`,
+		httpmock.NewStringResponder(200, `[
+  {"id": 112, "head_commit_sha": "cccccccccccccccc", "created_at": "2021-01-03T10:00:00Z"},
+  {"id": 111, "head_commit_sha": "bbbbbbbbbbbbbbbb", "created_at": "2021-01-02T10:00:00Z"},
+  {"id": 110, "head_commit_sha": "aaaaaaaaaaaaaaaa", "created_at": "2021-01-01T10:00:00Z"}
+]`))
+}
+
+func TestMRDiff_versions(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	registerVersions()
+
+	output, err := runCommand(nil, false, "123 --versions")
+	require.NoError(t, err)
+
+	lines := strings.Split(output.String(), "\n")
+	assert.Equal(t, "Showing 3 versions of !123", lines[0])
+	assert.Regexp(t, `^3\tcccccccc\t.*\tlatest$`, lines[2])
+	assert.Regexp(t, `^2\tbbbbbbbb\t.*\t$`, lines[3])
+	assert.Regexp(t, `^1\taaaaaaaa\t.*\t$`, lines[4])
+}
+
+func TestMRDiff_latestVersion(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	registerVersions()
+	httpmock.RegisterResponder("GET", `https://gitlab.com/api/v4/projects/OWNER%2FREPO/merge_requests/123/versions/112`,
+		httpmock.NewStringResponder(200, `{"id": 112, "diffs": [{
+  "old_path": "main.go",
+  "new_path": "main.go",
+  "diff": "@@ -1 +1,2 @@\n-version 1\n+version 3\n+done\n"
+}]}`))
+
+	output, err := runCommand(nil, false, "123 --stat")
+	require.NoError(t, err)
+	assert.Contains(t, output.String(), " 1 file changed, 2 insertions(+), 1 deletion(-)")
+}
+
+func TestMRDiff_fromVersion(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	registerVersions()
+	httpmock.RegisterResponder("GET", `https://gitlab.com/api/v4/projects/OWNER%2FREPO/repository/compare`,
+		func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, "aaaaaaaaaaaaaaaa", req.URL.Query().Get("from"))
+			assert.Equal(t, "bbbbbbbbbbbbbbbb", req.URL.Query().Get("to"))
+			assert.Equal(t, "true", req.URL.Query().Get("straight"))
+			return httpmock.NewStringResponse(200, `{"diffs": [{
+  "old_path": "main.go",
+  "new_path": "main.go",
+  "diff": "@@ -1 +1 @@\n-version 1\n+version 2\n"
+}]}`), nil
+		})
+
+	output, err := runCommand(nil, false, "123 --from-version 1 --to-version 2 --name-only")
+	require.NoError(t, err)
+	assert.Equal(t, "main.go\n", output.String())
+
+	_, err = runCommand(nil, false, "123 --from-version 4")
+	assert.EqualError(t, err, "version 4 does not exist. The merge request has 3 versions")
+}
+
+func TestMRDiff_sinceReview(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	registerVersions()
+	httpmock.RegisterResponder("GET", `https://gitlab.com/api/v4/user`,
+		httpmock.NewStringResponder(200, `{"id": 1, "username": "reviewer"}`))
+	httpmock.RegisterResponder("GET", `https://gitlab.com/api/v4/projects/OWNER%2FREPO/merge_requests/123/notes`,
+		httpmock.NewStringResponder(200, `[
+  {"id": 4, "body": "changed the description", "system": true, "author": {"id": 1}, "created_at": "2021-01-03T12:00:00Z"},
+  {"id": 3, "body": "pushed", "author": {"id": 2}, "created_at": "2021-01-03T11:00:00Z"},
+  {"id": 2, "body": "approved this merge request", "system": true, "author": {"id": 1}, "created_at": "2021-01-02T12:00:00Z"},
+  {"id": 1, "body": "looks good", "author": {"id": 1}, "created_at": "2021-01-01T12:00:00Z"}
+]`))
+	httpmock.RegisterResponder("GET", `https://gitlab.com/api/v4/projects/OWNER%2FREPO/repository/compare`,
+		func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, "bbbbbbbbbbbbbbbb", req.URL.Query().Get("from"))
+			assert.Equal(t, "cccccccccccccccc", req.URL.Query().Get("to"))
+			return httpmock.NewStringResponse(200, `{"diffs": [{
+  "old_path": "main.go",
+  "new_path": "main.go",
+  "diff": "@@ -1 +1 @@\n-version 2\n+version 3\n"
+}]}`), nil
+		})
+
+	output, err := runCommand(nil, false, "123 --since-review")
+	require.NoError(t, err)
+	assert.Equal(t, "main.go\n@@ -1 +1 @@\n-version 2\n+version 3\n", output.String())
+}
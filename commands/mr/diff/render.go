@@ -0,0 +1,586 @@
+package diff
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/alecthomas/chroma"
+	"github.com/alecthomas/chroma/formatters"
+	"github.com/alecthomas/chroma/lexers"
+	"github.com/alecthomas/chroma/styles"
+	"github.com/profclems/glab/pkg/text"
+	"github.com/profclems/glab/pkg/utils"
+	"github.com/xanzy/go-gitlab"
+)
+
+const (
+	colorReset       = "\x1b[m"
+	colorHeader      = "\x1b[1;38m"
+	colorHunk        = "\x1b[36m"
+	colorAddition    = "\x1b[32m"
+	colorRemoval     = "\x1b[31m"
+	colorAddedWord   = "\x1b[1;37;42m"
+	colorRemovedWord = "\x1b[1;37;41m"
+	colorLineNumber  = "\x1b[2m"
+
+	syntaxStyle = "monokai"
+
+	// lines with more tokens than this are highlighted as a whole instead of word by word
+	maxWordDiffTokens = 500
+)
+
+var hunkHeaderRE = regexp.MustCompile(`^@@ -(\d+)(?:,\d+)? \+(\d+)(?:,\d+)? @@`)
+
+// segment is a piece of a diff line which is either unchanged or changed
+// relative to the line it is paired with
+type segment struct {
+	text    string
+	changed bool
+}
+
+type line struct {
+	// kind is the diff marker of the line: ' ', '+', '-', '@' for hunk headers,
+	// 'h' for file headers and '\\' for "No newline at end of file"
+	kind     byte
+	text     string
+	oldNum   int
+	newNum   int
+	segments []segment
+}
+
+type renderer struct {
+	out      io.Writer
+	color    bool
+	wordDiff bool
+	syntax   bool
+	width    int
+}
+
+// filterDiffs returns the diffs whose old or new path matches any of the glob patterns.
+// Patterns without a slash are also matched against the base name of the file.
+func filterDiffs(diffs []*gitlab.Diff, patterns []string) []*gitlab.Diff {
+	if len(patterns) == 0 {
+		return diffs
+	}
+	var filtered []*gitlab.Diff
+	for _, d := range diffs {
+		if matchesPath(d.NewPath, patterns) || matchesPath(d.OldPath, patterns) {
+			filtered = append(filtered, d)
+		}
+	}
+	return filtered
+}
+
+func matchesPath(p string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, p); ok {
+			return true
+		}
+		if !strings.Contains(pattern, "/") {
+			if ok, _ := path.Match(pattern, path.Base(p)); ok {
+				return true
+			}
+		}
+		if strings.HasPrefix(p, strings.TrimSuffix(pattern, "/")+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func displayPath(d *gitlab.Diff) string {
+	if d.RenamedFile && d.OldPath != d.NewPath {
+		return d.OldPath + " => " + d.NewPath
+	}
+	return d.NewPath
+}
+
+func countChanges(d *gitlab.Diff) (additions, deletions int) {
+	for _, l := range parseDiff(d.Diff) {
+		switch l.kind {
+		case '+':
+			additions++
+		case '-':
+			deletions++
+		}
+	}
+	return
+}
+
+func (r *renderer) colorize(color, s string) string {
+	if !r.color || s == "" {
+		return s
+	}
+	return color + s + colorReset
+}
+
+func (r *renderer) nameOnly(diffs []*gitlab.Diff) {
+	for _, d := range diffs {
+		fmt.Fprintln(r.out, d.NewPath)
+	}
+}
+
+func (r *renderer) stat(diffs []*gitlab.Diff) {
+	type fileStat struct {
+		name                 string
+		additions, deletions int
+	}
+	var stats []fileStat
+	nameWidth, maxChanges := 0, 0
+	totalAdditions, totalDeletions := 0, 0
+	for _, d := range diffs {
+		s := fileStat{name: displayPath(d)}
+		s.additions, s.deletions = countChanges(d)
+		stats = append(stats, s)
+
+		totalAdditions += s.additions
+		totalDeletions += s.deletions
+		if w := text.StringWidth(s.name); w > nameWidth {
+			nameWidth = w
+		}
+		if c := s.additions + s.deletions; c > maxChanges {
+			maxChanges = c
+		}
+	}
+
+	countWidth := len(strconv.Itoa(maxChanges))
+	barWidth := r.width - nameWidth - countWidth - 6
+	if barWidth < 10 {
+		barWidth = 10
+	}
+
+	for _, s := range stats {
+		plus, minus := s.additions, s.deletions
+		if maxChanges > barWidth {
+			plus = scale(plus, maxChanges, barWidth)
+			minus = scale(minus, maxChanges, barWidth)
+		}
+		fmt.Fprintf(r.out, " %s | %*d %s%s\n",
+			text.PadRight(s.name, nameWidth, ' '), countWidth, s.additions+s.deletions,
+			r.colorize(colorAddition, strings.Repeat("+", plus)),
+			r.colorize(colorRemoval, strings.Repeat("-", minus)))
+	}
+
+	summary := fmt.Sprintf(" %s changed", utils.Pluralize(len(stats), "file"))
+	if totalAdditions > 0 || totalDeletions == 0 {
+		summary += fmt.Sprintf(", %s(+)", utils.Pluralize(totalAdditions, "insertion"))
+	}
+	if totalDeletions > 0 || totalAdditions == 0 {
+		summary += fmt.Sprintf(", %s(-)", utils.Pluralize(totalDeletions, "deletion"))
+	}
+	fmt.Fprintln(r.out, summary)
+}
+
+// scale scales n to fit in width, keeping at least one character for a non-zero n
+func scale(n, total, width int) int {
+	if n == 0 {
+		return 0
+	}
+	scaled := n * width / total
+	if scaled == 0 {
+		return 1
+	}
+	return scaled
+}
+
+func (r *renderer) unified(diffs []*gitlab.Diff) {
+	for _, d := range diffs {
+		r.fileHeader(d)
+		lexer := r.lexer(d.NewPath)
+		lines := parseDiff(d.Diff)
+		if r.wordDiff {
+			pairLines(lines)
+		}
+		for i := range lines {
+			l := &lines[i]
+			switch l.kind {
+			case 'h':
+				fmt.Fprintln(r.out, r.colorize(colorHeader, l.text))
+			case '@':
+				fmt.Fprintln(r.out, r.colorize(colorHunk, l.text))
+			case '\\':
+				fmt.Fprintln(r.out, l.text)
+			default:
+				fmt.Fprintln(r.out, r.marker(l.kind)+r.content(l, lexer))
+			}
+		}
+	}
+}
+
+func (r *renderer) sideBySide(diffs []*gitlab.Diff) {
+	// each side has a 4 digit line number, the diff marker and the content
+	columnWidth := (r.width - 3) / 2
+	if columnWidth < 20 {
+		columnWidth = 20
+	}
+	contentWidth := columnWidth - 7
+	separator := r.colorize(colorLineNumber, " │ ")
+
+	for _, d := range diffs {
+		r.fileHeader(d)
+		lexer := r.lexer(d.NewPath)
+		lines := parseDiff(d.Diff)
+		if r.wordDiff {
+			pairLines(lines)
+		}
+
+		cell := func(l *line, num int) string {
+			truncated := *l
+			truncated.segments = truncateSegments(lineSegments(l), contentWidth)
+			width := 0
+			for _, s := range truncated.segments {
+				width += text.StringWidth(s.text)
+			}
+			return r.colorize(colorLineNumber, fmt.Sprintf("%4d ", num)) +
+				r.marker(l.kind) + " " + r.content(&truncated, lexer) +
+				strings.Repeat(" ", contentWidth-width)
+		}
+
+		for i := 0; i < len(lines); i++ {
+			l := &lines[i]
+			switch l.kind {
+			case 'h':
+				// the file header above already names the files
+				continue
+			case '@':
+				fmt.Fprintln(r.out, r.colorize(colorHunk, l.text))
+				continue
+			case '\\':
+				fmt.Fprintln(r.out, l.text)
+				continue
+			case ' ':
+				fmt.Fprintln(r.out, strings.TrimRight(cell(l, l.oldNum)+separator+cell(l, l.newNum), " "))
+				continue
+			}
+
+			// a block of removals followed by a block of additions is shown row by row
+			var removed, added []*line
+			for ; i < len(lines) && lines[i].kind == '-'; i++ {
+				removed = append(removed, &lines[i])
+			}
+			for ; i < len(lines) && lines[i].kind == '+'; i++ {
+				added = append(added, &lines[i])
+			}
+			i--
+
+			for row := 0; row < len(removed) || row < len(added); row++ {
+				left, right := strings.Repeat(" ", columnWidth), ""
+				if row < len(removed) {
+					left = cell(removed[row], removed[row].oldNum)
+				}
+				if row < len(added) {
+					right = cell(added[row], added[row].newNum)
+				}
+				fmt.Fprintln(r.out, strings.TrimRight(left+separator+right, " "))
+			}
+		}
+	}
+}
+
+func (r *renderer) fileHeader(d *gitlab.Diff) {
+	header := displayPath(d)
+	switch {
+	case d.NewFile:
+		header += " (new file)"
+	case d.DeletedFile:
+		header += " (deleted)"
+	}
+	fmt.Fprintln(r.out, r.colorize(colorHeader, header))
+}
+
+func (r *renderer) marker(kind byte) string {
+	switch kind {
+	case '+':
+		return r.colorize(colorAddition, "+")
+	case '-':
+		return r.colorize(colorRemoval, "-")
+	}
+	return " "
+}
+
+// content renders the text of an added, removed or context line.
+// Changed words are highlighted when the line was paired by pairLines,
+// otherwise the line is syntax highlighted if enabled.
+func (r *renderer) content(l *line, lexer chroma.Lexer) string {
+	segments := lineSegments(l)
+	if !r.color {
+		if !r.wordDiff || l.segments == nil {
+			return joinSegments(segments)
+		}
+		var b strings.Builder
+		for _, s := range segments {
+			switch {
+			case !s.changed:
+				b.WriteString(s.text)
+			case l.kind == '+':
+				b.WriteString("{+" + s.text + "+}")
+			default:
+				b.WriteString("[-" + s.text + "-]")
+			}
+		}
+		return b.String()
+	}
+
+	lineColor, wordColor := "", ""
+	switch l.kind {
+	case '+':
+		lineColor, wordColor = colorAddition, colorAddedWord
+	case '-':
+		lineColor, wordColor = colorRemoval, colorRemovedWord
+	}
+
+	if r.wordDiff && hasChanges(l.segments) {
+		var b strings.Builder
+		for _, s := range segments {
+			if s.changed {
+				b.WriteString(r.colorize(wordColor, s.text))
+			} else {
+				b.WriteString(r.colorize(lineColor, s.text))
+			}
+		}
+		return b.String()
+	}
+
+	if lexer != nil {
+		if highlighted, ok := highlight(lexer, joinSegments(segments)); ok {
+			return highlighted
+		}
+	}
+	if lineColor == "" {
+		return joinSegments(segments)
+	}
+	return r.colorize(lineColor, joinSegments(segments))
+}
+
+func (r *renderer) lexer(filename string) chroma.Lexer {
+	if !r.syntax || !r.color {
+		return nil
+	}
+	lexer := lexers.Match(filename)
+	if lexer == nil {
+		return nil
+	}
+	return chroma.Coalesce(lexer)
+}
+
+func highlight(lexer chroma.Lexer, s string) (string, bool) {
+	if s == "" {
+		return s, true
+	}
+	iterator, err := lexer.Tokenise(nil, s)
+	if err != nil {
+		return "", false
+	}
+	var b bytes.Buffer
+	if err := formatters.Get("terminal256").Format(&b, styles.Get(syntaxStyle), iterator); err != nil {
+		return "", false
+	}
+	return strings.TrimRight(b.String(), "\n"), true
+}
+
+// parseDiff splits a unified diff into lines, keeping track of the line numbers
+// in the old and new versions of the file
+func parseDiff(diff string) []line {
+	var lines []line
+	oldNum, newNum := 0, 0
+	inHunk := false
+	for _, raw := range strings.Split(strings.TrimSuffix(diff, "\n"), "\n") {
+		if raw == "" && !inHunk {
+			continue
+		}
+		if m := hunkHeaderRE.FindStringSubmatch(raw); m != nil {
+			oldNum, _ = strconv.Atoi(m[1])
+			newNum, _ = strconv.Atoi(m[2])
+			inHunk = true
+			lines = append(lines, line{kind: '@', text: raw})
+			continue
+		}
+		if !inHunk {
+			lines = append(lines, line{kind: 'h', text: raw})
+			continue
+		}
+
+		l := line{kind: ' ', text: raw}
+		if raw != "" {
+			l.kind, l.text = raw[0], raw[1:]
+		}
+		switch l.kind {
+		case '+':
+			l.newNum = newNum
+			newNum++
+		case '-':
+			l.oldNum = oldNum
+			oldNum++
+		case '\\':
+			l.text = raw
+		default:
+			l.kind = ' '
+			l.oldNum, l.newNum = oldNum, newNum
+			oldNum++
+			newNum++
+		}
+		lines = append(lines, l)
+	}
+	return lines
+}
+
+// pairLines pairs each block of removed lines with the block of added lines
+// which follows it and records the words which changed between the pairs
+func pairLines(lines []line) {
+	for i := 0; i < len(lines); {
+		if lines[i].kind != '-' {
+			i++
+			continue
+		}
+		start := i
+		for i < len(lines) && lines[i].kind == '-' {
+			i++
+		}
+		removed := lines[start:i]
+		start = i
+		for i < len(lines) && lines[i].kind == '+' {
+			i++
+		}
+		added := lines[start:i]
+
+		for j := 0; j < len(removed) && j < len(added); j++ {
+			removed[j].segments, added[j].segments = wordDiff(removed[j].text, added[j].text)
+		}
+	}
+}
+
+func lineSegments(l *line) []segment {
+	if l.segments != nil {
+		return l.segments
+	}
+	return []segment{{text: l.text}}
+}
+
+func hasChanges(segments []segment) bool {
+	for _, s := range segments {
+		if s.changed {
+			return true
+		}
+	}
+	return false
+}
+
+func joinSegments(segments []segment) string {
+	var b strings.Builder
+	for _, s := range segments {
+		b.WriteString(s.text)
+	}
+	return b.String()
+}
+
+// truncateSegments expands tabs and cuts the segments to fit in width
+func truncateSegments(segments []segment, width int) []segment {
+	var truncated []segment
+	for _, s := range segments {
+		var b strings.Builder
+		for _, r := range strings.ReplaceAll(s.text, "\t", "    ") {
+			w := text.RuneWidth(r)
+			if w > width {
+				width = 0
+				break
+			}
+			b.WriteRune(r)
+			width -= w
+		}
+		truncated = append(truncated, segment{text: b.String(), changed: s.changed})
+		if width == 0 {
+			break
+		}
+	}
+	return truncated
+}
+
+// wordDiff compares two lines token by token and returns the segments of each
+// line, marking the tokens which are not part of their longest common subsequence
+func wordDiff(old, new string) ([]segment, []segment) {
+	a, b := tokenize(old), tokenize(new)
+	if len(a) > maxWordDiffTokens || len(b) > maxWordDiffTokens {
+		return []segment{{text: old, changed: true}}, []segment{{text: new, changed: true}}
+	}
+
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var oldSegments, newSegments []segment
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			oldSegments = appendSegment(oldSegments, a[i], false)
+			newSegments = appendSegment(newSegments, b[j], false)
+			i++
+			j++
+		case j < len(b) && (i == len(a) || lcs[i][j+1] >= lcs[i+1][j]):
+			newSegments = appendSegment(newSegments, b[j], true)
+			j++
+		default:
+			oldSegments = appendSegment(oldSegments, a[i], true)
+			i++
+		}
+	}
+	if oldSegments == nil {
+		oldSegments = []segment{}
+	}
+	if newSegments == nil {
+		newSegments = []segment{}
+	}
+	return oldSegments, newSegments
+}
+
+func appendSegment(segments []segment, token string, changed bool) []segment {
+	if n := len(segments); n > 0 && segments[n-1].changed == changed {
+		segments[n-1].text += token
+		return segments
+	}
+	return append(segments, segment{text: token, changed: changed})
+}
+
+// tokenize splits s into words, runs of whitespace and single punctuation characters
+func tokenize(s string) []string {
+	var tokens []string
+	runes := []rune(s)
+	for i := 0; i < len(runes); {
+		j := i + 1
+		switch {
+		case isWordRune(runes[i]):
+			for j < len(runes) && isWordRune(runes[j]) {
+				j++
+			}
+		case unicode.IsSpace(runes[i]):
+			for j < len(runes) && unicode.IsSpace(runes[j]) {
+				j++
+			}
+		}
+		tokens = append(tokens, string(runes[i:j]))
+		i = j
+	}
+	return tokens
+}
+
+func isWordRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
@@ -0,0 +1,127 @@
+package diff
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/stretchr/testify/assert"
+	"github.com/xanzy/go-gitlab"
+)
+
+var renderDiffs = []*gitlab.Diff{
+	{
+		OldPath: "main.go",
+		NewPath: "main.go",
+		Diff: heredoc.Doc(`
+			@@ -1,4 +1,4 @@
+			 package main
+			-func greet() { fmt.Println("hello world") }
+			+func greet() { fmt.Println("hello there") }
+			+// greet prints a greeting
+			 func main() {}
+		`),
+	},
+	{
+		OldPath:     "docs/old.md",
+		NewPath:     "docs/new.md",
+		RenamedFile: true,
+		Diff: heredoc.Doc(`
+			@@ -1 +0,0 @@
+			-Remove me
+		`),
+	},
+}
+
+func Test_filterDiffs(t *testing.T) {
+	tests := []struct {
+		patterns []string
+		want     []string
+	}{
+		{nil, []string{"main.go", "docs/new.md"}},
+		{[]string{"*.go"}, []string{"main.go"}},
+		{[]string{"docs/"}, []string{"docs/new.md"}},
+		{[]string{"docs/old.md"}, []string{"docs/new.md"}},
+		{[]string{"*.txt"}, nil},
+	}
+	for _, tt := range tests {
+		var got []string
+		for _, d := range filterDiffs(renderDiffs, tt.patterns) {
+			got = append(got, d.NewPath)
+		}
+		assert.Equal(t, tt.want, got, "patterns: %v", tt.patterns)
+	}
+}
+
+func Test_wordDiff(t *testing.T) {
+	old, new := wordDiff(`fmt.Println("hello world")`, `fmt.Println("hello there")`)
+	assert.Equal(t, []segment{
+		{text: `fmt.Println("hello `},
+		{text: "world", changed: true},
+		{text: `")`},
+	}, old)
+	assert.Equal(t, []segment{
+		{text: `fmt.Println("hello `},
+		{text: "there", changed: true},
+		{text: `")`},
+	}, new)
+}
+
+func Test_renderer_stat(t *testing.T) {
+	out := &bytes.Buffer{}
+	r := &renderer{out: out, width: 80}
+	r.stat(renderDiffs)
+
+	assert.Equal(t, " main.go                    | 3 ++-\n"+
+		" docs/old.md => docs/new.md | 1 -\n"+
+		" 2 files changed, 2 insertions(+), 2 deletions(-)\n", out.String())
+}
+
+func Test_renderer_unified_wordDiff(t *testing.T) {
+	out := &bytes.Buffer{}
+	r := &renderer{out: out, wordDiff: true, width: 80}
+	r.unified(renderDiffs[:1])
+
+	assert.Equal(t, heredoc.Doc(`
+		main.go
+		@@ -1,4 +1,4 @@
+		 package main
+		-func greet() { fmt.Println("hello [-world-]") }
+		+func greet() { fmt.Println("hello {+there+}") }
+		+// greet prints a greeting
+		 func main() {}
+	`), out.String())
+}
+
+func Test_renderer_sideBySide(t *testing.T) {
+	out := &bytes.Buffer{}
+	r := &renderer{out: out, width: 63}
+	r.sideBySide(renderDiffs)
+
+	assert.Equal(t, heredoc.Doc(`
+		main.go
+		@@ -1,4 +1,4 @@
+		   1   package main            │    1   package main
+		   2 - func greet() { fmt.Prin │    2 + func greet() { fmt.Prin
+		                               │    3 + // greet prints a greet
+		   3   func main() {}          │    4   func main() {}
+		docs/old.md => docs/new.md
+		@@ -1 +0,0 @@
+		   1 - Remove me               │
+	`), out.String())
+}
+
+func Test_renderer_color(t *testing.T) {
+	out := &bytes.Buffer{}
+	r := &renderer{out: out, color: true, wordDiff: true, width: 80}
+	r.unified(renderDiffs[:1])
+
+	assert.Contains(t, out.String(), "\x1b[31m-\x1b[m\x1b[31mfunc greet() { fmt.Println(\"hello \x1b[m\x1b[1;37;41mworld\x1b[m")
+	assert.Contains(t, out.String(), "\x1b[32m+\x1b[m\x1b[32m// greet prints a greeting\x1b[m")
+
+	out.Reset()
+	r = &renderer{out: out, color: true, syntax: true, width: 80}
+	r.unified(renderDiffs[:1])
+	// chroma colors the keyword with the monokai style
+	assert.Contains(t, out.String(), "\x1b[32m+\x1b[m\x1b[38;5;")
+}
@@ -0,0 +1,121 @@
+package diff
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/commands/mr/mrutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/tableprinter"
+	"github.com/profclems/glab/pkg/utils"
+	"github.com/xanzy/go-gitlab"
+)
+
+// sortVersions orders the merge request diff versions from the oldest to the newest
+// so that version N is at index N-1, matching the numbering in the GitLab UI
+func sortVersions(versions []*gitlab.MergeRequestDiffVersion) []*gitlab.MergeRequestDiffVersion {
+	sorted := make([]*gitlab.MergeRequestDiffVersion, len(versions))
+	copy(sorted, versions)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].ID < sorted[j].ID
+	})
+	return sorted
+}
+
+func findVersion(versions []*gitlab.MergeRequestDiffVersion, number int) (*gitlab.MergeRequestDiffVersion, error) {
+	if number < 1 || number > len(versions) {
+		return nil, fmt.Errorf("version %d does not exist. The merge request has %s", number, utils.Pluralize(len(versions), "version"))
+	}
+	return versions[number-1], nil
+}
+
+func listVersions(opts *DiffOptions, mr *gitlab.MergeRequest, versions []*gitlab.MergeRequestDiffVersion) error {
+	c := opts.IO.Color()
+
+	table := tableprinter.NewTablePrinter()
+	for i := len(versions) - 1; i >= 0; i-- {
+		v := versions[i]
+		latest := ""
+		if i == len(versions)-1 {
+			latest = c.Green("latest")
+		}
+		created := ""
+		if v.CreatedAt != nil {
+			created = utils.TimeToPrettyTimeAgo(*v.CreatedAt)
+		}
+		table.AddRow(fmt.Sprintf("%d", i+1), mrutils.ShortSHA(v.HeadCommitSHA), c.Gray(created), latest)
+	}
+
+	fmt.Fprintf(opts.IO.StdOut, "Showing %s of !%d\n\n%s", utils.Pluralize(len(versions), "version"), mr.IID, table.String())
+	return nil
+}
+
+// interdiff returns the changes between the heads of two versions of a merge request
+func interdiff(client *gitlab.Client, repo glrepo.Interface, from, to *gitlab.MergeRequestDiffVersion) ([]*gitlab.Diff, error) {
+	compare, err := api.CompareBranches(client, repo.FullName(), &gitlab.CompareOptions{
+		From: gitlab.String(from.HeadCommitSHA),
+		To:   gitlab.String(to.HeadCommitSHA),
+		// compare the trees directly since the versions may not share a merge base after a rebase
+		Straight: gitlab.Bool(true),
+	})
+	if err != nil {
+		return nil, cmdutils.WrapError(err, "failed to compare the merge request versions")
+	}
+	return compare.Diffs, nil
+}
+
+// reviewedVersion finds the version which was the latest when the current user last
+// commented on or approved the merge request. Approving adds a system note, so both
+// are found in the notes of the merge request.
+func reviewedVersion(client *gitlab.Client, repo glrepo.Interface, mr *gitlab.MergeRequest, versions []*gitlab.MergeRequestDiffVersion) (int, error) {
+	user, err := api.CurrentUser(client)
+	if err != nil {
+		return 0, cmdutils.WrapError(err, "failed to get the current user")
+	}
+
+	notesOpts := &gitlab.ListMergeRequestNotesOptions{
+		OrderBy: gitlab.String("created_at"),
+		Sort:    gitlab.String("desc"),
+	}
+	notesOpts.PerPage = 100
+
+	var lastReview *gitlab.Note
+	for notesOpts.Page = 1; lastReview == nil; notesOpts.Page++ {
+		notes, err := api.ListMRNotes(client, repo.FullName(), mr.IID, notesOpts)
+		if err != nil {
+			return 0, cmdutils.WrapError(err, "failed to list the merge request notes")
+		}
+		for _, note := range notes {
+			if note.Author.ID == user.ID && note.CreatedAt != nil && isReview(note) {
+				lastReview = note
+				break
+			}
+		}
+		if len(notes) < notesOpts.PerPage {
+			break
+		}
+	}
+	if lastReview == nil {
+		return 0, errors.New("you have not reviewed or approved this merge request yet")
+	}
+
+	for i := len(versions) - 1; i >= 0; i-- {
+		if versions[i].CreatedAt != nil && !versions[i].CreatedAt.After(*lastReview.CreatedAt) {
+			return i + 1, nil
+		}
+	}
+	return 0, errors.New("could not find the version of the merge request you last reviewed")
+}
+
+// isReview reports whether the note is a comment or an approval. Other system notes,
+// like the ones for changing the description or the assignees, are not reviews.
+func isReview(note *gitlab.Note) bool {
+	if !note.System {
+		return true
+	}
+	return strings.HasPrefix(note.Body, "approved this merge request")
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/avast/retry-go"
 	"github.com/profclems/glab/api"
 	"github.com/profclems/glab/commands/mr/mrutils"
+	"github.com/profclems/glab/internal/glrepo"
 	"github.com/profclems/glab/pkg/prompt"
 
 	"github.com/profclems/glab/commands/cmdutils"
@@ -33,6 +34,7 @@ type MergeOpts struct {
 	RebaseBeforeMerge         bool
 	RemoveSourceBranch        bool
 	SkipPrompts               bool
+	MergeTrain                bool
 
 	SquashMessage      string
 	MergeCommitMessage string
@@ -55,6 +57,7 @@ func NewCmdMerge(f *cmdutils.Factory) *cobra.Command {
 			$ glab mr merge 235
 			$ glab mr accept 235
 			$ glab mr merge    # Finds open merge request from current branch
+			$ glab mr merge 235 --train
 		`),
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -69,6 +72,10 @@ func NewCmdMerge(f *cmdutils.Factory) *cobra.Command {
 				return &cmdutils.FlagError{Err: errors.New("--squash-message can only be used with --squash")}
 			}
 
+			if opts.MergeTrain && (opts.RebaseBeforeMerge || opts.RemoveSourceBranch || opts.MergeCommitMessage != "" || opts.SquashMessage != "") {
+				return &cmdutils.FlagError{Err: errors.New("--train cannot be used with --rebase, --remove-source-branch, --message or --squash-message")}
+			}
+
 			apiClient, err := f.HttpClient()
 			if err != nil {
 				return err
@@ -90,6 +97,10 @@ func NewCmdMerge(f *cmdutils.Factory) *cobra.Command {
 				return err
 			}
 
+			if opts.MergeTrain {
+				return addToMergeTrain(f, apiClient, repo, mr, opts)
+			}
+
 			if !cmd.Flags().Changed("when-pipeline-succeeds") &&
 				f.IO.IsOutputTTY() &&
 				mr.Pipeline != nil &&
@@ -254,10 +265,49 @@ func NewCmdMerge(f *cmdutils.Factory) *cobra.Command {
 	mrMergeCmd.Flags().BoolVarP(&opts.SquashBeforeMerge, "squash", "s", false, "Squash commits on merge")
 	mrMergeCmd.Flags().BoolVarP(&opts.RebaseBeforeMerge, "rebase", "r", false, "Rebase the commits onto the base branch")
 	mrMergeCmd.Flags().BoolVarP(&opts.SkipPrompts, "yes", "y", false, "Skip submission confirmation prompt")
+	mrMergeCmd.Flags().BoolVarP(&opts.MergeTrain, "train", "", false, "Add the merge request to the merge train of its target branch")
 
 	return mrMergeCmd
 }
 
+// addToMergeTrain queues the merge request on the merge train of its target branch, or
+// once its pipeline succeeds when --when-pipeline-succeeds is set
+func addToMergeTrain(f *cmdutils.Factory, apiClient *gitlab.Client, repo glrepo.Interface, mr *gitlab.MergeRequest, opts *MergeOpts) error {
+	c := f.IO.Color()
+	trainOpts := &api.AddMRToMergeTrainOptions{
+		WhenPipelineSucceeds: gitlab.Bool(opts.MergeWhenPipelineSucceeds),
+	}
+	if opts.SHA != "" {
+		trainOpts.SHA = gitlab.String(opts.SHA)
+	}
+	if opts.SquashBeforeMerge {
+		trainOpts.Squash = gitlab.Bool(true)
+	}
+
+	trains, err := api.AddMRToMergeTrain(apiClient, repo.FullName(), mr.IID, trainOpts)
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to add !%d to the merge train", mr.IID))
+	}
+
+	position, queued := 0, 0
+	for _, train := range mrutils.SortMergeTrain(trains) {
+		if train.TargetBranch != mr.TargetBranch {
+			continue
+		}
+		queued++
+		if train.MergeRequest != nil && train.MergeRequest.IID == mr.IID {
+			position = queued
+		}
+	}
+	if position == 0 {
+		fmt.Fprintf(f.IO.StdOut, "%s Will add !%d to the merge train of %s when the pipeline succeeds\n", c.GreenCheck(), mr.IID, mr.TargetBranch)
+	} else {
+		fmt.Fprintf(f.IO.StdOut, "%s Added !%d to the merge train of %s at position %d\n", c.GreenCheck(), mr.IID, mr.TargetBranch, position)
+	}
+	fmt.Fprintln(f.IO.StdOut, mrutils.DisplayMR(c, mr, f.IO.IsaTTY))
+	return nil
+}
+
 func mergeMethodSurvey() (MRMergeMethod, error) {
 	type mergeOption struct {
 		title  string
@@ -3,9 +3,13 @@ package mr
 import (
 	"github.com/MakeNowJust/heredoc"
 	"github.com/profclems/glab/commands/cmdutils"
+	mrApprovalRulesCmd "github.com/profclems/glab/commands/mr/approvalrules"
 	mrApproveCmd "github.com/profclems/glab/commands/mr/approve"
 	mrApproversCmd "github.com/profclems/glab/commands/mr/approvers"
+	mrBulkUpdateCmd "github.com/profclems/glab/commands/mr/bulkupdate"
+	mrCheckCmd "github.com/profclems/glab/commands/mr/check"
 	mrCheckoutCmd "github.com/profclems/glab/commands/mr/checkout"
+	mrCherryPickCmd "github.com/profclems/glab/commands/mr/cherrypick"
 	mrCloseCmd "github.com/profclems/glab/commands/mr/close"
 	mrCreateCmd "github.com/profclems/glab/commands/mr/create"
 	mrDeleteCmd "github.com/profclems/glab/commands/mr/delete"
@@ -17,12 +21,17 @@ import (
 	mrNoteCmd "github.com/profclems/glab/commands/mr/note"
 	mrRebaseCmd "github.com/profclems/glab/commands/mr/rebase"
 	mrReopenCmd "github.com/profclems/glab/commands/mr/reopen"
+	mrResolveConflictsCmd "github.com/profclems/glab/commands/mr/resolveconflicts"
+	mrRevertCmd "github.com/profclems/glab/commands/mr/revert"
 	mrRevokeCmd "github.com/profclems/glab/commands/mr/revoke"
 	mrSubscribeCmd "github.com/profclems/glab/commands/mr/subscribe"
 	mrTodoCmd "github.com/profclems/glab/commands/mr/todo"
+	mrTrainCmd "github.com/profclems/glab/commands/mr/train"
 	mrUnsubscribeCmd "github.com/profclems/glab/commands/mr/unsubscribe"
 	mrUpdateCmd "github.com/profclems/glab/commands/mr/update"
 	mrViewCmd "github.com/profclems/glab/commands/mr/view"
+	mrWorktreeCmd "github.com/profclems/glab/commands/mr/worktree"
+	"github.com/profclems/glab/commands/timetracking"
 
 	"github.com/spf13/cobra"
 )
@@ -48,9 +57,13 @@ func NewCmdMR(f *cmdutils.Factory) *cobra.Command {
 
 	cmdutils.EnableRepoOverride(mrCmd, f)
 
+	mrCmd.AddCommand(mrApprovalRulesCmd.NewCmdApprovalRules(f))
 	mrCmd.AddCommand(mrApproveCmd.NewCmdApprove(f))
 	mrCmd.AddCommand(mrApproversCmd.NewCmdApprovers(f))
+	mrCmd.AddCommand(mrBulkUpdateCmd.NewCmdBulkUpdate(f, nil))
+	mrCmd.AddCommand(mrCheckCmd.NewCmdCheck(f, nil))
 	mrCmd.AddCommand(mrCheckoutCmd.NewCmdCheckout(f))
+	mrCmd.AddCommand(mrCherryPickCmd.NewCmdCherryPick(f, nil))
 	mrCmd.AddCommand(mrCloseCmd.NewCmdClose(f))
 	mrCmd.AddCommand(mrCreateCmd.NewCmdCreate(f, nil))
 	mrCmd.AddCommand(mrDeleteCmd.NewCmdDelete(f))
@@ -62,12 +75,17 @@ func NewCmdMR(f *cmdutils.Factory) *cobra.Command {
 	mrCmd.AddCommand(mrNoteCmd.NewCmdNote(f))
 	mrCmd.AddCommand(mrRebaseCmd.NewCmdRebase(f))
 	mrCmd.AddCommand(mrReopenCmd.NewCmdReopen(f))
+	mrCmd.AddCommand(mrResolveConflictsCmd.NewCmdResolveConflicts(f, nil))
+	mrCmd.AddCommand(mrRevertCmd.NewCmdRevert(f, nil))
 	mrCmd.AddCommand(mrRevokeCmd.NewCmdRevoke(f))
 	mrCmd.AddCommand(mrSubscribeCmd.NewCmdSubscribe(f))
 	mrCmd.AddCommand(mrUnsubscribeCmd.NewCmdUnsubscribe(f))
+	mrCmd.AddCommand(timetracking.NewCmdTime(f, timetracking.MergeRequest))
 	mrCmd.AddCommand(mrTodoCmd.NewCmdTodo(f))
+	mrCmd.AddCommand(mrTrainCmd.NewCmdTrain(f))
 	mrCmd.AddCommand(mrUpdateCmd.NewCmdUpdate(f))
 	mrCmd.AddCommand(mrViewCmd.NewCmdView(f))
+	mrCmd.AddCommand(mrWorktreeCmd.NewCmdWorktree(f))
 
 	return mrCmd
 }
@@ -0,0 +1,182 @@
+package mrutils
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/profclems/glab/pkg/prompt"
+	"github.com/profclems/glab/pkg/utils"
+	"github.com/xanzy/go-gitlab"
+)
+
+// Backport applies commits of a merge request to another branch on GitLab, either directly
+// in that branch or in a new branch with a merge request opened against it. It is shared by
+// `glab mr cherry-pick` and `glab mr revert`.
+type Backport struct {
+	Client *gitlab.Client
+	Repo   glrepo.Interface
+	MR     *gitlab.MergeRequest
+
+	// SHAs are the commits to apply, in the order they are applied
+	SHAs []string
+	// Target is the branch the changes are made in
+	Target string
+	// CreateMR applies the commits in the new branch SourceBranch, created from Target,
+	// and opens a merge request against Target
+	CreateMR     bool
+	SourceBranch string
+	// Yes skips the confirmation prompt before the commits are applied directly in Target
+	Yes bool
+
+	// Verb and Past name how a commit is applied, such as "cherry-pick" and
+	// "cherry-picked", and Preposition joins them to the branch, such as "into"
+	Verb        string
+	Past        string
+	Preposition string
+	// Title is the title of the merge request, and Reference the note added after each
+	// commit in its description, with a %s for the SHA of the original commit
+	Title     string
+	Reference string
+	// Apply applies a single commit in the branch
+	Apply func(sha, branch string) (*gitlab.Commit, error)
+}
+
+// Run asks for confirmation when the commits are applied directly in the target branch,
+// then applies them and opens the merge request if needed
+func (b *Backport) Run(streams *iostreams.IOStreams) error {
+	if !b.CreateMR && !b.Yes && streams.PromptEnabled() {
+		question := fmt.Sprintf("%s %s of !%d directly %s %s?", capitalize(b.Verb), utils.Pluralize(len(b.SHAs), "commit"), b.MR.IID, b.Preposition, b.Target)
+		if err := prompt.Confirm(&b.Yes, question, false); err != nil {
+			return cmdutils.WrapError(err, "could not prompt")
+		}
+	}
+	if !b.CreateMR && !b.Yes {
+		return cmdutils.CancelError()
+	}
+
+	branch := b.Target
+	if b.CreateMR {
+		branch = b.SourceBranch
+		_, err := api.CreateBranch(b.Client, b.Repo.FullName(), &gitlab.CreateBranchOptions{
+			Branch: gitlab.String(branch),
+			Ref:    gitlab.String(b.Target),
+		})
+		if err != nil {
+			return cmdutils.WrapError(err, fmt.Sprintf("failed to create the branch %s", branch))
+		}
+	}
+
+	c := streams.Color()
+	var applied []*gitlab.Commit
+	for _, sha := range b.SHAs {
+		commit, err := b.Apply(sha, branch)
+		if err != nil {
+			if b.CreateMR {
+				_ = api.DeleteBranch(b.Client, b.Repo.FullName(), branch)
+			} else {
+				PrintPartialBackport(streams, b.Past, branch, b.SHAs, applied)
+			}
+			return cmdutils.WrapError(err, fmt.Sprintf("failed to %s %s %s %s", b.Verb, ShortSHA(sha), b.Preposition, branch))
+		}
+		applied = append(applied, commit)
+		fmt.Fprintf(streams.StdOut, "%s %s %s %s %s as %s\n", c.GreenCheck(), capitalize(b.Past), ShortSHA(sha), b.Preposition, branch, commit.ShortID)
+	}
+
+	if !b.CreateMR {
+		return nil
+	}
+
+	var description strings.Builder
+	fmt.Fprintf(&description, "%ss !%d %s `%s`.\n\n", capitalize(b.Verb), b.MR.IID, b.Preposition, b.Target)
+	for i, commit := range applied {
+		fmt.Fprintf(&description, "- %s (%s)\n", commit.Title, fmt.Sprintf(b.Reference, b.SHAs[i]))
+	}
+	newMR, err := api.CreateMR(b.Client, b.Repo.FullName(), &gitlab.CreateMergeRequestOptions{
+		Title:              gitlab.String(b.Title),
+		Description:        gitlab.String(description.String()),
+		SourceBranch:       gitlab.String(branch),
+		TargetBranch:       gitlab.String(b.Target),
+		RemoveSourceBranch: gitlab.Bool(true),
+	})
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to create a merge request from %s", branch))
+	}
+
+	fmt.Fprintln(streams.StdOut, DisplayMR(c, newMR, streams.IsaTTY))
+	return nil
+}
+
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+// BackportCommits returns the SHAs of the commits which hold the changes of a merge request,
+// oldest first, for cherry-picking or reverting them. That is the merge or squash commit
+// of a merged merge request, or each of its commits when eachCommit is set or the merge
+// request was merged with a fast-forward.
+func BackportCommits(client *gitlab.Client, repo glrepo.Interface, mr *gitlab.MergeRequest, eachCommit bool) ([]string, error) {
+	if !eachCommit {
+		if mr.State != "merged" {
+			return nil, fmt.Errorf("!%d has not been merged. Use --each-commit to use its commits instead", mr.IID)
+		}
+		if mr.MergeCommitSHA != "" {
+			return []string{mr.MergeCommitSHA}, nil
+		}
+		if mr.SquashCommitSHA != "" {
+			return []string{mr.SquashCommitSHA}, nil
+		}
+	}
+
+	opts := &gitlab.GetMergeRequestCommitsOptions{PerPage: 100}
+	var commits []*gitlab.Commit
+	for opts.Page = 1; ; opts.Page++ {
+		page, err := api.GetMRCommits(client, repo.FullName(), mr.IID, opts)
+		if err != nil {
+			return nil, cmdutils.WrapError(err, fmt.Sprintf("failed to get the commits of !%d", mr.IID))
+		}
+		commits = append(commits, page...)
+		if len(page) < opts.PerPage {
+			break
+		}
+	}
+	if len(commits) == 0 {
+		return nil, fmt.Errorf("!%d has no commits", mr.IID)
+	}
+
+	// the API lists the newest commit first
+	shas := make([]string, 0, len(commits))
+	for i := len(commits) - 1; i >= 0; i-- {
+		shas = append(shas, commits[i].ID)
+	}
+	return shas, nil
+}
+
+// PrintPartialBackport warns that applying the commits to the branch stopped part way,
+// listing the commits which were applied, so that the branch can be fixed up by hand.
+// verb is how the commits were applied, such as "reverted".
+func PrintPartialBackport(streams *iostreams.IOStreams, verb, branch string, shas []string, applied []*gitlab.Commit) {
+	if len(applied) == 0 {
+		return
+	}
+	done := make([]string, len(applied))
+	for i, commit := range applied {
+		done[i] = fmt.Sprintf("%s as %s", ShortSHA(shas[i]), commit.ShortID)
+	}
+	c := streams.Color()
+	fmt.Fprintf(streams.StdErr, "%s %s was left partially %s. Already %s: %s\n", c.WarnIcon(), branch, verb, verb, strings.Join(done, ", "))
+}
+
+// ShortSHA returns the abbreviated form of a commit SHA
+func ShortSHA(sha string) string {
+	if len(sha) > 8 {
+		return sha[:8]
+	}
+	return sha
+}
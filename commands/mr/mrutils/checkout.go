@@ -0,0 +1,75 @@
+package mrutils
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/profclems/glab/pkg/git"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/xanzy/go-gitlab"
+)
+
+// BranchMissing is the state of a local branch which did not exist before
+// UpdateLocalBranch created it
+const BranchMissing = "missing"
+
+// UpdateLocalBranch points the local branch at the head of the merge request, which must
+// have been fetched already. A missing branch is created and a branch which is behind the
+// merge request is fast-forwarded, with a merge when it is the current branch. A branch
+// with commits which are not in the merge request is left alone. The state the branch was
+// in is returned.
+func UpdateLocalBranch(mr *gitlab.MergeRequest, branch, current string, cmdOut, cmdErr io.Writer) (string, error) {
+	if !git.HasLocalBranch(branch) {
+		return BranchMissing, git.SetBranchHead(branch, mr.SHA)
+	}
+
+	local, err := git.BranchHead(branch)
+	if err != nil {
+		return "", err
+	}
+	sync, err := BranchSync(local, mr.SHA)
+	if err != nil {
+		return "", err
+	}
+	if sync == BranchBehind {
+		if current == branch {
+			err = git.Merge(mr.SHA, cmdOut, cmdErr)
+		} else {
+			err = git.SetBranchHead(branch, mr.SHA)
+		}
+	}
+	return sync, err
+}
+
+// CheckoutMR fetches the head of the merge request and checks it out in the local branch,
+// which is created or fast-forwarded if needed. Local commits which are not in the merge
+// request are never dropped. The branch which was checked out before is returned, or an
+// empty string when the HEAD was detached, along with the state the local branch was in,
+// such as BranchMissing when it was created.
+func CheckoutMR(streams *iostreams.IOStreams, mr *gitlab.MergeRequest, remote, branch string) (string, string, error) {
+	mrRef := fmt.Sprintf("refs/merge-requests/%d/head", mr.IID)
+	if err := git.Fetch(remote, []string{mrRef}, streams.StdErr, streams.StdErr); err != nil {
+		return "", "", err
+	}
+
+	current, err := git.CurrentBranch()
+	if err != nil && !errors.Is(err, git.ErrNotOnAnyBranch) {
+		return "", "", err
+	}
+
+	sync, err := UpdateLocalBranch(mr, branch, current, streams.StdErr, streams.StdErr)
+	if err != nil {
+		return "", "", err
+	}
+	if sync == BranchAhead || sync == BranchDiverged {
+		return "", "", fmt.Errorf("the local branch %s has commits which are not in !%d. Use --branch to check out the merge request as another branch", branch, mr.IID)
+	}
+
+	if current != branch {
+		if err := git.CheckoutBranch(branch); err != nil {
+			return "", "", err
+		}
+	}
+	return current, sync, nil
+}
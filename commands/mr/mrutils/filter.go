@@ -0,0 +1,142 @@
+package mrutils
+
+import (
+	"errors"
+
+	"github.com/profclems/glab/api"
+
+	"github.com/spf13/pflag"
+	"github.com/xanzy/go-gitlab"
+)
+
+// MRFilter selects merge requests with a subset of the filters of "glab mr list"
+type MRFilter struct {
+	Labels       []string
+	NotLabels    []string
+	Milestone    string
+	Author       string
+	Assignee     []string
+	Search       string
+	SourceBranch string
+	TargetBranch string
+	Draft        bool
+	Closed       bool
+	Merged       bool
+	All          bool
+}
+
+// AddFlags registers the filter flags
+func (f *MRFilter) AddFlags(fs *pflag.FlagSet) {
+	fs.StringSliceVarP(&f.Labels, "label", "l", []string{}, "Select merge requests with label <name>")
+	fs.StringSliceVar(&f.NotLabels, "not-label", []string{}, "Select merge requests without label <name>")
+	fs.StringVarP(&f.Milestone, "milestone", "m", "", "Select merge requests in milestone <title>")
+	fs.StringVar(&f.Author, "author", "", "Select merge requests by author <username>")
+	fs.StringSliceVarP(&f.Assignee, "assignee", "a", []string{}, "Select merge requests assigned to users")
+	fs.StringVar(&f.Search, "search", "", "Select merge requests with <string> in the title or description")
+	fs.StringVarP(&f.SourceBranch, "source-branch", "s", "", "Select merge requests from source branch <name>")
+	fs.StringVarP(&f.TargetBranch, "target-branch", "t", "", "Select merge requests into target branch <name>")
+	fs.BoolVarP(&f.Draft, "draft", "d", false, "Select draft merge requests")
+	fs.BoolVarP(&f.Closed, "closed", "c", false, "Select closed merge requests instead of open ones")
+	fs.BoolVarP(&f.Merged, "merged", "M", false, "Select merged merge requests instead of open ones")
+	fs.BoolVarP(&f.All, "all", "A", false, "Select merge requests in any state")
+}
+
+// Validate checks that the filters do not conflict
+func (f *MRFilter) Validate() error {
+	if (f.Closed && f.Merged) || (f.Closed && f.All) || (f.Merged && f.All) {
+		return errors.New("--closed, --merged and --all are mutually exclusive")
+	}
+	return nil
+}
+
+// IsSet reports whether any filter other than the state is set
+func (f *MRFilter) IsSet() bool {
+	return len(f.Labels) > 0 || len(f.NotLabels) > 0 || f.Milestone != "" ||
+		f.Author != "" || len(f.Assignee) > 0 || f.Search != "" ||
+		f.SourceBranch != "" || f.TargetBranch != "" || f.Draft
+}
+
+// ListFilteredMRs lists every merge request of the project matching the filter.
+// Merge requests assigned to any of the assignees are selected.
+func ListFilteredMRs(client *gitlab.Client, projectID interface{}, f *MRFilter) ([]*gitlab.MergeRequest, error) {
+	l := &gitlab.ListProjectMergeRequestsOptions{
+		State: gitlab.String("opened"),
+	}
+	switch {
+	case f.Closed:
+		l.State = gitlab.String("closed")
+	case f.Merged:
+		l.State = gitlab.String("merged")
+	case f.All:
+		l.State = gitlab.String("all")
+	}
+	if len(f.Labels) > 0 {
+		l.Labels = f.Labels
+	}
+	if len(f.NotLabels) > 0 {
+		l.NotLabels = f.NotLabels
+	}
+	if f.Milestone != "" {
+		l.Milestone = gitlab.String(f.Milestone)
+	}
+	if f.Search != "" {
+		l.Search = gitlab.String(f.Search)
+	}
+	if f.SourceBranch != "" {
+		l.SourceBranch = gitlab.String(f.SourceBranch)
+	}
+	if f.TargetBranch != "" {
+		l.TargetBranch = gitlab.String(f.TargetBranch)
+	}
+	if f.Draft {
+		l.WIP = gitlab.String("yes")
+	}
+	if f.Author != "" {
+		u, err := api.UserByName(client, f.Author)
+		if err != nil {
+			return nil, err
+		}
+		l.AuthorID = gitlab.Int(u.ID)
+	}
+
+	if len(f.Assignee) == 0 {
+		return listAllMRs(client, projectID, l)
+	}
+
+	users, err := api.UsersByNames(client, f.Assignee)
+	if err != nil {
+		return nil, err
+	}
+	var mergeRequests []*gitlab.MergeRequest
+	seen := map[int]bool{}
+	for _, user := range users {
+		l.AssigneeID = gitlab.Int(user.ID)
+		assigned, err := listAllMRs(client, projectID, l)
+		if err != nil {
+			return nil, err
+		}
+		for _, mr := range assigned {
+			if !seen[mr.IID] {
+				seen[mr.IID] = true
+				mergeRequests = append(mergeRequests, mr)
+			}
+		}
+	}
+	return mergeRequests, nil
+}
+
+func listAllMRs(client *gitlab.Client, projectID interface{}, l *gitlab.ListProjectMergeRequestsOptions) ([]*gitlab.MergeRequest, error) {
+	var mergeRequests []*gitlab.MergeRequest
+	l.PerPage = 100
+	for l.Page = 1; ; l.Page++ {
+		page, err := api.ListMRs(client, projectID, l)
+		if err != nil {
+			return nil, err
+		}
+		mergeRequests = append(mergeRequests, page...)
+		if len(page) < l.PerPage {
+			break
+		}
+	}
+	return mergeRequests, nil
+}
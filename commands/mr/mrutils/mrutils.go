@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/profclems/glab/pkg/iostreams"
 
@@ -39,51 +40,161 @@ type MRCheckErrOptions struct {
 	Unsubscribed bool
 	// MergePrivilege : check and return err if user is not authorized to merge
 	MergePrivilege bool
+	// Pipeline : check and return err if the latest pipeline has not succeeded, whether or not it is required.
+	// It covers PipelineStatus, which is skipped when both are set
+	Pipeline bool
+	// Discussions : check and return err if there are unresolved threads which block merging
+	Discussions bool
+	// MergeStatus : check and return err if GitLab reports that the merge request cannot be merged
+	MergeStatus bool
+	// Approvals : check and return err if approvals are still required. The approvals are not part
+	// of the merge request, so the check only runs when they are set
+	Approvals *gitlab.MergeRequestApprovals
 }
 
-// MRCheckErrors checks and return merge request errors specified in MRCheckErrOptions{}
-func MRCheckErrors(mr *gitlab.MergeRequest, err MRCheckErrOptions) error {
-	if mr.WorkInProgress && err.WorkInProgress {
-		return fmt.Errorf("this merge request is still a work in progress. Run `glab mr update %d --ready` to mark it as ready for review", mr.IID)
-	}
+// MRCheck is the result of one of the checks of MRCheckErrOptions{}
+type MRCheck struct {
+	// Name describes what the check expects, e.g. "No merge conflicts"
+	Name string
+	// Err is the reason the check failed, or nil if it passed
+	Err error
+}
 
-	if mr.MergeWhenPipelineSucceeds && err.PipelineStatus && mr.Pipeline != nil {
-		if mr.Pipeline.Status != "success" {
-			return fmt.Errorf("pipeline for this merge request has failed. Pipeline is required to succeed before merging")
+// MRChecklist runs every check specified in MRCheckErrOptions{}, in the order MRCheckErrors runs them
+func MRChecklist(mr *gitlab.MergeRequest, opts MRCheckErrOptions) []*MRCheck {
+	var checks []*MRCheck
+	add := func(name string, failed bool, err error) {
+		check := &MRCheck{Name: name}
+		if failed {
+			check.Err = err
 		}
+		checks = append(checks, check)
+	}
+
+	if opts.WorkInProgress {
+		add("Ready for review", mr.WorkInProgress, fmt.Errorf("this merge request is still a work in progress. Run `glab mr update %d --ready` to mark it as ready for review", mr.IID))
+	}
+
+	if opts.PipelineStatus && !opts.Pipeline {
+		add("Pipeline succeeded", mr.MergeWhenPipelineSucceeds && mr.Pipeline != nil && mr.Pipeline.Status != "success",
+			fmt.Errorf("pipeline for this merge request has failed. Pipeline is required to succeed before merging"))
+	}
+
+	if opts.Pipeline {
+		err := pipelineError(mr)
+		add("Pipeline succeeded", err != nil, err)
+	}
+
+	if opts.Merged {
+		add("Not merged", mr.State == "merged", fmt.Errorf("this merge request has already been merged"))
+	}
+
+	if opts.Closed {
+		add("Not closed", mr.State == "closed", fmt.Errorf("this merge request has been closed"))
 	}
 
-	if mr.State == "merged" && err.Merged {
-		return fmt.Errorf("this merge request has already been merged")
+	if opts.Opened {
+		add("Not open", mr.State == "opened", fmt.Errorf("this merge request is already open"))
 	}
 
-	if mr.State == "closed" && err.Closed {
-		return fmt.Errorf("this merge request has been closed")
+	if opts.Subscribed {
+		add("Not subscribed", mr.Subscribed, fmt.Errorf("you are already subscribed to this merge request"))
 	}
 
-	if mr.State == "opened" && err.Opened {
-		return fmt.Errorf("this merge request is already open")
+	if opts.Unsubscribed {
+		add("Subscribed", !mr.Subscribed, fmt.Errorf("you are not subscribed to this merge request"))
 	}
 
-	if mr.Subscribed && err.Subscribed {
-		return fmt.Errorf("you are already subscribed to this merge request")
+	if opts.MergePrivilege {
+		add("Allowed to merge", !mr.User.CanMerge, fmt.Errorf("you do not have enough priviledges to merge this merge request"))
 	}
 
-	if !mr.Subscribed && err.Unsubscribed {
-		return fmt.Errorf("you are not subscribed to this merge request")
+	if opts.Conflict {
+		add("No merge conflicts", mr.HasConflicts, fmt.Errorf("there are merge conflicts. Resolve conflicts and try again or merge locally"))
 	}
 
-	if err.MergePrivilege && !mr.User.CanMerge {
-		return fmt.Errorf("you do not have enough priviledges to merge this merge request")
+	if opts.Discussions {
+		add("Threads resolved", !mr.BlockingDiscussionsResolved, fmt.Errorf("there are unresolved threads. Resolve them before merging"))
 	}
 
-	if err.Conflict && mr.HasConflicts {
-		return fmt.Errorf("there are merge conflicts. Resolve conflicts and try again or merge locally")
+	if opts.Approvals != nil {
+		err := approvalsError(opts.Approvals)
+		add("Approved", err != nil, err)
+	}
+
+	if opts.MergeStatus {
+		err := mergeStatusError(mr)
+		add("Mergeable", err != nil, err)
+	}
+
+	return checks
+}
+
+// MRCheckErrors checks and return merge request errors specified in MRCheckErrOptions{}
+func MRCheckErrors(mr *gitlab.MergeRequest, err MRCheckErrOptions) error {
+	for _, check := range MRChecklist(mr, err) {
+		if check.Err != nil {
+			return check.Err
+		}
 	}
 
 	return nil
 }
 
+// pipelineError returns why the head pipeline of the merge request blocks merging.
+// Merge requests without a pipeline are not blocked.
+func pipelineError(mr *gitlab.MergeRequest) error {
+	var status string
+	if mr.HeadPipeline != nil {
+		status = mr.HeadPipeline.Status
+	} else if mr.Pipeline != nil {
+		status = mr.Pipeline.Status
+	}
+
+	switch status {
+	case "", "success", "skipped":
+		return nil
+	case "created", "waiting_for_resource", "preparing", "pending", "running", "scheduled":
+		return fmt.Errorf("pipeline for this merge request has not finished yet (%s)", status)
+	case "manual":
+		return fmt.Errorf("pipeline for this merge request is waiting for a manual action")
+	default:
+		return fmt.Errorf("pipeline for this merge request did not succeed (%s)", status)
+	}
+}
+
+func mergeStatusError(mr *gitlab.MergeRequest) error {
+	switch mr.MergeStatus {
+	case "unchecked", "checking":
+		return fmt.Errorf("GitLab has not finished checking if this merge request can be merged. Try again in a moment")
+	case "cannot_be_merged", "cannot_be_merged_recheck":
+		return fmt.Errorf("GitLab reports that this merge request cannot be merged")
+	}
+	return nil
+}
+
+func approvalsError(approvals *gitlab.MergeRequestApprovals) error {
+	if approvals.Approved {
+		return nil
+	}
+
+	var rules []string
+	for _, rule := range approvals.ApprovalRulesLeft {
+		rules = append(rules, rule.Name)
+	}
+
+	msg := "this merge request has not been approved"
+	if approvals.ApprovalsLeft == 1 {
+		msg = "this merge request needs 1 more approval"
+	} else if approvals.ApprovalsLeft > 1 {
+		msg = fmt.Sprintf("this merge request needs %d more approvals", approvals.ApprovalsLeft)
+	}
+	if len(rules) > 0 {
+		msg += fmt.Sprintf(" (rules: %s)", strings.Join(rules, ", "))
+	}
+	return errors.New(msg)
+}
+
 func DisplayMR(c *iostreams.ColorPalette, mr *gitlab.MergeRequest, isTTY bool) string {
 	mrID := MRState(c, mr)
 	if isTTY {
@@ -290,6 +401,36 @@ var getMRForBranch = func(apiClient *gitlab.Client, baseRepo glrepo.Interface, a
 	return mrMap[pickedMR], nil
 }
 
+// mrPollInterval is the time to wait before getting a merge request again in WaitForMR
+var mrPollInterval = time.Second
+
+// mrWaitAttempts is the number of times WaitForMR gets a merge request before it gives up
+var mrWaitAttempts = 120
+
+// ErrWaitTimeout is returned by WaitForMR when GitLab is still not done with the merge request
+var ErrWaitTimeout = errors.New("timed out waiting for GitLab to update the merge request")
+
+// WaitForMR gets the merge request until done returns true for it, to follow the rebases and
+// mergeability checks which GitLab runs in the background. It returns ErrWaitTimeout, along
+// with the last merge request it got, when done is still false after mrWaitAttempts attempts.
+func WaitForMR(apiClient *gitlab.Client, repo glrepo.Interface, iid int, opts *gitlab.GetMergeRequestsOptions, done func(*gitlab.MergeRequest) bool) (*gitlab.MergeRequest, error) {
+	var mr *gitlab.MergeRequest
+	for i := 0; i < mrWaitAttempts; i++ {
+		if i > 0 {
+			time.Sleep(mrPollInterval)
+		}
+		var err error
+		mr, err = api.GetMR(apiClient, repo.FullName(), iid, opts)
+		if err != nil {
+			return nil, err
+		}
+		if done(mr) {
+			return mr, nil
+		}
+	}
+	return mr, ErrWaitTimeout
+}
+
 func RebaseMR(ios *iostreams.IOStreams, apiClient *gitlab.Client, repo glrepo.Interface, mr *gitlab.MergeRequest) error {
 	ios.StartSpinner("Sending rebase request...")
 	err := api.RebaseMR(apiClient, repo.FullName(), mr.IID)
@@ -303,23 +444,20 @@ func RebaseMR(ios *iostreams.IOStreams, apiClient *gitlab.Client, repo glrepo.In
 	ios.StartSpinner("Checking rebase status...")
 	errorMSG := ""
 	i := 0
-	for {
-		mr, err := api.GetMR(apiClient, repo.FullName(), mr.IID, opts)
-		if err != nil {
-			errorMSG = err.Error()
-			break
-		}
+	mr, err = WaitForMR(apiClient, repo, mr.IID, opts, func(mr *gitlab.MergeRequest) bool {
 		if i == 0 {
 			ios.StopSpinner("")
 			ios.StartSpinner("Rebase in progress...")
 		}
-		if !mr.RebaseInProgress {
-			if mr.MergeError != "" && mr.MergeError != "null" {
-				errorMSG = mr.MergeError
-			}
-			break
-		}
 		i++
+		return !mr.RebaseInProgress
+	})
+	if errors.Is(err, ErrWaitTimeout) {
+		errorMSG = fmt.Sprintf("the rebase of !%d is still in progress. Run `glab mr view %d` to follow it", mr.IID, mr.IID)
+	} else if err != nil {
+		errorMSG = err.Error()
+	} else if mr.MergeError != "" && mr.MergeError != "null" {
+		errorMSG = mr.MergeError
 	}
 	ios.StopSpinner("")
 	if errorMSG != "" {
@@ -191,6 +191,70 @@ func Test_MRCheckErrors(t *testing.T) {
 			},
 			output: "there are merge conflicts. Resolve conflicts and try again or merge locally",
 		},
+		{
+			name: "running-pipeline",
+			mr: &gitlab.MergeRequest{
+				IID: 1,
+				HeadPipeline: &gitlab.Pipeline{
+					Status: "running",
+				},
+			},
+			errOpts: MRCheckErrOptions{
+				Pipeline: true,
+			},
+			output: "pipeline for this merge request has not finished yet (running)",
+		},
+		{
+			name: "failed-pipeline",
+			mr: &gitlab.MergeRequest{
+				IID: 1,
+				Pipeline: &gitlab.PipelineInfo{
+					Status: "failed",
+				},
+			},
+			errOpts: MRCheckErrOptions{
+				Pipeline: true,
+			},
+			output: "pipeline for this merge request did not succeed (failed)",
+		},
+		{
+			name: "discussions",
+			mr: &gitlab.MergeRequest{
+				IID:                         1,
+				BlockingDiscussionsResolved: false,
+			},
+			errOpts: MRCheckErrOptions{
+				Discussions: true,
+			},
+			output: "there are unresolved threads. Resolve them before merging",
+		},
+		{
+			name: "merge-status",
+			mr: &gitlab.MergeRequest{
+				IID:         1,
+				MergeStatus: "cannot_be_merged",
+			},
+			errOpts: MRCheckErrOptions{
+				MergeStatus: true,
+			},
+			output: "GitLab reports that this merge request cannot be merged",
+		},
+		{
+			name: "approvals",
+			mr: &gitlab.MergeRequest{
+				IID: 1,
+			},
+			errOpts: MRCheckErrOptions{
+				Approvals: &gitlab.MergeRequestApprovals{
+					ApprovalsLeft: 2,
+					ApprovalRulesLeft: []*gitlab.MergeRequestApprovalRule{
+						{Name: "Backend"},
+						{Name: "Security"},
+					},
+				},
+			},
+			output: "this merge request needs 2 more approvals (rules: Backend, Security)",
+		},
 	}
 	for _, tC := range testCases {
 		t.Run(tC.name, func(t *testing.T) {
@@ -205,6 +269,74 @@ func Test_MRCheckErrors(t *testing.T) {
 	})
 }
 
+func Test_MRChecklist(t *testing.T) {
+	mr := &gitlab.MergeRequest{
+		IID:                         1,
+		HasConflicts:                true,
+		BlockingDiscussionsResolved: true,
+		MergeStatus:                 "cannot_be_merged",
+		HeadPipeline: &gitlab.Pipeline{
+			Status: "success",
+		},
+	}
+
+	checks := MRChecklist(mr, MRCheckErrOptions{
+		WorkInProgress: true,
+		Pipeline:       true,
+		Conflict:       true,
+		Discussions:    true,
+		Approvals:      &gitlab.MergeRequestApprovals{Approved: true},
+		MergeStatus:    true,
+	})
+
+	var names []string
+	var failed []string
+	for _, check := range checks {
+		names = append(names, check.Name)
+		if check.Err != nil {
+			failed = append(failed, check.Name)
+		}
+	}
+	assert.Equal(t, []string{"Ready for review", "Pipeline succeeded", "No merge conflicts", "Threads resolved", "Approved", "Mergeable"}, names)
+	assert.Equal(t, []string{"No merge conflicts", "Mergeable"}, failed)
+	assert.EqualError(t, MRCheckErrors(mr, MRCheckErrOptions{Conflict: true, MergeStatus: true}), "there are merge conflicts. Resolve conflicts and try again or merge locally")
+
+	checks = MRChecklist(mr, MRCheckErrOptions{PipelineStatus: true, Pipeline: true})
+	assert.Len(t, checks, 1)
+}
+
+func Test_WaitForMR(t *testing.T) {
+	oldGetMR, oldInterval, oldAttempts := api.GetMR, mrPollInterval, mrWaitAttempts
+	defer func() {
+		api.GetMR, mrPollInterval, mrWaitAttempts = oldGetMR, oldInterval, oldAttempts
+	}()
+	mrPollInterval = 0
+	mrWaitAttempts = 3
+
+	calls := 0
+	api.GetMR = func(_ *gitlab.Client, _ interface{}, _ int, _ *gitlab.GetMergeRequestsOptions) (*gitlab.MergeRequest, error) {
+		calls++
+		return &gitlab.MergeRequest{IID: 1, MergeStatus: "checking"}, nil
+	}
+	repo := glrepo.New("OWNER", "REPO")
+
+	t.Run("done", func(t *testing.T) {
+		calls = 0
+		mr, err := WaitForMR(nil, repo, 1, nil, func(*gitlab.MergeRequest) bool { return calls == 2 })
+		assert.NoError(t, err)
+		assert.Equal(t, 1, mr.IID)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		calls = 0
+		mr, err := WaitForMR(nil, repo, 1, nil, func(mr *gitlab.MergeRequest) bool { return mr.MergeStatus != "checking" })
+		assert.ErrorIs(t, err, ErrWaitTimeout)
+		assert.Equal(t, "checking", mr.MergeStatus)
+		assert.Equal(t, 3, calls)
+	})
+}
+
 func Test_getMRForBranchFails(t *testing.T) {
 	baseRepo := glrepo.NewWithHost("foo", "bar", "gitlab.com")
 
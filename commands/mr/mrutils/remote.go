@@ -0,0 +1,22 @@
+package mrutils
+
+import (
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/pkg/git"
+	"github.com/xanzy/go-gitlab"
+)
+
+// AuthorRemote returns the git remote of the source project of the merge request, which is
+// named after its author. The remote is added with the SSH URL of the project if needed.
+func AuthorRemote(apiClient *gitlab.Client, mr *gitlab.MergeRequest) (string, error) {
+	if _, err := git.Config("remote." + mr.Author.Username + ".url"); err != nil {
+		mrProject, err := api.GetProject(apiClient, mr.SourceProjectID)
+		if err != nil {
+			return "", err
+		}
+		if _, err := git.AddRemote(mr.Author.Username, mrProject.SSHURLToRepo); err != nil {
+			return "", err
+		}
+	}
+	return mr.Author.Username, nil
+}
@@ -0,0 +1,20 @@
+package mrutils
+
+import (
+	"sort"
+
+	"github.com/profclems/glab/api"
+)
+
+// SortMergeTrain orders merge train entries by target branch and then by their place
+// in the queue, the next one to be merged first
+func SortMergeTrain(trains []*api.MergeTrain) []*api.MergeTrain {
+	sorted := append([]*api.MergeTrain{}, trains...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].TargetBranch != sorted[j].TargetBranch {
+			return sorted[i].TargetBranch < sorted[j].TargetBranch
+		}
+		return sorted[i].ID < sorted[j].ID
+	})
+	return sorted
+}
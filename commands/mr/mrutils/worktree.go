@@ -0,0 +1,80 @@
+package mrutils
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+
+	"github.com/profclems/glab/pkg/git"
+)
+
+// WorktreeConfigKey is the branch config which records the merge request checked out
+// in a worktree, stored as `branch.BRANCH.glab-mr`
+const WorktreeConfigKey = "glab-mr"
+
+// MRWorktree is a worktree created by `glab mr checkout --worktree`
+type MRWorktree struct {
+	*git.Worktree
+	IID int
+}
+
+// MRWorktrees returns the linked worktrees which have a merge request checked out
+func MRWorktrees() ([]*MRWorktree, error) {
+	worktrees, err := git.ListWorktrees()
+	if err != nil {
+		return nil, err
+	}
+	mrs, err := git.BranchConfigValues(WorktreeConfigKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var mrWorktrees []*MRWorktree
+	for i, wt := range worktrees {
+		// the first worktree is the main one, which is never removed
+		if i == 0 || wt.Branch == "" {
+			continue
+		}
+		iid, err := strconv.Atoi(mrs[wt.Branch])
+		if err != nil {
+			continue
+		}
+		mrWorktrees = append(mrWorktrees, &MRWorktree{Worktree: wt, IID: iid})
+	}
+	return mrWorktrees, nil
+}
+
+// DefaultWorktreePath returns the path of the worktree for a merge request, next to
+// the main worktree of the repository
+func DefaultWorktreePath(mainWorktree string, iid int) string {
+	return filepath.Join(filepath.Dir(mainWorktree), fmt.Sprintf("%s-mr-%d", filepath.Base(mainWorktree), iid))
+}
+
+const (
+	BranchUpToDate = "up to date"
+	BranchBehind   = "behind"
+	BranchAhead    = "ahead"
+	BranchDiverged = "diverged"
+)
+
+// BranchSync compares the commit of a local branch with the head of its merge request
+func BranchSync(local, mrHead string) (string, error) {
+	if local == mrHead {
+		return BranchUpToDate, nil
+	}
+	behind, err := git.IsAncestor(local, mrHead)
+	if err != nil {
+		return "", err
+	}
+	if behind {
+		return BranchBehind, nil
+	}
+	ahead, err := git.IsAncestor(mrHead, local)
+	if err != nil {
+		return "", err
+	}
+	if ahead {
+		return BranchAhead, nil
+	}
+	return BranchDiverged, nil
+}
@@ -0,0 +1,318 @@
+package resolveconflicts
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/commands/mr/mrutils"
+	"github.com/profclems/glab/internal/config"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/internal/run"
+	"github.com/profclems/glab/pkg/git"
+	"github.com/profclems/glab/pkg/iostreams"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/google/shlex"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+var conflictMarkerRE = regexp.MustCompile(`(?m)^(<{7}|={7}|>{7})(\s|$)`)
+
+type ResolveOpts struct {
+	Merge  bool
+	Tool   string
+	Branch string
+
+	IO         *iostreams.IOStreams
+	HTTPClient func() (*gitlab.Client, error)
+	Remotes    func() (glrepo.Remotes, error)
+	Config     func() (config.Config, error)
+	MR         func() (*gitlab.MergeRequest, glrepo.Interface, error)
+}
+
+func NewCmdResolveConflicts(f *cmdutils.Factory, runE func(*ResolveOpts) error) *cobra.Command {
+	opts := &ResolveOpts{
+		IO: f.IO,
+	}
+
+	var mrResolveConflictsCmd = &cobra.Command{
+		Use:   "resolve-conflicts [<id> | <branch>]",
+		Short: `Resolve the merge conflicts of a merge request locally`,
+		Long: heredoc.Doc(`
+			Resolve the merge conflicts of a merge request locally and push the result.
+
+			The merge request is checked out, as with "glab mr checkout", and rebased on its
+			target branch, or the target branch is merged into it with --merge. Merge requests
+			from forks are checked out in a branch named mr-<id>, unless --branch is given. Each conflicted file is
+			opened in the merge tool given with --tool, or the one set in the merge.tool git
+			config, or else in your editor. Once every conflict is resolved, the branch is pushed
+			back to the merge request and its merge status is checked again.
+
+			If a conflict is left unresolved, the rebase or merge is aborted, nothing is pushed and
+			the branch you were on is checked out again.
+		`),
+		Example: heredoc.Doc(`
+			$ glab mr resolve-conflicts 123
+			$ glab mr resolve-conflicts 123 --merge
+			$ glab mr resolve-conflicts 123 --tool vimdiff
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.HTTPClient = f.HttpClient
+			opts.Remotes = f.Remotes
+			opts.Config = f.Config
+			opts.MR = func() (*gitlab.MergeRequest, glrepo.Interface, error) {
+				return mrutils.MRFromArgs(f, args, "opened")
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return resolveRun(opts)
+		},
+	}
+
+	mrResolveConflictsCmd.Flags().BoolVarP(&opts.Merge, "merge", "m", false, "Merge the target branch into the source branch instead of rebasing")
+	mrResolveConflictsCmd.Flags().StringVarP(&opts.Tool, "tool", "t", "", "The merge tool to resolve the conflicts with, as in `git mergetool --tool`")
+	mrResolveConflictsCmd.Flags().StringVarP(&opts.Branch, "branch", "b", "", "The local branch to check out the merge request in (default: the source branch, or mr-<id> for forks)")
+
+	return mrResolveConflictsCmd
+}
+
+func resolveRun(opts *ResolveOpts) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+	mr, repo, err := opts.MR()
+	if err != nil {
+		return err
+	}
+
+	if err = mrutils.MRCheckErrors(mr, mrutils.MRCheckErrOptions{
+		Merged: true,
+		Closed: true,
+	}); err != nil {
+		return err
+	}
+
+	changes, err := git.UncommittedChangeCount()
+	if err != nil {
+		return err
+	}
+	if changes > 0 {
+		return errors.New("you have uncommitted changes. Commit or stash them before resolving conflicts")
+	}
+
+	remotes, err := opts.Remotes()
+	if err != nil {
+		return err
+	}
+	repoRemote, err := remotes.FindByRepo(repo.RepoOwner(), repo.RepoName())
+	if err != nil {
+		return err
+	}
+	pushRemote := repoRemote.Name
+	if mr.SourceProjectID != mr.TargetProjectID {
+		pushRemote, err = mrutils.AuthorRemote(apiClient, mr)
+		if err != nil {
+			return err
+		}
+	}
+
+	editor, err := conflictEditor(opts)
+	if err != nil {
+		return err
+	}
+
+	branch := opts.Branch
+	if branch == "" {
+		branch = localBranch(mr)
+	}
+	if err = git.Fetch(repoRemote.Name, []string{mr.TargetBranch}, opts.IO.StdErr, opts.IO.StdErr); err != nil {
+		return err
+	}
+	previous, state, err := mrutils.CheckoutMR(opts.IO, mr, repoRemote.Name, branch)
+	if err != nil {
+		return err
+	}
+
+	target := repoRemote.Name + "/" + mr.TargetBranch
+	if opts.Merge {
+		err = git.Merge(target, opts.IO.StdErr, opts.IO.StdErr)
+	} else {
+		err = git.Rebase(target, branch, opts.IO.StdErr, opts.IO.StdErr)
+	}
+	if err = resolveConflicts(opts, editor, err); err != nil {
+		action := "rebase"
+		if opts.Merge {
+			action = "merge"
+			_ = git.AbortMerge()
+		} else {
+			_ = git.AbortRebase()
+		}
+		if previous != "" && previous != branch && git.CheckoutBranch(previous) == nil {
+			// a branch which was created for the merge request is not left behind
+			if state == mrutils.BranchMissing {
+				_ = git.DeleteLocalBranch(branch)
+			}
+		}
+		return fmt.Errorf("%w. The %s was aborted and nothing was pushed", err, action)
+	}
+
+	head, err := git.BranchHead(branch)
+	if err != nil {
+		return err
+	}
+	c := opts.IO.Color()
+	if head == mr.SHA {
+		fmt.Fprintf(opts.IO.StdOut, "%s %s is already up to date with %s. Nothing to push\n", c.GreenCheck(), branch, mr.TargetBranch)
+		return nil
+	}
+
+	if err = git.PushWithLease(pushRemote, branch, mr.SourceBranch, mr.SHA, opts.IO.StdErr, opts.IO.StdErr); err != nil {
+		return err
+	}
+	fmt.Fprintf(opts.IO.StdOut, "%s Pushed %s to !%d\n", c.GreenCheck(), mrutils.ShortSHA(head), mr.IID)
+
+	return checkMergeStatus(opts.IO, apiClient, repo, mr, head)
+}
+
+// conflictEditor returns the editor to open the conflicted files with, or an empty string
+// when git mergetool is used
+func conflictEditor(opts *ResolveOpts) (string, error) {
+	if opts.Tool != "" {
+		return "", nil
+	}
+	if tool, _ := git.Config("merge.tool"); tool != "" {
+		return "", nil
+	}
+	editor, err := cmdutils.GetEditor(opts.Config)
+	if err != nil {
+		return "", err
+	}
+	if editor == "" {
+		return "", errors.New("no merge tool or editor is set. Use --tool, the merge.tool git config or `glab config set editor <editor>`")
+	}
+	return editor, nil
+}
+
+// localBranch returns the local branch to check out the merge request in. The source
+// branch of a merge request from a fork may have the name of a branch of the project, like
+// main, so these get a branch of their own.
+func localBranch(mr *gitlab.MergeRequest) string {
+	if mr.SourceProjectID != mr.TargetProjectID {
+		return fmt.Sprintf("mr-%d", mr.IID)
+	}
+	return mr.SourceBranch
+}
+
+// resolveConflicts resolves the conflicts which stopped the rebase or merge with err, until
+// it completes. A rebase stops again for every commit which conflicts.
+func resolveConflicts(opts *ResolveOpts, editor string, err error) error {
+	for err != nil {
+		files, filesErr := git.ConflictedFiles()
+		if filesErr != nil {
+			return filesErr
+		}
+		if len(files) == 0 {
+			// the rebase or merge failed for another reason than conflicts
+			return err
+		}
+		if resolveErr := resolveFiles(opts, editor, files); resolveErr != nil {
+			return resolveErr
+		}
+
+		if opts.Merge {
+			err = git.ContinueMerge(opts.IO.StdErr, opts.IO.StdErr)
+		} else {
+			err = git.ContinueRebase(opts.IO.StdErr, opts.IO.StdErr)
+		}
+	}
+	return nil
+}
+
+func resolveFiles(opts *ResolveOpts, editor string, files []string) error {
+	// conflicted files are listed relative to the root of the repository
+	dir, err := git.ToplevelDir()
+	if err != nil {
+		return err
+	}
+	paths := make([]string, len(files))
+	for i, file := range files {
+		paths[i] = filepath.Join(dir, file)
+	}
+
+	c := opts.IO.Color()
+	for _, file := range files {
+		fmt.Fprintf(opts.IO.StdErr, "%s Conflicts in %s\n", c.WarnIcon(), file)
+	}
+
+	if editor == "" {
+		if err := git.MergeTool(opts.Tool, paths, opts.IO.In, opts.IO.StdOut, opts.IO.StdErr); err != nil {
+			return errors.New("the conflicts were not resolved")
+		}
+		return nil
+	}
+
+	for i, path := range paths {
+		if err := runEditor(opts.IO, editor, path); err != nil {
+			return err
+		}
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if conflictMarkerRE.Match(content) {
+			return fmt.Errorf("%s still has conflict markers", files[i])
+		}
+		if err := git.Add(path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func runEditor(io *iostreams.IOStreams, editor, path string) error {
+	args, err := shlex.Split(editor)
+	if err != nil {
+		return err
+	}
+	editorCmd := exec.Command(args[0], append(args[1:], path)...)
+	editorCmd.Stdin = io.In
+	editorCmd.Stdout = io.StdOut
+	editorCmd.Stderr = io.StdErr
+	return run.PrepareCmd(editorCmd).Run()
+}
+
+// checkMergeStatus waits for GitLab to check the pushed head of the merge request again and
+// reports whether it can be merged now
+func checkMergeStatus(io *iostreams.IOStreams, apiClient *gitlab.Client, repo glrepo.Interface, mr *gitlab.MergeRequest, head string) error {
+	io.StartSpinner("Checking merge status...")
+	mr, err := mrutils.WaitForMR(apiClient, repo, mr.IID, &gitlab.GetMergeRequestsOptions{}, func(mr *gitlab.MergeRequest) bool {
+		return mr.SHA == head && mr.MergeStatus != "unchecked" && mr.MergeStatus != "checking"
+	})
+	io.StopSpinner("")
+	if errors.Is(err, mrutils.ErrWaitTimeout) {
+		return fmt.Errorf("GitLab has not checked the push to !%d yet. Run `glab mr check %d` in a moment", mr.IID, mr.IID)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err = mrutils.MRCheckErrors(mr, mrutils.MRCheckErrOptions{
+		Conflict:    true,
+		MergeStatus: true,
+	}); err != nil {
+		return err
+	}
+	fmt.Fprintf(io.StdOut, "%s !%d has no merge conflicts\n", io.Color().GreenCheck(), mr.IID)
+	return nil
+}
@@ -0,0 +1,199 @@
+package resolveconflicts
+
+import (
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/internal/config"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/git"
+	"github.com/profclems/glab/pkg/httpmock"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/profclems/glab/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xanzy/go-gitlab"
+)
+
+func testOpts(reg *httpmock.Mocker, io *iostreams.IOStreams) *ResolveOpts {
+	opts := &ResolveOpts{
+		IO: io,
+		HTTPClient: func() (*gitlab.Client, error) {
+			a, _ := api.TestClient(&http.Client{Transport: reg}, "", "gitlab.com", false)
+			return a.Lab(), nil
+		},
+		Remotes: func() (glrepo.Remotes, error) {
+			return glrepo.Remotes{
+				&glrepo.Remote{
+					Remote: &git.Remote{Name: "origin"},
+					Repo:   glrepo.New("OWNER", "REPO"),
+				},
+			}, nil
+		},
+		Config: func() (config.Config, error) {
+			return config.NewFromString("editor: vim\n"), nil
+		},
+		MR: func() (*gitlab.MergeRequest, glrepo.Interface, error) {
+			return &gitlab.MergeRequest{
+				IID:             12,
+				State:           "opened",
+				SourceBranch:    "feature",
+				TargetBranch:    "main",
+				SourceProjectID: 1,
+				TargetProjectID: 1,
+				SHA:             "1111111111",
+				HasConflicts:    true,
+			}, glrepo.New("OWNER", "REPO"), nil
+		},
+	}
+	_, _ = opts.HTTPClient()
+	return opts
+}
+
+func Test_resolveRun_mergeTool(t *testing.T) {
+	reg := &httpmock.Mocker{}
+	defer reg.Verify(t)
+	reg.RegisterResponder("GET", "/api/v4/projects/OWNER/REPO/merge_requests/12",
+		httpmock.NewStringResponse(200, `{"iid": 12, "sha": "2222222222", "merge_status": "can_be_merged", "has_conflicts": false}`))
+
+	cs, csTeardown := test.InitCmdStubber()
+	defer csTeardown()
+	cs.Stub("")                        // git status --porcelain
+	cs.Stub("")                        // git fetch origin main
+	cs.Stub("")                        // git fetch origin refs/merge-requests/12/head
+	cs.Stub("main")                    // git symbolic-ref
+	cs.StubError("unknown revision")   // git rev-parse --verify refs/heads/feature
+	cs.Stub("")                        // git branch --force
+	cs.Stub("")                        // git checkout
+	cs.StubError("CONFLICT (content)") // git rebase
+	cs.Stub("app.go\n")                // git diff --name-only
+	cs.Stub("/src/repo")               // git rev-parse --show-toplevel
+	cs.Stub("")                        // git mergetool
+	cs.Stub("")                        // git rebase --continue
+	cs.Stub("2222222222")              // git rev-parse --verify refs/heads/feature
+	cs.Stub("")                        // git push
+
+	io, _, stdout, _ := iostreams.Test()
+	opts := testOpts(reg, io)
+	opts.Tool = "vimdiff"
+
+	require.NoError(t, resolveRun(opts))
+
+	require.Len(t, cs.Calls, 14)
+	assert.Equal(t, []string{"git", "fetch", "origin", "main"}, cs.Calls[1].Args)
+	assert.Equal(t, []string{"git", "fetch", "origin", "refs/merge-requests/12/head"}, cs.Calls[2].Args)
+	assert.Equal(t, []string{"git", "branch", "--force", "feature", "1111111111"}, cs.Calls[5].Args)
+	assert.Equal(t, []string{"git", "rebase", "--fork-point", "origin/main", "feature"}, cs.Calls[7].Args)
+	assert.Equal(t, []string{"git", "mergetool", "--no-prompt", "--tool=vimdiff", "--", filepath.Join("/src/repo", "app.go")}, cs.Calls[10].Args)
+	assert.Equal(t, []string{"git", "-c", "core.editor=true", "rebase", "--continue"}, cs.Calls[11].Args)
+	assert.Equal(t, []string{"git", "push", "--force-with-lease=refs/heads/feature:1111111111", "origin", "feature:refs/heads/feature"}, cs.Calls[13].Args)
+	assert.Equal(t, "✓ Pushed 22222222 to !12\n✓ !12 has no merge conflicts\n", stdout.String())
+}
+
+func Test_resolveRun_unresolved(t *testing.T) {
+	// the editor set in the environment takes precedence over the config
+	for _, env := range []string{"GLAB_EDITOR", "VISUAL", "EDITOR"} {
+		t.Setenv(env, "")
+	}
+	dir := t.TempDir()
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "app.go"), []byte("<<<<<<< HEAD\na\n=======\nb\n>>>>>>> main\n"), 0600))
+
+	reg := &httpmock.Mocker{}
+	defer reg.Verify(t)
+
+	cs, csTeardown := test.InitCmdStubber()
+	defer csTeardown()
+	cs.Stub("")                        // git status --porcelain
+	cs.StubError("")                   // git config merge.tool
+	cs.Stub("")                        // git fetch origin main
+	cs.Stub("")                        // git fetch origin refs/merge-requests/12/head
+	cs.Stub("main")                    // git symbolic-ref
+	cs.Stub("1111111111")              // git rev-parse --verify refs/heads/feature
+	cs.Stub("1111111111")              // git rev-parse --verify refs/heads/feature
+	cs.Stub("")                        // git checkout feature
+	cs.StubError("CONFLICT (content)") // git merge
+	cs.Stub("app.go\n")                // git diff --name-only
+	cs.Stub(dir)                       // git rev-parse --show-toplevel
+	cs.Stub("")                        // vim app.go
+	cs.Stub("")                        // git merge --abort
+	cs.Stub("")                        // git checkout main
+
+	io, _, stdout, _ := iostreams.Test()
+	opts := testOpts(reg, io)
+	opts.Merge = true
+
+	err := resolveRun(opts)
+	assert.EqualError(t, err, "app.go still has conflict markers. The merge was aborted and nothing was pushed")
+
+	require.Len(t, cs.Calls, 14)
+	assert.Equal(t, []string{"git", "checkout", "feature"}, cs.Calls[7].Args)
+	assert.Equal(t, []string{"git", "merge", "--no-edit", "origin/main"}, cs.Calls[8].Args)
+	assert.Equal(t, []string{"vim", filepath.Join(dir, "app.go")}, cs.Calls[11].Args)
+	assert.Equal(t, []string{"git", "merge", "--abort"}, cs.Calls[12].Args)
+	assert.Equal(t, []string{"git", "checkout", "main"}, cs.Calls[13].Args)
+	assert.Empty(t, stdout.String())
+}
+
+func Test_resolveRun_unresolvedNewBranch(t *testing.T) {
+	for _, env := range []string{"GLAB_EDITOR", "VISUAL", "EDITOR"} {
+		t.Setenv(env, "")
+	}
+	dir := t.TempDir()
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "app.go"), []byte("<<<<<<< HEAD\na\n=======\nb\n>>>>>>> main\n"), 0600))
+
+	reg := &httpmock.Mocker{}
+	defer reg.Verify(t)
+
+	cs, csTeardown := test.InitCmdStubber()
+	defer csTeardown()
+	cs.Stub("")                        // git status --porcelain
+	cs.StubError("")                   // git config merge.tool
+	cs.Stub("")                        // git fetch origin main
+	cs.Stub("")                        // git fetch origin refs/merge-requests/12/head
+	cs.Stub("main")                    // git symbolic-ref
+	cs.StubError("unknown revision")   // git rev-parse --verify refs/heads/feature
+	cs.Stub("")                        // git branch --force
+	cs.Stub("")                        // git checkout feature
+	cs.StubError("CONFLICT (content)") // git rebase
+	cs.Stub("app.go\n")                // git diff --name-only
+	cs.Stub(dir)                       // git rev-parse --show-toplevel
+	cs.Stub("")                        // vim app.go
+	cs.Stub("")                        // git rebase --abort
+	cs.Stub("")                        // git checkout main
+	cs.Stub("")                        // git branch -D feature
+
+	io, _, _, _ := iostreams.Test()
+	opts := testOpts(reg, io)
+
+	err := resolveRun(opts)
+	assert.EqualError(t, err, "app.go still has conflict markers. The rebase was aborted and nothing was pushed")
+
+	require.Len(t, cs.Calls, 15)
+	assert.Equal(t, []string{"git", "rebase", "--abort"}, cs.Calls[12].Args)
+	assert.Equal(t, []string{"git", "checkout", "main"}, cs.Calls[13].Args)
+	assert.Equal(t, []string{"git", "branch", "-D", "feature"}, cs.Calls[14].Args)
+}
+
+func Test_resolveRun_uncommittedChanges(t *testing.T) {
+	reg := &httpmock.Mocker{}
+	defer reg.Verify(t)
+
+	cs, csTeardown := test.InitCmdStubber()
+	defer csTeardown()
+	cs.Stub(" M app.go\n") // git status --porcelain
+
+	io, _, _, _ := iostreams.Test()
+	err := resolveRun(testOpts(reg, io))
+	assert.EqualError(t, err, "you have uncommitted changes. Commit or stash them before resolving conflicts")
+}
+
+func Test_localBranch(t *testing.T) {
+	mr := &gitlab.MergeRequest{IID: 12, SourceBranch: "main", SourceProjectID: 1, TargetProjectID: 1}
+	assert.Equal(t, "main", localBranch(mr))
+
+	mr.SourceProjectID = 2
+	assert.Equal(t, "mr-12", localBranch(mr))
+}
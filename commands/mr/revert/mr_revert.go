@@ -0,0 +1,134 @@
+package revert
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/commands/mr/mrutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type RevertOpts struct {
+	Target       string
+	EachCommit   bool
+	CreateMR     bool
+	SourceBranch string
+	Yes          bool
+
+	IO         *iostreams.IOStreams
+	HTTPClient func() (*gitlab.Client, error)
+	MR         func() (*gitlab.MergeRequest, glrepo.Interface, error)
+}
+
+func NewCmdRevert(f *cmdutils.Factory, runE func(*RevertOpts) error) *cobra.Command {
+	opts := &RevertOpts{
+		IO: f.IO,
+	}
+
+	var mrRevertCmd = &cobra.Command{
+		Use:   "revert [<id> | <branch>]",
+		Short: `Revert the changes of a merged merge request`,
+		Long: heredoc.Doc(`
+			Revert the changes of a merged merge request on GitLab.
+
+			The merge commit, or the squash commit, of the merge request is reverted in its
+			target branch, or in the branch given with --to. Use --each-commit to revert the
+			commits of the merge request one by one instead, newest first.
+
+			With --create-mr, the commits are reverted in a new branch and a merge request
+			is opened against the target branch. Otherwise, you are asked to confirm before
+			the commits are reverted directly in the branch.
+		`),
+		Example: heredoc.Doc(`
+			$ glab mr revert 123
+			$ glab mr revert 123 --create-mr
+			$ glab mr revert 123 --to release-1.2 --each-commit --yes
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.SourceBranch != "" && !opts.CreateMR {
+				return &cmdutils.FlagError{Err: errors.New("--source-branch can only be used with --create-mr")}
+			}
+			if !opts.CreateMR && !opts.Yes && !opts.IO.PromptEnabled() {
+				return &cmdutils.FlagError{Err: errors.New("--yes or -y flag is required when not running interactively")}
+			}
+
+			opts.HTTPClient = f.HttpClient
+			opts.MR = func() (*gitlab.MergeRequest, glrepo.Interface, error) {
+				return mrutils.MRFromArgs(f, args, "merged")
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return revertRun(opts)
+		},
+	}
+
+	mrRevertCmd.Flags().StringVarP(&opts.Target, "to", "t", "", "The branch to revert the changes in (default: the target branch of the merge request)")
+	mrRevertCmd.Flags().BoolVarP(&opts.EachCommit, "each-commit", "e", false, "Revert each commit of the merge request instead of its merge commit")
+	mrRevertCmd.Flags().BoolVarP(&opts.CreateMR, "create-mr", "m", false, "Revert in a new branch and open a merge request against the target branch")
+	mrRevertCmd.Flags().StringVarP(&opts.SourceBranch, "source-branch", "s", "", "Name of the branch to create for --create-mr (default: revert-<commit>)")
+	mrRevertCmd.Flags().BoolVarP(&opts.Yes, "yes", "y", false, "Skip the confirmation prompt when reverting directly in the branch")
+
+	return mrRevertCmd
+}
+
+func revertRun(opts *RevertOpts) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+	mr, repo, err := opts.MR()
+	if err != nil {
+		return err
+	}
+
+	shas, err := mrutils.BackportCommits(apiClient, repo, mr, opts.EachCommit)
+	if err != nil {
+		return err
+	}
+	// undo the changes in the reverse order they were made
+	for i, j := 0, len(shas)-1; i < j; i, j = i+1, j-1 {
+		shas[i], shas[j] = shas[j], shas[i]
+	}
+
+	target := opts.Target
+	if target == "" {
+		target = mr.TargetBranch
+	}
+	sourceBranch := opts.SourceBranch
+	if sourceBranch == "" {
+		sourceBranch = "revert-" + mrutils.ShortSHA(shas[0])
+	}
+
+	backport := &mrutils.Backport{
+		Client:       apiClient,
+		Repo:         repo,
+		MR:           mr,
+		SHAs:         shas,
+		Target:       target,
+		CreateMR:     opts.CreateMR,
+		SourceBranch: sourceBranch,
+		Yes:          opts.Yes,
+		Verb:         "revert",
+		Past:         "reverted",
+		Preposition:  "in",
+		Title:        fmt.Sprintf("Revert \"%s\"", mr.Title),
+		Reference:    "reverts %s",
+		Apply: func(sha, branch string) (*gitlab.Commit, error) {
+			return api.RevertCommit(apiClient, repo.FullName(), sha, &gitlab.RevertCommitOptions{
+				Branch: gitlab.String(branch),
+			})
+		},
+	}
+	return backport.Run(opts.IO)
+}
@@ -0,0 +1,136 @@
+package revert
+
+import (
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/httpmock"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xanzy/go-gitlab"
+)
+
+func Test_revertRun_eachCommit(t *testing.T) {
+	reg := &httpmock.Mocker{}
+	defer reg.Verify(t)
+
+	var reverted []string
+	reg.RegisterResponder("GET", "/api/v4/projects/OWNER/REPO/merge_requests/10/commits",
+		httpmock.NewStringResponse(200, `[{"id": "2222222222"}, {"id": "1111111111"}]`))
+	revert := func(sha, newSHA string) {
+		reg.RegisterResponder("POST", "/api/v4/projects/OWNER/REPO/repository/commits/"+sha+"/revert",
+			func(req *http.Request) (*http.Response, error) {
+				b, _ := ioutil.ReadAll(req.Body)
+				assert.JSONEq(t, `{"branch": "main"}`, string(b))
+				reverted = append(reverted, sha)
+				return httpmock.NewStringResponse(201, `{"short_id": "`+newSHA+`"}`)(req)
+			})
+	}
+	revert("2222222222", "bbbbbbbb")
+	revert("1111111111", "aaaaaaaa")
+
+	io, _, stdout, _ := iostreams.Test()
+	opts := &RevertOpts{
+		EachCommit: true,
+		Yes:        true,
+		IO:         io,
+		HTTPClient: func() (*gitlab.Client, error) {
+			a, _ := api.TestClient(&http.Client{Transport: reg}, "", "gitlab.com", false)
+			return a.Lab(), nil
+		},
+		MR: func() (*gitlab.MergeRequest, glrepo.Interface, error) {
+			return &gitlab.MergeRequest{
+				IID:          10,
+				State:        "merged",
+				TargetBranch: "main",
+			}, glrepo.New("OWNER", "REPO"), nil
+		},
+	}
+
+	_, _ = opts.HTTPClient()
+
+	require.NoError(t, revertRun(opts))
+
+	assert.Equal(t, []string{"2222222222", "1111111111"}, reverted)
+	assert.Equal(t, "✓ Reverted 22222222 in main as bbbbbbbb\n✓ Reverted 11111111 in main as aaaaaaaa\n", stdout.String())
+}
+
+func Test_revertRun_partialFailure(t *testing.T) {
+	reg := &httpmock.Mocker{}
+	defer reg.Verify(t)
+
+	reg.RegisterResponder("GET", "/api/v4/projects/OWNER/REPO/merge_requests/10/commits",
+		httpmock.NewStringResponse(200, `[{"id": "2222222222"}, {"id": "1111111111"}]`))
+	reg.RegisterResponder("POST", "/api/v4/projects/OWNER/REPO/repository/commits/2222222222/revert",
+		httpmock.NewStringResponse(201, `{"short_id": "bbbbbbbb"}`))
+	reg.RegisterResponder("POST", "/api/v4/projects/OWNER/REPO/repository/commits/1111111111/revert",
+		httpmock.NewStringResponse(400, `{"message": "Sorry, we cannot revert this commit automatically."}`))
+
+	io, _, _, stderr := iostreams.Test()
+	opts := &RevertOpts{
+		EachCommit: true,
+		Yes:        true,
+		IO:         io,
+		HTTPClient: func() (*gitlab.Client, error) {
+			a, _ := api.TestClient(&http.Client{Transport: reg}, "", "gitlab.com", false)
+			return a.Lab(), nil
+		},
+		MR: func() (*gitlab.MergeRequest, glrepo.Interface, error) {
+			return &gitlab.MergeRequest{
+				IID:          10,
+				State:        "merged",
+				TargetBranch: "main",
+			}, glrepo.New("OWNER", "REPO"), nil
+		},
+	}
+
+	_, _ = opts.HTTPClient()
+
+	require.Error(t, revertRun(opts))
+	assert.Equal(t, "! main was left partially reverted. Already reverted: 22222222 as bbbbbbbb\n", stderr.String())
+}
+
+func Test_NewCmdRevert_yesRequired(t *testing.T) {
+	io, _, _, _ := iostreams.Test()
+	f := &cmdutils.Factory{IO: io}
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "in the target branch",
+			args:    []string{"10"},
+			wantErr: "--yes or -y flag is required when not running interactively",
+		},
+		{
+			name: "with --yes",
+			args: []string{"10", "--yes"},
+		},
+		{
+			name: "with --create-mr",
+			args: []string{"10", "--create-mr"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := NewCmdRevert(f, func(*RevertOpts) error { return nil })
+			cmd.SetArgs(tt.args)
+			cmd.SetOut(ioutil.Discard)
+			cmd.SetErr(ioutil.Discard)
+
+			_, err := cmd.ExecuteC()
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,163 @@
+package list
+
+import (
+	"fmt"
+
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/commands/mr/mrutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/profclems/glab/pkg/tableprinter"
+	"github.com/profclems/glab/pkg/utils"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type ListOpts struct {
+	TargetBranch string
+	Completed    bool
+
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+	HTTPClient func() (*gitlab.Client, error)
+}
+
+func NewCmdList(f *cmdutils.Factory, runE func(*ListOpts) error) *cobra.Command {
+	opts := &ListOpts{
+		IO: f.IO,
+	}
+
+	var mrTrainListCmd = &cobra.Command{
+		Use:   "list [flags]",
+		Short: `Show the merge trains of the project`,
+		Long: heredoc.Doc(`
+			Show the merge requests queued on the merge trains of the project, in the order
+			they will be merged, with the status of their merge train pipelines.
+		`),
+		Aliases: []string{"ls"},
+		Example: heredoc.Doc(`
+			$ glab mr train list
+			$ glab mr train list --branch main
+			$ glab mr train list --completed
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+			opts.HTTPClient = f.HttpClient
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return listRun(opts)
+		},
+	}
+
+	mrTrainListCmd.Flags().StringVarP(&opts.TargetBranch, "branch", "b", "", "Only show the merge train of the given target branch")
+	mrTrainListCmd.Flags().BoolVarP(&opts.Completed, "completed", "c", false, "Show the merge requests which left the merge trains instead")
+
+	return mrTrainListCmd
+}
+
+func listRun(opts *ListOpts) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	scope := "active"
+	if opts.Completed {
+		scope = "complete"
+	}
+	listOpts := &api.ListMergeTrainsOptions{
+		Scope: gitlab.String(scope),
+		Sort:  gitlab.String("asc"),
+	}
+	listOpts.PerPage = 100
+
+	var trains []*api.MergeTrain
+	for listOpts.Page = 1; ; listOpts.Page++ {
+		page, err := api.ListMergeTrains(apiClient, repo.FullName(), listOpts)
+		if err != nil {
+			return cmdutils.WrapError(err, "failed to list the merge trains")
+		}
+		for _, train := range page {
+			if opts.TargetBranch == "" || train.TargetBranch == opts.TargetBranch {
+				trains = append(trains, train)
+			}
+		}
+		if len(page) < listOpts.PerPage {
+			break
+		}
+	}
+
+	if len(trains) == 0 {
+		if opts.TargetBranch != "" {
+			fmt.Fprintf(opts.IO.StdErr, "No merge requests on the merge train of %s\n", opts.TargetBranch)
+		} else {
+			fmt.Fprintln(opts.IO.StdErr, "No merge requests on merge trains")
+		}
+		return nil
+	}
+
+	c := opts.IO.Color()
+	table := tableprinter.NewTablePrinter()
+	branch, position := "", 0
+	for _, train := range mrutils.SortMergeTrain(trains) {
+		if train.TargetBranch != branch {
+			if branch != "" {
+				fmt.Fprintf(opts.IO.StdOut, "Merge train of %s\n%s\n", c.Bold(branch), table.String())
+				table = tableprinter.NewTablePrinter()
+			}
+			branch, position = train.TargetBranch, 0
+		}
+		position++
+
+		ref, title := "", ""
+		if train.MergeRequest != nil {
+			ref, title = c.Cyan(fmt.Sprintf("!%d", train.MergeRequest.IID)), train.MergeRequest.Title
+		}
+		pipeline := c.Gray("no pipeline")
+		if train.Pipeline != nil {
+			pipeline = pipelineStatus(c, train.Pipeline.Status)
+		}
+		user := ""
+		if train.User != nil {
+			user = "@" + train.User.Username
+		}
+		when := ""
+		if opts.Completed && train.MergedAt != nil {
+			when = "merged " + utils.TimeToPrettyTimeAgo(*train.MergedAt)
+		} else if train.CreatedAt != nil {
+			when = "added " + utils.TimeToPrettyTimeAgo(*train.CreatedAt)
+		}
+
+		pos := fmt.Sprintf("%d.", position)
+		if opts.Completed {
+			pos = ""
+		}
+		table.AddRow(pos, ref, title, pipeline, train.Status, user, c.Gray(when))
+	}
+	fmt.Fprintf(opts.IO.StdOut, "Merge train of %s\n%s", c.Bold(branch), table.String())
+	return nil
+}
+
+func pipelineStatus(c *iostreams.ColorPalette, status string) string {
+	switch status {
+	case "success":
+		return c.Green(status)
+	case "failed", "canceled":
+		return c.Red(status)
+	case "running", "pending", "created", "preparing", "waiting_for_resource":
+		return c.Yellow(status)
+	default:
+		return c.Gray(status)
+	}
+}
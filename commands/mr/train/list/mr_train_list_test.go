@@ -0,0 +1,82 @@
+package list
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/httpmock"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xanzy/go-gitlab"
+)
+
+func Test_listRun(t *testing.T) {
+	reg := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+	defer reg.Verify(t)
+
+	added := time.Now().Add(-10 * time.Minute).UTC().Format(time.RFC3339)
+	reg.RegisterResponder("GET", "/api/v4/projects/OWNER/REPO/merge_trains?page=1&per_page=100&scope=active&sort=asc",
+		httpmock.NewStringResponse(200, `[
+			{"id": 3, "target_branch": "main", "status": "idle", "created_at": "`+added+`",
+			 "merge_request": {"iid": 14, "title": "Add the cli"}, "user": {"username": "bob"}},
+			{"id": 1, "target_branch": "main", "status": "fresh", "created_at": "`+added+`",
+			 "merge_request": {"iid": 12, "title": "Add the parser"}, "user": {"username": "alice"},
+			 "pipeline": {"id": 100, "status": "running"}},
+			{"id": 2, "target_branch": "stable", "status": "fresh", "created_at": "`+added+`",
+			 "merge_request": {"iid": 13, "title": "Backport the fix"}, "user": {"username": "alice"},
+			 "pipeline": {"id": 101, "status": "success"}}
+		]`))
+
+	io, _, stdout, _ := iostreams.Test()
+	opts := &ListOpts{
+		IO: io,
+		BaseRepo: func() (glrepo.Interface, error) {
+			return glrepo.New("OWNER", "REPO"), nil
+		},
+		HTTPClient: func() (*gitlab.Client, error) {
+			a, _ := api.TestClient(&http.Client{Transport: reg}, "", "gitlab.com", false)
+			return a.Lab(), nil
+		},
+	}
+	_, _ = opts.HTTPClient()
+
+	err := listRun(opts)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Merge train of main\n"+
+		"1.\t!12\tAdd the parser\trunning\tfresh\t@alice\tadded about 10 minutes ago\n"+
+		"2.\t!14\tAdd the cli\tno pipeline\tidle\t@bob\tadded about 10 minutes ago\n"+
+		"\nMerge train of stable\n"+
+		"1.\t!13\tBackport the fix\tsuccess\tfresh\t@alice\tadded about 10 minutes ago\n", stdout.String())
+}
+
+func Test_listRun_empty(t *testing.T) {
+	reg := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+	defer reg.Verify(t)
+
+	reg.RegisterResponder("GET", "/api/v4/projects/OWNER/REPO/merge_trains?page=1&per_page=100&scope=active&sort=asc",
+		httpmock.NewStringResponse(200, `[{"id": 2, "target_branch": "stable", "status": "fresh"}]`))
+
+	io, _, stdout, stderr := iostreams.Test()
+	opts := &ListOpts{
+		TargetBranch: "main",
+		IO:           io,
+		BaseRepo: func() (glrepo.Interface, error) {
+			return glrepo.New("OWNER", "REPO"), nil
+		},
+		HTTPClient: func() (*gitlab.Client, error) {
+			a, _ := api.TestClient(&http.Client{Transport: reg}, "", "gitlab.com", false)
+			return a.Lab(), nil
+		},
+	}
+	_, _ = opts.HTTPClient()
+
+	err := listRun(opts)
+	require.NoError(t, err)
+	assert.Equal(t, "", stdout.String())
+	assert.Equal(t, "No merge requests on the merge train of main\n", stderr.String())
+}
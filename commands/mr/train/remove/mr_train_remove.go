@@ -0,0 +1,77 @@
+package remove
+
+import (
+	"fmt"
+
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/commands/mr/mrutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type RemoveOpts struct {
+	IO         *iostreams.IOStreams
+	HTTPClient func() (*gitlab.Client, error)
+	MR         func() (*gitlab.MergeRequest, glrepo.Interface, error)
+}
+
+func NewCmdRemove(f *cmdutils.Factory, runE func(*RemoveOpts) error) *cobra.Command {
+	opts := &RemoveOpts{
+		IO: f.IO,
+	}
+
+	var mrTrainRemoveCmd = &cobra.Command{
+		Use:   "remove [<id> | <branch>]",
+		Short: `Remove a merge request from its merge train`,
+		Long: heredoc.Doc(`
+			Remove a merge request from the merge train of its target branch.
+
+			The merge requests behind it on the merge train get new merge train pipelines.
+		`),
+		Aliases: []string{"rm"},
+		Example: heredoc.Doc(`
+			$ glab mr train remove 235
+			$ glab mr train remove    # the merge request of the current branch
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.HTTPClient = f.HttpClient
+			opts.MR = func() (*gitlab.MergeRequest, glrepo.Interface, error) {
+				return mrutils.MRFromArgs(f, args, "opened")
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return removeRun(opts)
+		},
+	}
+
+	return mrTrainRemoveCmd
+}
+
+func removeRun(opts *RemoveOpts) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+	mr, repo, err := opts.MR()
+	if err != nil {
+		return err
+	}
+
+	_, err = api.RemoveMRFromMergeTrain(apiClient, repo.FullName(), mr.IID)
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to remove !%d from the merge train", mr.IID))
+	}
+
+	c := opts.IO.Color()
+	fmt.Fprintf(opts.IO.StdOut, "%s Removed !%d from the merge train of %s\n", c.GreenCheck(), mr.IID, mr.TargetBranch)
+	return nil
+}
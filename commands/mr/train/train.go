@@ -0,0 +1,26 @@
+package train
+
+import (
+	"github.com/profclems/glab/commands/cmdutils"
+	trainListCmd "github.com/profclems/glab/commands/mr/train/list"
+	trainRemoveCmd "github.com/profclems/glab/commands/mr/train/remove"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdTrain(f *cmdutils.Factory) *cobra.Command {
+	var mrTrainCmd = &cobra.Command{
+		Use:   "train <command> [flags]",
+		Short: `Work with merge trains`,
+		Long: heredoc.Doc(`
+			Work with the merge trains of the project.
+
+			Merge requests are added to a merge train with "glab mr merge --train".
+		`),
+	}
+
+	mrTrainCmd.AddCommand(trainListCmd.NewCmdList(f, nil))
+	mrTrainCmd.AddCommand(trainRemoveCmd.NewCmdRemove(f, nil))
+	return mrTrainCmd
+}
@@ -77,6 +77,10 @@ func NewCmdView(f *cmdutils.Factory) *cobra.Command {
 				}
 			}
 
+			// approval rules are not available on every instance, so the approvals
+			// are left out of the preview when they cannot be fetched
+			approvals, _ := api.GetMRApprovalState(apiClient, baseRepo.FullName(), mr.IID)
+
 			glamourStyle, _ := cfg.Get(baseRepo.RepoHost(), "glamour_style")
 			f.IO.ResolveBackgroundColor(glamourStyle)
 			if err := f.IO.StartPager(); err != nil {
@@ -85,9 +89,9 @@ func NewCmdView(f *cmdutils.Factory) *cobra.Command {
 			defer f.IO.StopPager()
 
 			if f.IO.IsOutputTTY() {
-				return printTTYMRPreview(opts, mr, notes)
+				return printTTYMRPreview(opts, mr, notes, approvals)
 			}
-			return printRawMRPreview(opts, mr)
+			return printRawMRPreview(opts, mr, approvals)
 		},
 	}
 
@@ -136,7 +140,20 @@ func mrState(c *iostreams.ColorPalette, mr *gitlab.MergeRequest) (mrState string
 	return mrState
 }
 
-func printTTYMRPreview(opts *ViewOpts, mr *gitlab.MergeRequest, notes []*gitlab.Note) error {
+// approvalRulesSummary counts the approval rules which are satisfied
+func approvalRulesSummary(approvals *gitlab.MergeRequestApprovalState) (satisfied int, total int) {
+	if approvals == nil {
+		return 0, 0
+	}
+	for _, rule := range approvals.Rules {
+		if rule.Approved {
+			satisfied++
+		}
+	}
+	return satisfied, len(approvals.Rules)
+}
+
+func printTTYMRPreview(opts *ViewOpts, mr *gitlab.MergeRequest, notes []*gitlab.Note, approvals *gitlab.MergeRequestApprovalState) error {
 	c := opts.IO.Color()
 	out := opts.IO.StdOut
 	mrTimeAgo := utils.TimeToPrettyTimeAgo(*mr.CreatedAt)
@@ -192,6 +209,17 @@ func printTTYMRPreview(opts *ViewOpts, mr *gitlab.MergeRequest, notes []*gitlab.
 			fmt.Fprintf(out, "%s Requires pipeline to succeed before merging\n", c.WarnIcon())
 		}
 	}
+	if satisfied, total := approvalRulesSummary(approvals); total > 0 {
+		fmt.Fprint(out, c.Bold("Approvals: "))
+		fmt.Fprintf(out, "%d of %d rules satisfied\n", satisfied, total)
+		for _, rule := range approvals.Rules {
+			icon := c.FailedIcon()
+			if rule.Approved {
+				icon = c.GreenCheck()
+			}
+			fmt.Fprintf(out, "  %s %s: %d/%d approvals\n", icon, rule.Name, len(rule.ApprovedBy), rule.ApprovalsRequired)
+		}
+	}
 	fmt.Fprintf(out, "%s This merge request has %s changes\n", c.GreenCheck(), c.Yellow(mr.ChangesCount))
 	if mr.State == "merged" && mr.MergedBy != nil {
 		fmt.Fprintf(out, "%s The changes were merged into %s by %s %s\n", c.GreenCheck(), mr.TargetBranch, mr.MergedBy.Name, utils.TimeToPrettyTimeAgo(*mr.MergedAt))
@@ -237,7 +265,7 @@ func printTTYMRPreview(opts *ViewOpts, mr *gitlab.MergeRequest, notes []*gitlab.
 	return nil
 }
 
-func printRawMRPreview(opts *ViewOpts, mr *gitlab.MergeRequest) error {
+func printRawMRPreview(opts *ViewOpts, mr *gitlab.MergeRequest, approvals *gitlab.MergeRequestApprovalState) error {
 	out := opts.IO.StdOut
 	assignees := assigneesList(mr)
 	reviewers := reviewersList(mr)
@@ -253,6 +281,17 @@ func printRawMRPreview(opts *ViewOpts, mr *gitlab.MergeRequest) error {
 	if mr.Milestone != nil {
 		fmt.Fprintf(out, "milestone:\t%s\n", mr.Milestone.Title)
 	}
+	if _, total := approvalRulesSummary(approvals); total > 0 {
+		var rules []string
+		for _, rule := range approvals.Rules {
+			state := fmt.Sprintf("%d/%d", len(rule.ApprovedBy), rule.ApprovalsRequired)
+			if rule.Approved {
+				state += ", satisfied"
+			}
+			rules = append(rules, fmt.Sprintf("%s (%s)", rule.Name, state))
+		}
+		fmt.Fprintf(out, "approval rules:\t%s\n", strings.Join(rules, ", "))
+	}
 	fmt.Fprintf(out, "number:\t%d\n", mr.IID)
 	fmt.Fprintf(out, "url:\t%s\n", mr.WebURL)
 
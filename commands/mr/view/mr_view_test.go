@@ -89,6 +89,22 @@ hosts:
 			},
 		}, nil
 	}
+	api.GetMRApprovalState = func(client *gitlab.Client, projectID interface{}, mrID int, opts ...gitlab.RequestOptionFunc) (*gitlab.MergeRequestApprovalState, error) {
+		return &gitlab.MergeRequestApprovalState{
+			Rules: []*gitlab.MergeRequestApprovalRule{
+				{
+					Name:              "Backend",
+					ApprovalsRequired: 1,
+					ApprovedBy:        []*gitlab.BasicUser{{Username: "mona"}},
+					Approved:          true,
+				},
+				{
+					Name:              "Security",
+					ApprovalsRequired: 1,
+				},
+			},
+		}, nil
+	}
 	cmdtest.InitTest(m, "mr_view_test")
 }
 
@@ -179,6 +195,7 @@ func TestMRView(t *testing.T) {
 		require.Equal(t, outErr, "")
 		assert.Contains(t, out, "https://gitlab.com/glab-cli/test/-/merge_requests/13")
 		assert.Contains(t, out, "johnwick Marked MR as ready")
+		assert.Contains(t, out, "Approvals: 1 of 2 rules satisfied\n  ✓ Backend: 1/1 approvals\n  x Security: 0/1 approvals\n")
 	})
 
 	t.Run("no_tty", func(t *testing.T) {
@@ -203,6 +220,7 @@ func TestMRView(t *testing.T) {
 			`comments:\t2`,
 			`labels:\ttest, bug`,
 			`milestone:\tMilestoneTitle\n`,
+			`approval rules:\tBackend \(1/1, satisfied\), Security \(0/1\)\n`,
 			`--`,
 			`mrBody`,
 		}
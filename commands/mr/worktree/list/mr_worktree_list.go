@@ -0,0 +1,121 @@
+package list
+
+import (
+	"fmt"
+
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/commands/mr/mrutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/profclems/glab/pkg/tableprinter"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type ListOpts struct {
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+	HTTPClient func() (*gitlab.Client, error)
+}
+
+func NewCmdList(f *cmdutils.Factory, runE func(*ListOpts) error) *cobra.Command {
+	opts := &ListOpts{
+		IO: f.IO,
+	}
+
+	var mrWorktreeListCmd = &cobra.Command{
+		Use:   "list [flags]",
+		Short: `Show the worktrees of merge requests`,
+		Long: heredoc.Doc(`
+			Show the worktrees created with "glab mr checkout --worktree", the state of their
+			merge requests, and whether the checked out branch matches the merge request head.
+		`),
+		Aliases: []string{"ls"},
+		Example: heredoc.Doc(`
+			$ glab mr worktree list
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+			opts.HTTPClient = f.HttpClient
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return listRun(opts)
+		},
+	}
+
+	return mrWorktreeListCmd
+}
+
+func listRun(opts *ListOpts) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	worktrees, err := mrutils.MRWorktrees()
+	if err != nil {
+		return err
+	}
+	if len(worktrees) == 0 {
+		fmt.Fprintln(opts.IO.StdErr, "No merge request worktrees. Create one with \"glab mr checkout --worktree\"")
+		return nil
+	}
+
+	c := opts.IO.Color()
+	table := tableprinter.NewTablePrinter()
+	for _, wt := range worktrees {
+		state, sync := c.Gray("unknown"), ""
+		mr, err := api.GetMR(apiClient, repo.FullName(), wt.IID, &gitlab.GetMergeRequestsOptions{})
+		if err == nil {
+			state = mrState(c, mr.State)
+			sync = branchSync(c, wt, mr)
+		}
+		if wt.Prunable {
+			sync = c.Red("missing")
+		}
+		table.AddRow(c.Cyan(fmt.Sprintf("!%d", wt.IID)), state, wt.Branch, wt.Path, sync)
+	}
+	fmt.Fprint(opts.IO.StdOut, table.String())
+	return nil
+}
+
+func mrState(c *iostreams.ColorPalette, state string) string {
+	switch state {
+	case "opened":
+		return c.Green(state)
+	case "merged":
+		return c.Magenta(state)
+	default:
+		return c.Red(state)
+	}
+}
+
+func branchSync(c *iostreams.ColorPalette, wt *mrutils.MRWorktree, mr *gitlab.MergeRequest) string {
+	if mr.SHA == "" {
+		return ""
+	}
+	sync, err := mrutils.BranchSync(wt.Head, mr.SHA)
+	if err != nil {
+		// the merge request head has not been fetched yet
+		return c.Yellow(mrutils.BranchBehind)
+	}
+	switch sync {
+	case mrutils.BranchUpToDate:
+		return c.Green(sync)
+	case mrutils.BranchDiverged:
+		return c.Red(sync)
+	default:
+		return c.Yellow(sync)
+	}
+}
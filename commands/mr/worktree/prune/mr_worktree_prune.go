@@ -0,0 +1,124 @@
+package prune
+
+import (
+	"fmt"
+
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/commands/mr/mrutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/git"
+	"github.com/profclems/glab/pkg/iostreams"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type PruneOpts struct {
+	Force          bool
+	DeleteBranches bool
+	DryRun         bool
+
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+	HTTPClient func() (*gitlab.Client, error)
+}
+
+func NewCmdPrune(f *cmdutils.Factory, runE func(*PruneOpts) error) *cobra.Command {
+	opts := &PruneOpts{
+		IO: f.IO,
+	}
+
+	var mrWorktreePruneCmd = &cobra.Command{
+		Use:   "prune [flags]",
+		Short: `Remove the worktrees of merged and closed merge requests`,
+		Long: heredoc.Doc(`
+			Remove the worktrees created with "glab mr checkout --worktree" whose merge
+			requests have been merged or closed.
+
+			Worktrees with uncommitted changes are kept unless --force is used.
+		`),
+		Example: heredoc.Doc(`
+			$ glab mr worktree prune --dry-run
+			$ glab mr worktree prune --delete-branches
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+			opts.HTTPClient = f.HttpClient
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return pruneRun(opts)
+		},
+	}
+
+	mrWorktreePruneCmd.Flags().BoolVarP(&opts.Force, "force", "f", false, "Remove worktrees even if they have uncommitted changes")
+	mrWorktreePruneCmd.Flags().BoolVarP(&opts.DeleteBranches, "delete-branches", "d", false, "Also delete the local branches of the removed worktrees")
+	mrWorktreePruneCmd.Flags().BoolVarP(&opts.DryRun, "dry-run", "n", false, "Show the worktrees which would be removed without removing them")
+	return mrWorktreePruneCmd
+}
+
+func pruneRun(opts *PruneOpts) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	if !opts.DryRun {
+		// forget the worktrees whose directories were deleted by hand first
+		if err := git.PruneWorktrees(); err != nil {
+			return err
+		}
+	}
+
+	worktrees, err := mrutils.MRWorktrees()
+	if err != nil {
+		return err
+	}
+
+	c := opts.IO.Color()
+	removed := 0
+	for _, wt := range worktrees {
+		mr, err := api.GetMR(apiClient, repo.FullName(), wt.IID, &gitlab.GetMergeRequestsOptions{})
+		if err != nil {
+			fmt.Fprintf(opts.IO.StdErr, "%s Could not get !%d: %s\n", c.WarnIcon(), wt.IID, err)
+			continue
+		}
+		if mr.State != "merged" && mr.State != "closed" {
+			continue
+		}
+
+		if opts.DryRun {
+			fmt.Fprintf(opts.IO.StdOut, "Would remove %s (!%d is %s)\n", wt.Path, wt.IID, mr.State)
+			removed++
+			continue
+		}
+
+		if err := git.RemoveWorktree(wt.Path, opts.Force); err != nil {
+			fmt.Fprintf(opts.IO.StdErr, "%s Could not remove %s. Use --force to remove it with its uncommitted changes\n", c.WarnIcon(), wt.Path)
+			continue
+		}
+		if opts.DeleteBranches {
+			if err := git.DeleteLocalBranch(wt.Branch); err != nil {
+				return err
+			}
+		} else if err := git.UnsetBranchConfig(wt.Branch, mrutils.WorktreeConfigKey); err != nil {
+			return err
+		}
+		fmt.Fprintf(opts.IO.StdOut, "%s Removed %s (!%d is %s)\n", c.GreenCheck(), wt.Path, wt.IID, mr.State)
+		removed++
+	}
+
+	if removed == 0 {
+		fmt.Fprintln(opts.IO.StdErr, "No worktrees of merged or closed merge requests")
+	}
+	return nil
+}
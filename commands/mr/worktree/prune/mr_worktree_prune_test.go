@@ -0,0 +1,78 @@
+package prune
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/httpmock"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/profclems/glab/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xanzy/go-gitlab"
+)
+
+func Test_pruneRun(t *testing.T) {
+	reg := &httpmock.Mocker{}
+	defer reg.Verify(t)
+
+	reg.RegisterResponder("GET", "/api/v4/projects/OWNER/REPO/merge_requests/12",
+		httpmock.NewStringResponse(200, `{"id": 1, "iid": 12, "state": "merged"}`))
+	reg.RegisterResponder("GET", "/api/v4/projects/OWNER/REPO/merge_requests/13",
+		httpmock.NewStringResponse(200, `{"id": 2, "iid": 13, "state": "opened"}`))
+	reg.RegisterResponder("GET", "/api/v4/projects/OWNER/REPO/merge_requests/14",
+		httpmock.NewStringResponse(200, `{"id": 3, "iid": 14, "state": "closed"}`))
+
+	cs, csTeardown := test.InitCmdStubber()
+	defer csTeardown()
+	cs.Stub("") // git worktree prune
+	cs.Stub(`worktree /src/repo
+HEAD 1111111111111111111111111111111111111111
+branch refs/heads/main
+
+worktree /src/repo-mr-12
+HEAD 2222222222222222222222222222222222222222
+branch refs/heads/parser
+
+worktree /src/repo-mr-13
+HEAD 3333333333333333333333333333333333333333
+branch refs/heads/lexer
+
+worktree /src/repo-mr-14
+HEAD 4444444444444444444444444444444444444444
+branch refs/heads/docs
+
+worktree /src/scratch
+HEAD 5555555555555555555555555555555555555555
+branch refs/heads/scratch
+`) // git worktree list
+	cs.Stub("branch.parser.glab-mr 12\nbranch.lexer.glab-mr 13\nbranch.docs.glab-mr 14\n") // git config --get-regexp
+	cs.Stub("")                                                                            // git worktree remove /src/repo-mr-12
+	cs.Stub("")                                                                            // git config --unset
+	cs.StubError("contains modified or untracked files")                                   // git worktree remove /src/repo-mr-14
+
+	io, _, stdout, stderr := iostreams.Test()
+	opts := &PruneOpts{
+		IO: io,
+		BaseRepo: func() (glrepo.Interface, error) {
+			return glrepo.New("OWNER", "REPO"), nil
+		},
+		HTTPClient: func() (*gitlab.Client, error) {
+			a, _ := api.TestClient(&http.Client{Transport: reg}, "", "gitlab.com", false)
+			return a.Lab(), nil
+		},
+	}
+	_, _ = opts.HTTPClient()
+
+	err := pruneRun(opts)
+	require.NoError(t, err)
+
+	require.Len(t, cs.Calls, 6)
+	assert.Equal(t, []string{"git", "worktree", "remove", "/src/repo-mr-12"}, cs.Calls[3].Args)
+	assert.Equal(t, []string{"git", "config", "--unset", "branch.parser.glab-mr"}, cs.Calls[4].Args)
+	assert.Equal(t, []string{"git", "worktree", "remove", "/src/repo-mr-14"}, cs.Calls[5].Args)
+	assert.Equal(t, "✓ Removed /src/repo-mr-12 (!12 is merged)\n", stdout.String())
+	assert.Equal(t, "! Could not remove /src/repo-mr-14. Use --force to remove it with its uncommitted changes\n", stderr.String())
+}
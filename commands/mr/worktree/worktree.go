@@ -0,0 +1,26 @@
+package worktree
+
+import (
+	"github.com/profclems/glab/commands/cmdutils"
+	worktreeListCmd "github.com/profclems/glab/commands/mr/worktree/list"
+	worktreePruneCmd "github.com/profclems/glab/commands/mr/worktree/prune"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdWorktree(f *cmdutils.Factory) *cobra.Command {
+	var mrWorktreeCmd = &cobra.Command{
+		Use:   "worktree <command> [flags]",
+		Short: `Manage the git worktrees of merge requests`,
+		Long: heredoc.Doc(`
+			Manage the git worktrees created for merge requests.
+
+			Merge requests are checked out in a worktree with "glab mr checkout --worktree".
+		`),
+	}
+
+	mrWorktreeCmd.AddCommand(worktreeListCmd.NewCmdList(f, nil))
+	mrWorktreeCmd.AddCommand(worktreePruneCmd.NewCmdPrune(f, nil))
+	return mrWorktreeCmd
+}
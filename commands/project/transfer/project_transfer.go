@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/MakeNowJust/heredoc"
+	"github.com/profclems/glab/api"
 	"github.com/profclems/glab/commands/cmdutils"
 	"github.com/spf13/cobra"
 	"github.com/xanzy/go-gitlab"
@@ -48,19 +49,7 @@ func NewCmdTransfer(f *cmdutils.Factory) *cobra.Command {
 			}
 
 			c := f.IO.Color()
-			fmt.Printf(heredoc.Doc(`
-				🔴 Danger 🔴
-
-				The operation you are about to perform is potentially irreversible.
-				You will lose control of the repository you are transferring in case you do not
-				have access to the target namespace. In addition, you won't be able to transfer
-				the repository back to the original namespace unless you have administrative access
-				to the target namespace.
-
-				Source repository: %s
-				Target namespace: %s
-
-			`), c.Yellow(repo.FullName()), c.Yellow(targetNamespace))
+			cmdutils.PrintTransferWarning(f.IO, []string{repo.FullName()}, targetNamespace)
 
 			if !dontPromptForConfirmation {
 				err = cmdutils.ConfirmTransfer()
@@ -72,7 +61,7 @@ func NewCmdTransfer(f *cmdutils.Factory) *cobra.Command {
 			opt := &gitlab.TransferProjectOptions{}
 			opt.Namespace = targetNamespace
 
-			project, _, err := apiClient.Projects.TransferProject(repo.FullName(), opt)
+			project, err := api.TransferProject(apiClient, repo.FullName(), opt)
 			if err != nil {
 				return err
 			}
@@ -7,18 +7,27 @@ import (
 	aliasCmd "github.com/profclems/glab/commands/alias"
 	apiCmd "github.com/profclems/glab/commands/api"
 	authCmd "github.com/profclems/glab/commands/auth"
+	branchCmd "github.com/profclems/glab/commands/branch"
 	pipelineCmd "github.com/profclems/glab/commands/ci"
 	"github.com/profclems/glab/commands/cmdutils"
 	completionCmd "github.com/profclems/glab/commands/completion"
 	configCmd "github.com/profclems/glab/commands/config"
+	deployKeyCmd "github.com/profclems/glab/commands/deploy-key"
+	deployTokenCmd "github.com/profclems/glab/commands/deploy-token"
+	gpgKeyCmd "github.com/profclems/glab/commands/gpg-key"
+	groupCmd "github.com/profclems/glab/commands/group"
 	"github.com/profclems/glab/commands/help"
 	issueCmd "github.com/profclems/glab/commands/issue"
 	labelCmd "github.com/profclems/glab/commands/label"
+	memberCmd "github.com/profclems/glab/commands/member"
 	mrCmd "github.com/profclems/glab/commands/mr"
 	projectCmd "github.com/profclems/glab/commands/project"
 	releaseCmd "github.com/profclems/glab/commands/release"
 	snippetCmd "github.com/profclems/glab/commands/snippet"
 	sshCmd "github.com/profclems/glab/commands/ssh-key"
+	stackCmd "github.com/profclems/glab/commands/stack"
+	timesheetCmd "github.com/profclems/glab/commands/timesheet"
+	tokenCmd "github.com/profclems/glab/commands/token"
 	updateCmd "github.com/profclems/glab/commands/update"
 	userCmd "github.com/profclems/glab/commands/user"
 	variableCmd "github.com/profclems/glab/commands/variable"
@@ -104,13 +113,22 @@ func NewCmdRoot(f *cmdutils.Factory, version, buildDate string) *cobra.Command {
 	f.BaseRepo = resolvedBaseRepo(f)
 	cmdutils.HTTPClientFactory(f) // Initialize HTTP Client
 
+	rootCmd.AddCommand(branchCmd.NewCmdBranch(f))
+	rootCmd.AddCommand(deployKeyCmd.NewCmdDeployKey(f))
+	rootCmd.AddCommand(deployTokenCmd.NewCmdDeployToken(f))
+	rootCmd.AddCommand(gpgKeyCmd.NewCmdGPGKey(f))
+	rootCmd.AddCommand(groupCmd.NewCmdGroup(f))
 	rootCmd.AddCommand(issueCmd.NewCmdIssue(f))
 	rootCmd.AddCommand(labelCmd.NewCmdLabel(f))
+	rootCmd.AddCommand(memberCmd.NewCmdMember(f))
 	rootCmd.AddCommand(mrCmd.NewCmdMR(f))
 	rootCmd.AddCommand(pipelineCmd.NewCmdCI(f))
 	rootCmd.AddCommand(projectCmd.NewCmdRepo(f))
 	rootCmd.AddCommand(releaseCmd.NewCmdRelease(f))
 	rootCmd.AddCommand(sshCmd.NewCmdSSHKey(f))
+	rootCmd.AddCommand(stackCmd.NewCmdStack(f))
+	rootCmd.AddCommand(timesheetCmd.NewCmdTimesheet(f, nil))
+	rootCmd.AddCommand(tokenCmd.NewCmdToken(f))
 	rootCmd.AddCommand(userCmd.NewCmdUser(f))
 	rootCmd.AddCommand(variableCmd.NewVariableCmd(f))
 	rootCmd.AddCommand(apiCmd.NewCmdApi(f, nil))
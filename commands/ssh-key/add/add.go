@@ -2,14 +2,16 @@ package add
 
 import (
 	"errors"
-	"io"
-	"io/ioutil"
-	"os"
+	"fmt"
+	"strings"
 
 	"github.com/MakeNowJust/heredoc"
+	"github.com/profclems/glab/api"
 	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/commands/ssh-key/sshkeyutils"
 	"github.com/profclems/glab/internal/glrepo"
 	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/profclems/glab/pkg/utils"
 	"github.com/spf13/cobra"
 	"github.com/xanzy/go-gitlab"
 )
@@ -19,13 +21,17 @@ type AddOpts struct {
 	IO         *iostreams.IOStreams
 	BaseRepo   func() (glrepo.Interface, error)
 
-	Title     string
-	Key       string
-	ExpiresAt string
+	Title      string
+	Key        string
+	ExpiresAt  string
+	UsageType  string
+	SigningKey bool
 
 	KeyFile string
 }
 
+var usageTypes = []string{api.SSHKeyUsageAuth, api.SSHKeyUsageSigning, api.SSHKeyUsageAuthAndSigning}
+
 func NewCmdAdd(f *cmdutils.Factory, runE func(*AddOpts) error) *cobra.Command {
 	opts := &AddOpts{
 		IO: f.IO,
@@ -36,7 +42,11 @@ func NewCmdAdd(f *cmdutils.Factory, runE func(*AddOpts) error) *cobra.Command {
 		Long: heredoc.Doc(`
 		Creates a new SSH key owned by the currently authenticated user.
 
-		The --title flag is always required
+		The --title flag is always required.
+
+		Keys are used for authentication and commit signing by default. Use --usage-type
+		to restrict a key to one of them. With --signing-key, the SSH key configured
+		as user.signingkey in git is added as a signing key.
 		`),
 		Example: heredoc.Doc(`
 		# Read ssh key from stdin and upload
@@ -44,13 +54,27 @@ func NewCmdAdd(f *cmdutils.Factory, runE func(*AddOpts) error) *cobra.Command {
 
 		# Read ssh key from specified key file and upload
 		$ glab ssh-key add ~/.ssh/id_ed25519.pub -t "my title"
+
+		# Upload the ssh key git signs commits with
+		$ glab ssh-key add --signing-key -t "signing key"
 		`),
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.HTTPClient = f.HttpClient
 			opts.BaseRepo = f.BaseRepo
 
-			if len(args) == 0 {
+			if opts.UsageType != "" && !utils.PresentInStringSlice(usageTypes, opts.UsageType) {
+				return &cmdutils.FlagError{Err: fmt.Errorf("invalid usage type %q. Must be one of: %s", opts.UsageType, strings.Join(usageTypes, ", "))}
+			}
+
+			if opts.SigningKey {
+				if len(args) > 0 {
+					return &cmdutils.FlagError{Err: errors.New("a key file cannot be specified with --signing-key")}
+				}
+				if opts.UsageType == "" {
+					opts.UsageType = api.SSHKeyUsageSigning
+				}
+			} else if len(args) == 0 {
 				if opts.IO.IsOutputTTY() && opts.IO.IsInTTY {
 					return &cmdutils.FlagError{Err: errors.New("missing key file")}
 				}
@@ -69,6 +93,8 @@ func NewCmdAdd(f *cmdutils.Factory, runE func(*AddOpts) error) *cobra.Command {
 
 	cmd.Flags().StringVarP(&opts.Title, "title", "t", "", "New SSH key's title")
 	cmd.Flags().StringVarP(&opts.ExpiresAt, "expires-at", "e", "", "The expiration date of the SSH key in ISO 8601 format (YYYY-MM-DDTHH:MM:SSZ)")
+	cmd.Flags().StringVarP(&opts.UsageType, "usage-type", "u", "", "Usage of the key: auth, signing or auth_and_signing (default auth_and_signing)")
+	cmd.Flags().BoolVarP(&opts.SigningKey, "signing-key", "", false, "Add the SSH key configured as user.signingkey in git")
 
 	_ = cmd.MarkFlagRequired("title")
 
@@ -81,28 +107,20 @@ func addRun(opts *AddOpts) error {
 		return err
 	}
 
-	var keyFileReader io.Reader
-	if opts.KeyFile == "-" {
-		keyFileReader = opts.IO.In
-		defer opts.IO.In.Close()
+	if opts.SigningKey {
+		key, err := sshkeyutils.SigningKey()
+		if err != nil {
+			return err
+		}
+		opts.Key = key.String()
 	} else {
-		f, err := os.Open(opts.KeyFile)
+		opts.Key, err = sshkeyutils.ReadKeyFile(opts.IO, opts.KeyFile)
 		if err != nil {
 			return err
 		}
-		defer f.Close()
-
-		keyFileReader = f
 	}
 
-	keyInBytes, err := ioutil.ReadAll(keyFileReader)
-	if err != nil {
-		return cmdutils.WrapError(err, "failed to read ssh key file")
-	}
-
-	opts.Key = string(keyInBytes)
-
-	err = UploadSSHKey(httpClient, opts.Title, opts.Key, opts.ExpiresAt)
+	err = UploadSSHKey(httpClient, opts.Title, opts.Key, opts.ExpiresAt, opts.UsageType)
 	if err != nil {
 		return cmdutils.WrapError(err, "failed to add new ssh public key")
 	}
@@ -3,11 +3,12 @@ package add
 import (
 	"time"
 
+	"github.com/profclems/glab/api"
 	"github.com/xanzy/go-gitlab"
 )
 
-func UploadSSHKey(client *gitlab.Client, title, key, expiresAt string) error {
-	sshKeyAddOptions := &gitlab.AddSSHKeyOptions{
+func UploadSSHKey(client *gitlab.Client, title, key, expiresAt, usageType string) error {
+	sshKeyAddOptions := &api.AddSSHKeyOptions{
 		Title: &title,
 		Key:   &key,
 	}
@@ -20,6 +21,10 @@ func UploadSSHKey(client *gitlab.Client, title, key, expiresAt string) error {
 		sshKeyAddOptions.ExpiresAt = (*gitlab.ISOTime)(&expiresAt)
 	}
 
-	_, _, err := client.Users.AddSSHKey(sshKeyAddOptions)
+	if usageType != "" {
+		sshKeyAddOptions.UsageType = &usageType
+	}
+
+	_, err := api.AddSSHKey(client, sshKeyAddOptions)
 	return err
 }
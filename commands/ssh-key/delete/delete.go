@@ -0,0 +1,99 @@
+package delete
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/profclems/glab/pkg/prompt"
+	"github.com/profclems/glab/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type DeleteOpts struct {
+	HTTPClient func() (*gitlab.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+
+	KeyID       int
+	ForceDelete bool
+}
+
+func NewCmdDelete(f *cmdutils.Factory, runE func(*DeleteOpts) error) *cobra.Command {
+	opts := &DeleteOpts{
+		IO: f.IO,
+	}
+	cmd := &cobra.Command{
+		Use:     "delete <key-id>",
+		Short:   "Deletes a single SSH key",
+		Long:    "Deletes an SSH key specified by the ID from your GitLab account",
+		Aliases: []string{"rm"},
+		Example: heredoc.Doc(`
+		$ glab ssh-key delete 7750633
+		$ glab ssh-key delete 7750633 --yes
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.HTTPClient = f.HttpClient
+			opts.BaseRepo = f.BaseRepo
+
+			opts.KeyID = utils.StringToInt(args[0])
+			if opts.KeyID == 0 {
+				return &cmdutils.FlagError{Err: fmt.Errorf("invalid key ID %q", args[0])}
+			}
+
+			if !opts.ForceDelete && !opts.IO.PromptEnabled() {
+				return &cmdutils.FlagError{Err: errors.New("--yes or -y flag is required when not running interactively")}
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return deleteRun(opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.ForceDelete, "yes", "y", false, "Skip the confirmation prompt")
+
+	return cmd
+}
+
+func deleteRun(opts *DeleteOpts) error {
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	key, _, err := httpClient.Users.GetSSHKey(opts.KeyID)
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to get ssh key")
+	}
+
+	if !opts.ForceDelete && opts.IO.PromptEnabled() {
+		err = prompt.Confirm(&opts.ForceDelete, fmt.Sprintf("Delete SSH key %q from your account?", key.Title), false)
+		if err != nil {
+			return cmdutils.WrapError(err, "could not prompt")
+		}
+	}
+
+	if !opts.ForceDelete {
+		return cmdutils.CancelError()
+	}
+
+	_, err = httpClient.Users.DeleteSSHKey(opts.KeyID)
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to delete ssh key")
+	}
+
+	if opts.IO.IsOutputTTY() {
+		cs := opts.IO.Color()
+		opts.IO.Logf("%s SSH key %q deleted from your account\n", cs.RedCheck(), key.Title)
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+package generate
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/commands/ssh-key/add"
+	"github.com/profclems/glab/commands/ssh-key/sshkeyutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/internal/run"
+	"github.com/profclems/glab/pkg/git"
+	"github.com/profclems/glab/pkg/glinstance"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type GenerateOpts struct {
+	HTTPClient func() (*gitlab.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+
+	Title        string
+	KeyFile      string
+	ExpiresAt    string
+	NoPassphrase bool
+	SSHConfig    bool
+}
+
+func NewCmdGenerate(f *cmdutils.Factory, runE func(*GenerateOpts) error) *cobra.Command {
+	opts := &GenerateOpts{
+		IO: f.IO,
+	}
+	cmd := &cobra.Command{
+		Use:   "generate",
+		Short: "Generate an SSH key and add it to your GitLab account",
+		Long: heredoc.Doc(`
+		Generates a new ed25519 SSH key pair with ssh-keygen and adds the public key to your GitLab account.
+
+		The key is written to ~/.ssh/id_ed25519_<hostname> unless --file is given.
+		With --ssh-config, a Host entry using the new key for the GitLab instance is added
+		to ~/.ssh/config, unless the host is already configured there.
+		`),
+		Example: heredoc.Doc(`
+		$ glab ssh-key generate
+		$ glab ssh-key generate -t "work laptop" --expires-at 2022-12-31 --ssh-config
+		$ glab ssh-key generate -f ~/.ssh/gitlab_ci --no-passphrase
+		`),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.HTTPClient = f.HttpClient
+			opts.BaseRepo = f.BaseRepo
+
+			if !opts.NoPassphrase && !opts.IO.IsInTTY {
+				return &cmdutils.FlagError{Err: errors.New("--no-passphrase is required when not running interactively")}
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return generateRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Title, "title", "t", "", "New SSH key's title. Defaults to glab@<hostname of this machine>")
+	cmd.Flags().StringVarP(&opts.KeyFile, "file", "f", "", "Path of the private key file to create")
+	cmd.Flags().StringVarP(&opts.ExpiresAt, "expires-at", "e", "", "The expiration date of the SSH key in ISO 8601 format (YYYY-MM-DDTHH:MM:SSZ)")
+	cmd.Flags().BoolVarP(&opts.NoPassphrase, "no-passphrase", "", false, "Generate a key without a passphrase")
+	cmd.Flags().BoolVarP(&opts.SSHConfig, "ssh-config", "", false, "Add a Host entry using the new key to ~/.ssh/config")
+
+	return cmd
+}
+
+func generateRun(opts *GenerateOpts) error {
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	host := glinstance.OverridableDefault()
+	if repo, err := opts.BaseRepo(); err == nil {
+		host = repo.RepoHost()
+	}
+
+	if opts.KeyFile == "" {
+		sshDir, err := sshkeyutils.SSHDir()
+		if err != nil {
+			return err
+		}
+		opts.KeyFile = filepath.Join(sshDir, "id_ed25519_"+host)
+	}
+	if opts.Title == "" {
+		hostname, _ := os.Hostname()
+		opts.Title = "glab@" + hostname
+	}
+
+	if _, err := os.Stat(opts.KeyFile); err == nil {
+		return fmt.Errorf("%s already exists. Use --file to choose another path", opts.KeyFile)
+	}
+	if err := os.MkdirAll(filepath.Dir(opts.KeyFile), 0700); err != nil {
+		return err
+	}
+
+	args := []string{"-t", "ed25519", "-C", opts.Title, "-f", opts.KeyFile}
+	if opts.NoPassphrase {
+		args = append(args, "-N", "")
+	}
+	keygen := exec.Command("ssh-keygen", args...)
+	keygen.Stdin = opts.IO.In
+	keygen.Stdout = opts.IO.StdErr
+	keygen.Stderr = opts.IO.StdErr
+	if err := run.PrepareCmd(keygen).Run(); err != nil {
+		return cmdutils.WrapError(err, "failed to generate ssh key with ssh-keygen")
+	}
+
+	publicKey, err := ioutil.ReadFile(opts.KeyFile + ".pub")
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to read generated public key")
+	}
+
+	err = add.UploadSSHKey(httpClient, opts.Title, string(publicKey), opts.ExpiresAt, "")
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to add new ssh public key")
+	}
+
+	cs := opts.IO.Color()
+	opts.IO.Logf("%s New SSH public key %s added to your account\n", cs.GreenCheck(), opts.KeyFile+".pub")
+
+	if !opts.SSHConfig {
+		return nil
+	}
+
+	if git.SSHConfigHasHost(host) {
+		opts.IO.Logf("%s %s is already configured in your SSH config. Add %s to its entry to use the new key\n",
+			cs.WarnIcon(), host, cs.Bold("IdentityFile "+opts.KeyFile))
+		return nil
+	}
+
+	configFile, err := git.AddSSHConfigHost(host, opts.KeyFile)
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to update ssh config")
+	}
+	opts.IO.Logf("%s Added a Host entry for %s to %s\n", cs.GreenCheck(), host, configFile)
+
+	return nil
+}
@@ -3,6 +3,7 @@ package list
 import (
 	"github.com/MakeNowJust/heredoc"
 	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/commands/ssh-key/sshkeyutils"
 	"github.com/profclems/glab/internal/glrepo"
 	"github.com/profclems/glab/pkg/iostreams"
 	"github.com/profclems/glab/pkg/tableprinter"
@@ -17,6 +18,7 @@ type ListOpts struct {
 	BaseRepo   func() (glrepo.Interface, error)
 
 	ShowKeyIDs bool
+	Local      bool
 }
 
 func NewCmdList(f *cmdutils.Factory, runE func(*ListOpts) error) *cobra.Command {
@@ -29,6 +31,9 @@ func NewCmdList(f *cmdutils.Factory, runE func(*ListOpts) error) *cobra.Command
 		Long:  "Get a list of currently authenticated user’s SSH keys",
 		Example: heredoc.Doc(`
 		$ glab ssh-key list
+
+		# Show which public keys in ~/.ssh are not registered with your account
+		$ glab ssh-key list --local
 		`),
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -44,6 +49,7 @@ func NewCmdList(f *cmdutils.Factory, runE func(*ListOpts) error) *cobra.Command
 	}
 
 	cmd.Flags().BoolVarP(&opts.ShowKeyIDs, "show-id", "", false, "Show IDs of SSH Keys")
+	cmd.Flags().BoolVarP(&opts.Local, "local", "l", false, "List the public keys in ~/.ssh and whether they are registered with your account")
 
 	return cmd
 }
@@ -59,6 +65,10 @@ func listRun(opts *ListOpts) error {
 		return cmdutils.WrapError(err, "failed to get ssh keys")
 	}
 
+	if opts.Local {
+		return listLocalKeys(opts, keys)
+	}
+
 	cs := opts.IO.Color()
 	table := tableprinter.NewTablePrinter()
 	isTTy := opts.IO.IsOutputTTY()
@@ -78,3 +88,53 @@ func listRun(opts *ListOpts) error {
 
 	return nil
 }
+
+func listLocalKeys(opts *ListOpts, registered []*gitlab.SSHKey) error {
+	sshDir, err := sshkeyutils.SSHDir()
+	if err != nil {
+		return err
+	}
+
+	localKeys, err := sshkeyutils.LocalPublicKeys(sshDir)
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to read local ssh keys")
+	}
+
+	if len(localKeys) == 0 {
+		opts.IO.Logf("No public keys found in %s\n", sshDir)
+		return nil
+	}
+
+	cs := opts.IO.Color()
+	table := tableprinter.NewTablePrinter()
+	unregistered := 0
+
+	for _, localKey := range localKeys {
+		status := cs.Yellow("not registered")
+		if key := findRegisteredKey(registered, localKey); key != nil {
+			status = cs.Green("registered as " + key.Title)
+		} else {
+			unregistered++
+		}
+
+		table.AddRow(localKey.Path, localKey.Type, localKey.Fingerprint(), status)
+	}
+
+	opts.IO.LogInfo(table.String())
+
+	if unregistered > 0 && opts.IO.IsOutputTTY() {
+		opts.IO.Logf("%s %s not registered. Run %s to register a key\n",
+			cs.WarnIcon(), utils.Pluralize(unregistered, "key"), cs.Bold("glab ssh-key add <key-file> -t <title>"))
+	}
+
+	return nil
+}
+
+func findRegisteredKey(registered []*gitlab.SSHKey, localKey *sshkeyutils.PublicKey) *gitlab.SSHKey {
+	for _, key := range registered {
+		if k, err := sshkeyutils.ParsePublicKey(key.Key); err == nil && k.Equal(localKey) {
+			return key
+		}
+	}
+	return nil
+}
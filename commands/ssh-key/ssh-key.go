@@ -3,6 +3,8 @@ package ssh
 import (
 	"github.com/profclems/glab/commands/cmdutils"
 	cmdAdd "github.com/profclems/glab/commands/ssh-key/add"
+	cmdDelete "github.com/profclems/glab/commands/ssh-key/delete"
+	cmdGenerate "github.com/profclems/glab/commands/ssh-key/generate"
 	cmdGet "github.com/profclems/glab/commands/ssh-key/get"
 	cmdList "github.com/profclems/glab/commands/ssh-key/list"
 	"github.com/spf13/cobra"
@@ -20,6 +22,8 @@ func NewCmdSSHKey(f *cmdutils.Factory) *cobra.Command {
 	cmd.AddCommand(cmdAdd.NewCmdAdd(f, nil))
 	cmd.AddCommand(cmdGet.NewCmdGet(f, nil))
 	cmd.AddCommand(cmdList.NewCmdList(f, nil))
+	cmd.AddCommand(cmdDelete.NewCmdDelete(f, nil))
+	cmd.AddCommand(cmdGenerate.NewCmdGenerate(f, nil))
 
 	return cmd
 }
@@ -0,0 +1,169 @@
+package sshkeyutils
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/mitchellh/go-homedir"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/pkg/git"
+	"github.com/profclems/glab/pkg/iostreams"
+)
+
+// PublicKey is an SSH public key in the authorized_keys format
+type PublicKey struct {
+	Type    string
+	Blob    string
+	Comment string
+
+	// Path is the file the key was read from, if any
+	Path string
+}
+
+// ParsePublicKey parses a public key in the authorized_keys format: "<type> <base64 blob> [comment]"
+func ParsePublicKey(s string) (*PublicKey, error) {
+	fields := strings.Fields(strings.TrimSpace(s))
+	if len(fields) < 2 {
+		return nil, fmt.Errorf("invalid public key: expected \"<type> <key> [comment]\"")
+	}
+	if _, err := base64.StdEncoding.DecodeString(fields[1]); err != nil {
+		return nil, fmt.Errorf("invalid public key: %w", err)
+	}
+
+	return &PublicKey{
+		Type:    fields[0],
+		Blob:    fields[1],
+		Comment: strings.Join(fields[2:], " "),
+	}, nil
+}
+
+// Fingerprint returns the SHA256 fingerprint of the key as displayed by ssh-keygen -l
+func (k *PublicKey) Fingerprint() string {
+	blob, _ := base64.StdEncoding.DecodeString(k.Blob)
+	sum := sha256.Sum256(blob)
+	return "SHA256:" + base64.RawStdEncoding.EncodeToString(sum[:])
+}
+
+// Equal reports whether both keys have the same key material, regardless of their comments
+func (k *PublicKey) Equal(other *PublicKey) bool {
+	return k.Type == other.Type && k.Blob == other.Blob
+}
+
+// SSHDir returns the SSH directory of the current user
+func SSHDir() (string, error) {
+	homeDir, err := homedir.Dir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(homeDir, ".ssh"), nil
+}
+
+// LocalPublicKeys returns the public keys found in the *.pub files of dir.
+// Files which do not contain a valid public key are skipped.
+func LocalPublicKeys(dir string) ([]*PublicKey, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.pub"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+
+	var keys []*PublicKey
+	for _, path := range paths {
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			continue
+		}
+		key, err := ParsePublicKey(string(b))
+		if err != nil {
+			continue
+		}
+		key.Path = path
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+// SigningKey returns the SSH public key configured as user.signingkey for signing commits
+func SigningKey() (*PublicKey, error) {
+	value, err := git.Config("user.signingkey")
+	if err != nil || value == "" {
+		return nil, errors.New("no signing key configured. Set one with `git config user.signingkey <key>`")
+	}
+	return ReadSigningKey(value)
+}
+
+// ReadSigningKey parses the value of user.signingkey, which is either a literal
+// public key, optionally prefixed with "key::", or the path to a public or private key file.
+// For a private key file, the public key is read from the accompanying .pub file.
+func ReadSigningKey(value string) (*PublicKey, error) {
+	value = strings.TrimPrefix(value, "key::")
+	if strings.HasPrefix(value, "ssh-") || strings.HasPrefix(value, "ecdsa-") || strings.HasPrefix(value, "sk-") {
+		return ParsePublicKey(value)
+	}
+
+	path, err := homedir.Expand(value)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasSuffix(path, ".pub") {
+		if _, err := os.Stat(path + ".pub"); err == nil {
+			path += ".pub"
+		}
+	}
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("user.signingkey %q is neither an SSH public key nor a key file. Use `glab gpg-key add --signing-key` for GPG keys", value)
+		}
+		return nil, err
+	}
+
+	key, err := ParsePublicKey(string(b))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	key.Path = path
+	return key, nil
+}
+
+// String returns the key in the authorized_keys format
+func (k *PublicKey) String() string {
+	if k.Comment == "" {
+		return k.Type + " " + k.Blob
+	}
+	return k.Type + " " + k.Blob + " " + k.Comment
+}
+
+// ReadKeyFile reads a public key from the key file or from standard input when the key file is "-"
+func ReadKeyFile(streams *iostreams.IOStreams, keyFile string) (string, error) {
+	var keyFileReader io.Reader
+	if keyFile == "-" {
+		keyFileReader = streams.In
+		defer streams.In.Close()
+	} else {
+		f, err := os.Open(keyFile)
+		if err != nil {
+			return "", err
+		}
+		defer f.Close()
+
+		keyFileReader = f
+	}
+
+	keyInBytes, err := ioutil.ReadAll(keyFileReader)
+	if err != nil {
+		return "", cmdutils.WrapError(err, "failed to read key file")
+	}
+
+	return string(keyInBytes), nil
+}
@@ -0,0 +1,60 @@
+package sshkeyutils
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIDBEdXTtV6C5vJ6yDGNm3bD0PQWZE9fOZs3YzGhV4y5H"
+
+func Test_ParsePublicKey(t *testing.T) {
+	key, err := ParsePublicKey(testKey + " john@laptop\n")
+	require.NoError(t, err)
+	assert.Equal(t, "ssh-ed25519", key.Type)
+	assert.Equal(t, "john@laptop", key.Comment)
+	assert.Equal(t, "SHA256:", key.Fingerprint()[:7])
+
+	other, err := ParsePublicKey(testKey)
+	require.NoError(t, err)
+	assert.True(t, key.Equal(other))
+
+	_, err = ParsePublicKey("ssh-ed25519")
+	assert.Error(t, err)
+	_, err = ParsePublicKey("ssh-ed25519 not-base64!")
+	assert.Error(t, err)
+}
+
+func Test_LocalPublicKeys(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "id_ed25519.pub"), []byte(testKey+" me\n"), 0600))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "broken.pub"), []byte("garbage"), 0600))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "id_ed25519"), []byte("private"), 0600))
+
+	keys, err := LocalPublicKeys(dir)
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+	assert.Equal(t, filepath.Join(dir, "id_ed25519.pub"), keys[0].Path)
+}
+
+func Test_ReadSigningKey(t *testing.T) {
+	key, err := ReadSigningKey("key::" + testKey + " me")
+	require.NoError(t, err)
+	assert.Equal(t, testKey+" me", key.String())
+
+	dir := t.TempDir()
+	keyFile := filepath.Join(dir, "id_ed25519")
+	require.NoError(t, ioutil.WriteFile(keyFile, []byte("private"), 0600))
+	require.NoError(t, ioutil.WriteFile(keyFile+".pub", []byte(testKey+"\n"), 0600))
+
+	key, err = ReadSigningKey(keyFile)
+	require.NoError(t, err)
+	assert.Equal(t, keyFile+".pub", key.Path)
+	assert.Equal(t, testKey, key.String())
+
+	_, err = ReadSigningKey("3AA5C34371567BD2")
+	assert.EqualError(t, err, "user.signingkey \"3AA5C34371567BD2\" is neither an SSH public key nor a key file. Use `glab gpg-key add --signing-key` for GPG keys")
+}
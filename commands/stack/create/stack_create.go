@@ -0,0 +1,111 @@
+package create
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/commands/stack/stackutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/git"
+	"github.com/profclems/glab/pkg/iostreams"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+)
+
+type CreateOpts struct {
+	Base     string
+	Branches []string
+
+	IO       *iostreams.IOStreams
+	BaseRepo func() (glrepo.Interface, error)
+	Remotes  func() (glrepo.Remotes, error)
+}
+
+func NewCmdCreate(f *cmdutils.Factory, runE func(*CreateOpts) error) *cobra.Command {
+	opts := &CreateOpts{
+		IO: f.IO,
+	}
+
+	var stackCreateCmd = &cobra.Command{
+		Use:   "create <branch>... [flags]",
+		Short: `Record a chain of local branches as a stack`,
+		Long: heredoc.Doc(`
+			Record a chain of local branches as a stack.
+
+			Each branch is based on the one before it, and the first branch on the base branch,
+			which defaults to the default branch of the repository. Branches which are already
+			stacked are moved to their new place.
+		`),
+		Example: heredoc.Doc(`
+			$ glab stack create parser lexer cli
+			$ glab stack create parser lexer --base develop
+		`),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+			opts.Remotes = f.Remotes
+			opts.Branches = args
+
+			seen := map[string]bool{}
+			for _, branch := range opts.Branches {
+				if seen[branch] {
+					return &cmdutils.FlagError{Err: fmt.Errorf("branch %q is given more than once", branch)}
+				}
+				seen[branch] = true
+			}
+			if seen[opts.Base] {
+				return &cmdutils.FlagError{Err: fmt.Errorf("the base branch %q cannot be part of the stack", opts.Base)}
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return createRun(opts)
+		},
+	}
+
+	stackCreateCmd.Flags().StringVarP(&opts.Base, "base", "b", "", "The branch the stack is based on (default: the default branch of the repository)")
+
+	return stackCreateCmd
+}
+
+func createRun(opts *CreateOpts) error {
+	for _, branch := range opts.Branches {
+		if !git.HasLocalBranch(branch) {
+			return fmt.Errorf("branch %q does not exist", branch)
+		}
+	}
+
+	if opts.Base == "" {
+		repo, err := opts.BaseRepo()
+		if err != nil {
+			return err
+		}
+		remote, err := stackutils.Remote(opts.Remotes, repo)
+		if err != nil {
+			return err
+		}
+		opts.Base, err = git.GetDefaultBranch(remote.Name)
+		if err != nil {
+			return errors.New("could not determine the default branch of the repository. Specify the base branch with --base")
+		}
+	}
+
+	s := &stackutils.Stack{
+		Base:     opts.Base,
+		Branches: opts.Branches,
+	}
+	if err := stackutils.Save(s); err != nil {
+		return err
+	}
+
+	c := opts.IO.Color()
+	fmt.Fprintf(opts.IO.StdOut, "%s Created a stack based on %s:\n", c.GreenCheck(), s.Base)
+	for i, branch := range s.Branches {
+		fmt.Fprintf(opts.IO.StdOut, "%d. %s\n", i+1, branch)
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package list
+
+import (
+	"fmt"
+
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/commands/stack/stackutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/profclems/glab/pkg/tableprinter"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type ListOpts struct {
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+	HTTPClient func() (*gitlab.Client, error)
+	Branch     func() (string, error)
+}
+
+func NewCmdList(f *cmdutils.Factory, runE func(*ListOpts) error) *cobra.Command {
+	opts := &ListOpts{
+		IO: f.IO,
+	}
+
+	var stackListCmd = &cobra.Command{
+		Use:     "list",
+		Short:   `List the branches and merge requests of the current stack`,
+		Aliases: []string{"ls"},
+		Example: heredoc.Doc(`
+			$ glab stack list
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+			opts.HTTPClient = f.HttpClient
+			opts.Branch = f.Branch
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return listRun(opts)
+		},
+	}
+
+	return stackListCmd
+}
+
+func listRun(opts *ListOpts) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+	current, err := opts.Branch()
+	if err != nil {
+		return err
+	}
+
+	s, err := stackutils.Load(current)
+	if err != nil {
+		return err
+	}
+	mrs, err := stackutils.OpenMRs(apiClient, repo, s)
+	if err != nil {
+		return err
+	}
+
+	c := opts.IO.Color()
+	table := tableprinter.NewTablePrinter()
+	for i, branch := range s.Branches {
+		marker := " "
+		name := branch
+		if branch == current {
+			marker = "*"
+			name = c.Green(branch)
+		}
+
+		mrRef, title := c.Gray("no merge request"), ""
+		if mr, ok := mrs[branch]; ok {
+			mrRef = c.Cyan(fmt.Sprintf("!%d", mr.IID))
+			title = mr.Title
+			if mr.TargetBranch != s.Parent(branch) {
+				title += c.Yellow(fmt.Sprintf(" (targets %s)", mr.TargetBranch))
+			}
+		}
+		table.AddRow(fmt.Sprintf("%s %d.", marker, i+1), name, mrRef, title)
+	}
+
+	fmt.Fprintf(opts.IO.StdOut, "Stack based on %s\n%s", c.Bold(s.Base), table.String())
+	return nil
+}
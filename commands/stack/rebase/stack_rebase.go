@@ -0,0 +1,105 @@
+package rebase
+
+import (
+	"fmt"
+
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/commands/stack/stackutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/git"
+	"github.com/profclems/glab/pkg/iostreams"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+)
+
+type RebaseOpts struct {
+	NoPush bool
+
+	IO       *iostreams.IOStreams
+	BaseRepo func() (glrepo.Interface, error)
+	Remotes  func() (glrepo.Remotes, error)
+	Branch   func() (string, error)
+}
+
+func NewCmdRebase(f *cmdutils.Factory, runE func(*RebaseOpts) error) *cobra.Command {
+	opts := &RebaseOpts{
+		IO: f.IO,
+	}
+
+	var stackRebaseCmd = &cobra.Command{
+		Use:   "rebase [flags]",
+		Short: `Rebase every branch of the stack onto the branch before it`,
+		Long: heredoc.Doc(`
+			Rebase every branch of the current stack onto the branch before it, and the first
+			branch onto the latest base branch of the remote, then force-push the stack.
+
+			Run it after changing a branch of the stack, for example after amending a commit to
+			address review comments. When a rebase stops on conflicts, resolve them, run
+			"git rebase --continue" and then run "glab stack rebase" again.
+		`),
+		Example: heredoc.Doc(`
+			$ glab stack rebase
+			$ glab stack rebase --no-push
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+			opts.Remotes = f.Remotes
+			opts.Branch = f.Branch
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return rebaseRun(opts)
+		},
+	}
+
+	stackRebaseCmd.Flags().BoolVar(&opts.NoPush, "no-push", false, "Do not push the rebased branches")
+
+	return stackRebaseCmd
+}
+
+func rebaseRun(opts *RebaseOpts) error {
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+	current, err := opts.Branch()
+	if err != nil {
+		return err
+	}
+
+	s, err := stackutils.Load(current)
+	if err != nil {
+		return err
+	}
+	remote, err := stackutils.Remote(opts.Remotes, repo)
+	if err != nil {
+		return err
+	}
+
+	if err := git.Fetch(remote.Name, []string{s.Base}, opts.IO.StdOut, opts.IO.StdErr); err != nil {
+		return fmt.Errorf("failed to fetch %s: %w", s.Base, err)
+	}
+	upstreamBase := fmt.Sprintf("%s/%s", remote.Name, s.Base)
+	if err := stackutils.RebaseStack(s, upstreamBase, opts.IO.StdOut, opts.IO.StdErr); err != nil {
+		return err
+	}
+	if err := git.CheckoutBranch(current); err != nil {
+		return err
+	}
+
+	c := opts.IO.Color()
+	fmt.Fprintf(opts.IO.StdOut, "%s Rebased the stack onto %s\n", c.GreenCheck(), upstreamBase)
+	if opts.NoPush {
+		return nil
+	}
+
+	if err := stackutils.PushStack(s, remote.Name, opts.IO.StdOut, opts.IO.StdErr); err != nil {
+		return err
+	}
+	fmt.Fprintf(opts.IO.StdOut, "%s Pushed the stack to %s\n", c.GreenCheck(), remote.Name)
+	return nil
+}
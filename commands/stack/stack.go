@@ -0,0 +1,42 @@
+package stack
+
+import (
+	"github.com/profclems/glab/commands/cmdutils"
+	stackCreateCmd "github.com/profclems/glab/commands/stack/create"
+	stackListCmd "github.com/profclems/glab/commands/stack/list"
+	stackRebaseCmd "github.com/profclems/glab/commands/stack/rebase"
+	stackSubmitCmd "github.com/profclems/glab/commands/stack/submit"
+	stackSyncCmd "github.com/profclems/glab/commands/stack/sync"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdStack(f *cmdutils.Factory) *cobra.Command {
+	var stackCmd = &cobra.Command{
+		Use:   "stack <command> [flags]",
+		Short: `Work with stacks of dependent merge requests`,
+		Long: heredoc.Doc(`
+			Work with stacks of dependent merge requests.
+
+			A stack is a chain of local branches, each based on the one before it. Every branch
+			gets a merge request targeting the branch before it, so that each can be reviewed
+			on its own. The stack is recorded in the git config of the repository.
+		`),
+		Example: heredoc.Doc(`
+			$ glab stack create parser lexer cli
+			$ glab stack submit
+			$ glab stack rebase
+			$ glab stack sync
+		`),
+	}
+
+	cmdutils.EnableRepoOverride(stackCmd, f)
+
+	stackCmd.AddCommand(stackCreateCmd.NewCmdCreate(f, nil))
+	stackCmd.AddCommand(stackListCmd.NewCmdList(f, nil))
+	stackCmd.AddCommand(stackRebaseCmd.NewCmdRebase(f, nil))
+	stackCmd.AddCommand(stackSubmitCmd.NewCmdSubmit(f, nil))
+	stackCmd.AddCommand(stackSyncCmd.NewCmdSync(f, nil))
+	return stackCmd
+}
@@ -0,0 +1,182 @@
+package stackutils
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/git"
+	"github.com/xanzy/go-gitlab"
+)
+
+const (
+	navigationStart = "<!-- glab-stack -->"
+	navigationEnd   = "<!-- /glab-stack -->"
+)
+
+var navigationRE = regexp.MustCompile(`(?s)\s*` + navigationStart + `.*` + navigationEnd + `\s*`)
+
+// Navigation renders the list of merge requests of the stack which is kept in their
+// descriptions. The merge request of the current branch is highlighted.
+func Navigation(s *Stack, mrs map[string]*gitlab.MergeRequest, current string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s\n**Stack** based on `%s`:\n\n", navigationStart, s.Base)
+	for _, branch := range s.Branches {
+		ref := fmt.Sprintf("`%s`", branch)
+		if mr, ok := mrs[branch]; ok {
+			ref = fmt.Sprintf("!%d", mr.IID)
+		}
+		if branch == current {
+			ref = fmt.Sprintf("**%s** 👈 this merge request", ref)
+		}
+		fmt.Fprintf(&b, "1. %s\n", ref)
+	}
+	b.WriteString(navigationEnd)
+	return b.String()
+}
+
+// Annotate replaces the stack navigation of a merge request description, or appends it
+// when the description has none
+func Annotate(description, navigation string) string {
+	description = strings.TrimSpace(navigationRE.ReplaceAllString(description, "\n\n"))
+	if description == "" {
+		return navigation
+	}
+	return description + "\n\n" + navigation
+}
+
+// FindMR returns the most recently updated merge request of the branch in the given
+// state, or nil when there is none
+func FindMR(client *gitlab.Client, repo glrepo.Interface, branch, state string) (*gitlab.MergeRequest, error) {
+	mrs, err := api.ListMRs(client, repo.FullName(), &gitlab.ListProjectMergeRequestsOptions{
+		SourceBranch: gitlab.String(branch),
+		State:        gitlab.String(state),
+		OrderBy:      gitlab.String("updated_at"),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find the merge request of %q: %w", branch, err)
+	}
+	if len(mrs) == 0 {
+		return nil, nil
+	}
+	return mrs[0], nil
+}
+
+// OpenMRs returns the open merge requests of the branches of the stack, keyed by branch
+func OpenMRs(client *gitlab.Client, repo glrepo.Interface, s *Stack) (map[string]*gitlab.MergeRequest, error) {
+	mrs := map[string]*gitlab.MergeRequest{}
+	for _, branch := range s.Branches {
+		mr, err := FindMR(client, repo, branch, "opened")
+		if err != nil {
+			return nil, err
+		}
+		if mr != nil {
+			mrs[branch] = mr
+		}
+	}
+	return mrs, nil
+}
+
+// UpdateNavigation rewrites the stack navigation in the description of every merge
+// request of the stack, skipping the ones which are up to date
+func UpdateNavigation(client *gitlab.Client, repo glrepo.Interface, s *Stack, mrs map[string]*gitlab.MergeRequest) error {
+	for _, branch := range s.Branches {
+		mr, ok := mrs[branch]
+		if !ok {
+			continue
+		}
+		description := Annotate(mr.Description, Navigation(s, mrs, branch))
+		if description == mr.Description {
+			continue
+		}
+		updated, err := api.UpdateMR(client, repo.FullName(), mr.IID, &gitlab.UpdateMergeRequestOptions{
+			Description: gitlab.String(description),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update the description of !%d: %w", mr.IID, err)
+		}
+		mrs[branch] = updated
+	}
+	return nil
+}
+
+// Remote returns the git remote of the repository
+func Remote(remotesFn func() (glrepo.Remotes, error), repo glrepo.Interface) (*glrepo.Remote, error) {
+	remotes, err := remotesFn()
+	if err != nil {
+		return nil, err
+	}
+	return remotes.FindByRepo(repo.RepoOwner(), repo.RepoName())
+}
+
+// RebaseStack rebases every branch of the stack onto its parent in order, the first one
+// onto upstreamBase. Branches whose parent was removed from the stack by `glab stack sync`
+// only have their own commits moved, and the record of the removed parent is dropped once
+// they are rebased.
+func RebaseStack(s *Stack, upstreamBase string, cmdOut, cmdErr io.Writer) error {
+	previousParents, err := git.BranchConfigValues(PreviousParentConfigKey)
+	if err != nil {
+		return err
+	}
+
+	for i, branch := range s.Branches {
+		parent := upstreamBase
+		if i > 0 {
+			parent = s.Branches[i-1]
+		}
+
+		previous, ok := previousParents[branch]
+		onto := false
+		if ok {
+			previous, err = previousBase(previous, branch, upstreamBase)
+			if err != nil {
+				return fmt.Errorf("failed to find where %q was based on its previous parent: %w", branch, err)
+			}
+			// a branch whose rebase was resumed by hand is no longer based on the
+			// removed parent
+			onto, err = git.IsAncestor(previous, branch)
+			if err != nil {
+				return fmt.Errorf("failed to compare %q with %q: %w", branch, previous, err)
+			}
+		}
+
+		if onto {
+			err = git.RebaseOnto(parent, previous, branch, cmdOut, cmdErr)
+		} else {
+			err = git.Rebase(parent, branch, cmdOut, cmdErr)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to rebase %q onto %q: %w\nResolve the conflicts, run `git rebase --continue` and then `glab stack rebase` to rebase the rest of the stack", branch, parent, err)
+		}
+
+		if ok {
+			if err := git.UnsetBranchConfig(branch, PreviousParentConfigKey); err != nil {
+				return fmt.Errorf("failed to remove the previous parent of %q: %w", branch, err)
+			}
+		}
+	}
+	return nil
+}
+
+// previousBase returns the commit the branch was based on in its removed parent: the
+// recorded commit or branch when it is still in the repository, or else the merge-base of
+// the branch with upstreamBase.
+func previousBase(previous, branch, upstreamBase string) (string, error) {
+	if git.HasCommit(previous) {
+		return previous, nil
+	}
+	return git.MergeBase(branch, upstreamBase)
+}
+
+// PushStack force-pushes every branch of the stack to the remote
+func PushStack(s *Stack, remote string, cmdOut, cmdErr io.Writer) error {
+	for _, branch := range s.Branches {
+		if err := git.ForcePush(remote, branch, cmdOut, cmdErr); err != nil {
+			return fmt.Errorf("failed to push %q: %w", branch, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package stackutils
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/profclems/glab/pkg/git"
+)
+
+// ParentConfigKey is the `branch.BRANCH.*` git config which records the branch a stacked
+// branch is based on
+const ParentConfigKey = "glab-stack-parent"
+
+// PreviousParentConfigKey is the `branch.BRANCH.*` git config which records the last commit
+// of the parent a stacked branch had before that parent was merged and removed from the
+// stack. It is kept until the branch is rebased, so that an interrupted rebase can be
+// resumed even when the merged parent branch has been deleted.
+const PreviousParentConfigKey = "glab-stack-previous-parent"
+
+// Stack is a chain of branches, each based on the one before it. The first branch is
+// based on Base, which is not part of the stack.
+type Stack struct {
+	Base     string
+	Branches []string
+}
+
+// Parent returns the branch the given branch of the stack is based on
+func (s *Stack) Parent(branch string) string {
+	for i, b := range s.Branches {
+		if b != branch {
+			continue
+		}
+		if i == 0 {
+			return s.Base
+		}
+		return s.Branches[i-1]
+	}
+	return ""
+}
+
+// Contains reports whether the branch is part of the stack
+func (s *Stack) Contains(branch string) bool {
+	for _, b := range s.Branches {
+		if b == branch {
+			return true
+		}
+	}
+	return false
+}
+
+// Remove takes the branch out of the stack, so that the branch after it is based on
+// the parent of the removed branch
+func (s *Stack) Remove(branch string) {
+	for i, b := range s.Branches {
+		if b == branch {
+			s.Branches = append(s.Branches[:i:i], s.Branches[i+1:]...)
+			return
+		}
+	}
+}
+
+// Parent returns the branch the given branch is based on according to the recorded
+// stacks, or an empty string when the branch is not stacked
+func Parent(branch string) (string, error) {
+	parents, err := git.BranchConfigValues(ParentConfigKey)
+	if err != nil {
+		return "", err
+	}
+	return parents[branch], nil
+}
+
+// Load returns the recorded stack the branch is part of
+func Load(branch string) (*Stack, error) {
+	parents, err := git.BranchConfigValues(ParentConfigKey)
+	if err != nil {
+		return nil, err
+	}
+	return stackFromParents(parents, branch)
+}
+
+func stackFromParents(parents map[string]string, branch string) (*Stack, error) {
+	children := map[string][]string{}
+	for b, parent := range parents {
+		children[parent] = append(children[parent], b)
+	}
+	if _, ok := parents[branch]; !ok {
+		return nil, fmt.Errorf("branch %q is not part of a stack. Create one with `glab stack create`", branch)
+	}
+
+	// walk down to the first branch of the stack
+	bottom := branch
+	seen := map[string]bool{bottom: true}
+	for {
+		parent := parents[bottom]
+		if _, ok := parents[parent]; !ok {
+			break
+		}
+		if seen[parent] {
+			return nil, fmt.Errorf("the stack of %q has a cycle at %q", branch, parent)
+		}
+		seen[parent] = true
+		bottom = parent
+	}
+
+	s := &Stack{Base: parents[bottom]}
+	for b := bottom; ; {
+		s.Branches = append(s.Branches, b)
+		next := children[b]
+		if len(next) == 0 {
+			break
+		}
+		if len(next) > 1 {
+			sort.Strings(next)
+			return nil, fmt.Errorf("branch %q has more than one stacked branch: %s", b, strings.Join(next, ", "))
+		}
+		b = next[0]
+	}
+	return s, nil
+}
+
+// Save records the parent of every branch of the stack. Branches of the stack which were
+// recorded as based on another branch are rebased in the record only.
+func Save(s *Stack) error {
+	for i, branch := range s.Branches {
+		parent := s.Base
+		if i > 0 {
+			parent = s.Branches[i-1]
+		}
+		if err := git.SetBranchConfig(branch, ParentConfigKey, parent); err != nil {
+			return fmt.Errorf("failed to record the parent of %q: %w", branch, err)
+		}
+	}
+	return nil
+}
+
+// SavePreviousParent records the last commit of the parent the branch had before it was
+// removed from the stack
+func SavePreviousParent(branch, previous string) error {
+	if err := git.SetBranchConfig(branch, PreviousParentConfigKey, previous); err != nil {
+		return fmt.Errorf("failed to record the previous parent of %q: %w", branch, err)
+	}
+	return nil
+}
+
+// Forget removes the record of the branch being stacked
+func Forget(branch string) error {
+	return git.UnsetBranchConfig(branch, ParentConfigKey)
+}
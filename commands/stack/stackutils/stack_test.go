@@ -0,0 +1,139 @@
+package stackutils
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/profclems/glab/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xanzy/go-gitlab"
+)
+
+func Test_stackFromParents(t *testing.T) {
+	parents := map[string]string{
+		"parser": "main",
+		"lexer":  "parser",
+		"cli":    "lexer",
+		"docs":   "develop",
+	}
+
+	for _, branch := range []string{"parser", "lexer", "cli"} {
+		s, err := stackFromParents(parents, branch)
+		require.NoError(t, err)
+		assert.Equal(t, &Stack{Base: "main", Branches: []string{"parser", "lexer", "cli"}}, s)
+	}
+
+	s, err := stackFromParents(parents, "docs")
+	require.NoError(t, err)
+	assert.Equal(t, &Stack{Base: "develop", Branches: []string{"docs"}}, s)
+
+	_, err = stackFromParents(parents, "main")
+	assert.EqualError(t, err, "branch \"main\" is not part of a stack. Create one with `glab stack create`")
+
+	parents["tests"] = "parser"
+	_, err = stackFromParents(parents, "cli")
+	assert.EqualError(t, err, `branch "parser" has more than one stacked branch: lexer, tests`)
+
+	_, err = stackFromParents(map[string]string{"a": "b", "b": "a"}, "a")
+	assert.Error(t, err)
+}
+
+func TestStack_Remove(t *testing.T) {
+	s := &Stack{Base: "main", Branches: []string{"parser", "lexer", "cli"}}
+	assert.Equal(t, "parser", s.Parent("lexer"))
+
+	s.Remove("parser")
+	assert.Equal(t, []string{"lexer", "cli"}, s.Branches)
+	assert.Equal(t, "main", s.Parent("lexer"))
+	assert.False(t, s.Contains("parser"))
+	assert.Equal(t, "", s.Parent("parser"))
+}
+
+func TestAnnotate(t *testing.T) {
+	s := &Stack{Base: "main", Branches: []string{"parser", "lexer", "cli"}}
+	mrs := map[string]*gitlab.MergeRequest{
+		"parser": {IID: 12},
+		"lexer":  {IID: 13},
+	}
+
+	navigation := Navigation(s, mrs, "lexer")
+	assert.Equal(t, heredoc.Doc(`
+		<!-- glab-stack -->
+		**Stack** based on `+"`main`"+`:
+
+		1. !12
+		1. **!13** 👈 this merge request
+		1. `+"`cli`"+`
+		<!-- /glab-stack -->`), navigation)
+
+	annotated := Annotate("Adds the lexer.", navigation)
+	assert.Equal(t, "Adds the lexer.\n\n"+navigation, annotated)
+
+	updated := Navigation(s, map[string]*gitlab.MergeRequest{"lexer": {IID: 13}}, "lexer")
+	assert.Equal(t, "Adds the lexer.\n\n"+updated, Annotate(annotated, updated))
+	assert.Equal(t, annotated, Annotate(annotated, navigation))
+	assert.Equal(t, navigation, Annotate("", navigation))
+}
+
+func Test_RebaseStack(t *testing.T) {
+	cs, csTeardown := test.InitCmdStubber()
+	defer csTeardown()
+
+	cs.Stub("branch.lexer.glab-stack-previous-parent parser\n")  // git config --get-regexp
+	cs.Stub("")                                                  // git rev-parse --verify
+	cs.Stub("")                                                  // git merge-base --is-ancestor
+	cs.Stub("")                                                  // git rebase --onto
+	cs.Stub("")                                                  // git config --unset
+	cs.StubError("CONFLICT (content): Merge conflict in cli.go") // git rebase
+
+	s := &Stack{Base: "main", Branches: []string{"lexer", "cli"}}
+	err := RebaseStack(s, "origin/main", ioutil.Discard, ioutil.Discard)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `failed to rebase "cli" onto "lexer": `)
+	assert.Contains(t, err.Error(), "Merge conflict in cli.go")
+
+	var calls []string
+	for _, c := range cs.Calls {
+		calls = append(calls, strings.Join(c.Args[1:], " "))
+	}
+	assert.Equal(t, []string{
+		`config --get-regexp ^branch\..*\.glab-stack-previous-parent$`,
+		"rev-parse --verify --quiet parser^{commit}",
+		"merge-base --is-ancestor parser lexer",
+		"rebase --onto origin/main parser lexer",
+		"config --unset branch.lexer.glab-stack-previous-parent",
+		"rebase --fork-point lexer cli",
+	}, calls)
+}
+
+func Test_RebaseStack_previousParentDeleted(t *testing.T) {
+	cs, csTeardown := test.InitCmdStubber()
+	defer csTeardown()
+
+	cs.Stub("branch.lexer.glab-stack-previous-parent 4d5e6f7\n") // git config --get-regexp
+	cs.StubError("")                                             // git rev-parse --verify
+	cs.Stub("1a2b3c4\n")                                         // git merge-base
+	cs.Stub("")                                                  // git merge-base --is-ancestor
+	cs.Stub("")                                                  // git rebase --onto
+	cs.Stub("")                                                  // git config --unset
+
+	s := &Stack{Base: "main", Branches: []string{"lexer"}}
+	err := RebaseStack(s, "origin/main", ioutil.Discard, ioutil.Discard)
+	require.NoError(t, err)
+
+	var calls []string
+	for _, c := range cs.Calls {
+		calls = append(calls, strings.Join(c.Args[1:], " "))
+	}
+	assert.Equal(t, []string{
+		`config --get-regexp ^branch\..*\.glab-stack-previous-parent$`,
+		"rev-parse --verify --quiet 4d5e6f7^{commit}",
+		"merge-base lexer origin/main",
+		"merge-base --is-ancestor 1a2b3c4 lexer",
+		"rebase --onto origin/main 1a2b3c4 lexer",
+		"config --unset branch.lexer.glab-stack-previous-parent",
+	}, calls)
+}
@@ -0,0 +1,171 @@
+package submit
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/commands/stack/stackutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/git"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/profclems/glab/pkg/utils"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type SubmitOpts struct {
+	Draft bool
+
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+	HTTPClient func() (*gitlab.Client, error)
+	Remotes    func() (glrepo.Remotes, error)
+	Branch     func() (string, error)
+}
+
+func NewCmdSubmit(f *cmdutils.Factory, runE func(*SubmitOpts) error) *cobra.Command {
+	opts := &SubmitOpts{
+		IO: f.IO,
+	}
+
+	var stackSubmitCmd = &cobra.Command{
+		Use:   "submit [flags]",
+		Short: `Push the stack and create or update a merge request for every branch`,
+		Long: heredoc.Doc(`
+			Push every branch of the current stack and create or update a merge request for it.
+
+			The merge request of each branch targets the branch before it in the stack, and
+			merge requests which target another branch are retargeted. The description of every
+			merge request of the stack gets a list of the merge requests of the stack, which is
+			kept up to date by the stack commands.
+		`),
+		Example: heredoc.Doc(`
+			$ glab stack submit
+			$ glab stack submit --draft
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+			opts.HTTPClient = f.HttpClient
+			opts.Remotes = f.Remotes
+			opts.Branch = f.Branch
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return submitRun(opts)
+		},
+	}
+
+	stackSubmitCmd.Flags().BoolVarP(&opts.Draft, "draft", "d", false, "Mark the created merge requests as drafts")
+
+	return stackSubmitCmd
+}
+
+func submitRun(opts *SubmitOpts) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+	current, err := opts.Branch()
+	if err != nil {
+		return err
+	}
+
+	s, err := stackutils.Load(current)
+	if err != nil {
+		return err
+	}
+	remote, err := stackutils.Remote(opts.Remotes, repo)
+	if err != nil {
+		return err
+	}
+
+	if err := stackutils.PushStack(s, remote.Name, opts.IO.StdOut, opts.IO.StdErr); err != nil {
+		return err
+	}
+
+	mrs, err := stackutils.OpenMRs(apiClient, repo, s)
+	if err != nil {
+		return err
+	}
+
+	c := opts.IO.Color()
+	out := opts.IO.StdOut
+	for _, branch := range s.Branches {
+		parent := s.Parent(branch)
+
+		if mr, ok := mrs[branch]; ok {
+			if mr.TargetBranch == parent {
+				continue
+			}
+			mr, err = api.UpdateMR(apiClient, repo.FullName(), mr.IID, &gitlab.UpdateMergeRequestOptions{
+				TargetBranch: gitlab.String(parent),
+			})
+			if err != nil {
+				return cmdutils.WrapError(err, fmt.Sprintf("failed to retarget !%d", mrs[branch].IID))
+			}
+			mrs[branch] = mr
+			fmt.Fprintf(out, "%s Retargeted !%d to %s\n", c.GreenCheck(), mr.IID, parent)
+			continue
+		}
+
+		commits, err := git.Commits(parent, branch)
+		if err != nil || len(commits) == 0 {
+			fmt.Fprintf(opts.IO.StdErr, "%s Skipped %s, which has no commits on top of %s\n", c.WarnIcon(), branch, parent)
+			continue
+		}
+		title, description, err := titleAndDescription(branch, commits)
+		if err != nil {
+			return err
+		}
+		if opts.Draft {
+			title = "Draft: " + title
+		}
+
+		mr, err := api.CreateMR(apiClient, repo.FullName(), &gitlab.CreateMergeRequestOptions{
+			Title:        gitlab.String(title),
+			Description:  gitlab.String(description),
+			SourceBranch: gitlab.String(branch),
+			TargetBranch: gitlab.String(parent),
+		})
+		if err != nil {
+			return cmdutils.WrapError(err, fmt.Sprintf("failed to create a merge request for %s", branch))
+		}
+		mrs[branch] = mr
+		fmt.Fprintf(out, "%s Created !%d %s (%s → %s)\n%s\n", c.GreenCheck(), mr.IID, mr.Title, branch, parent, mr.WebURL)
+	}
+
+	if err := stackutils.UpdateNavigation(apiClient, repo, s, mrs); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "%s Submitted a stack of %s based on %s\n", c.GreenCheck(), utils.Pluralize(len(mrs), "merge request"), s.Base)
+	return nil
+}
+
+// titleAndDescription fills the merge request of a branch like `glab mr create --fill`:
+// the title and body of a single commit, or the branch name and the list of commits
+func titleAndDescription(branch string, commits []*git.Commit) (string, string, error) {
+	if len(commits) == 1 {
+		body, err := git.CommitBody(commits[0].Sha)
+		if err != nil {
+			return "", "", err
+		}
+		return commits[0].Title, strings.TrimSpace(body), nil
+	}
+
+	var body strings.Builder
+	for i := len(commits) - 1; i >= 0; i-- {
+		fmt.Fprintf(&body, "- %s\n", commits[i].Title)
+	}
+	return utils.Humanize(branch), strings.TrimSpace(body.String()), nil
+}
@@ -0,0 +1,98 @@
+package submit
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/git"
+	"github.com/profclems/glab/pkg/httpmock"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/profclems/glab/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xanzy/go-gitlab"
+)
+
+func Test_submitRun(t *testing.T) {
+	reg := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+	defer reg.Verify(t)
+
+	bodies := map[string]map[string]interface{}{}
+	record := func(name string, resp string) httpmock.Responder {
+		return func(req *http.Request) (*http.Response, error) {
+			b, err := ioutil.ReadAll(req.Body)
+			require.NoError(t, err)
+			body := map[string]interface{}{}
+			require.NoError(t, json.Unmarshal(b, &body))
+			bodies[name] = body
+			return httpmock.NewStringResponse(200, resp)(req)
+		}
+	}
+
+	reg.RegisterResponder("GET", "/api/v4/projects/OWNER/REPO/merge_requests?order_by=updated_at&per_page=30&source_branch=parser&state=opened",
+		httpmock.NewStringResponse(200, `[{"id": 1, "iid": 12, "title": "Add the parser", "description": "Parses.",
+			"source_branch": "parser", "target_branch": "main"}]`))
+	reg.RegisterResponder("GET", "/api/v4/projects/OWNER/REPO/merge_requests?order_by=updated_at&per_page=30&source_branch=lexer&state=opened",
+		httpmock.NewStringResponse(200, `[]`))
+	reg.RegisterResponder("POST", "/api/v4/projects/OWNER/REPO/merge_requests",
+		record("create", `{"id": 2, "iid": 13, "title": "Draft: Add the lexer", "description": "Lexes.",
+			"source_branch": "lexer", "target_branch": "parser", "web_url": "https://gitlab.com/OWNER/REPO/-/merge_requests/13"}`))
+	reg.RegisterResponder("PUT", "/api/v4/projects/OWNER/REPO/merge_requests/12",
+		record("update12", `{"id": 1, "iid": 12}`))
+	reg.RegisterResponder("PUT", "/api/v4/projects/OWNER/REPO/merge_requests/13",
+		record("update13", `{"id": 2, "iid": 13}`))
+
+	cs, csTeardown := test.InitCmdStubber()
+	defer csTeardown()
+	cs.Stub("branch.parser.glab-stack-parent main\nbranch.lexer.glab-stack-parent parser\n") // git config --get-regexp
+	cs.Stub("")                                                                              // git push parser
+	cs.Stub("")                                                                              // git push lexer
+	cs.Stub("d1sd2e,Add the lexer")                                                          // git log
+	cs.Stub("Lexes.\n")                                                                      // git show
+
+	io, _, stdout, _ := iostreams.Test()
+	opts := &SubmitOpts{
+		Draft: true,
+		IO:    io,
+		BaseRepo: func() (glrepo.Interface, error) {
+			return glrepo.New("OWNER", "REPO"), nil
+		},
+		HTTPClient: func() (*gitlab.Client, error) {
+			a, _ := api.TestClient(&http.Client{Transport: reg}, "", "gitlab.com", false)
+			return a.Lab(), nil
+		},
+		Remotes: func() (glrepo.Remotes, error) {
+			return glrepo.Remotes{
+				{Remote: &git.Remote{Name: "origin"}, Repo: glrepo.New("OWNER", "REPO")},
+			}, nil
+		},
+		Branch: func() (string, error) {
+			return "lexer", nil
+		},
+	}
+	_, _ = opts.HTTPClient()
+
+	err := submitRun(opts)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"git", "push", "--force-with-lease", "--set-upstream", "origin", "parser"}, cs.Calls[1].Args)
+	assert.Equal(t, []string{"git", "push", "--force-with-lease", "--set-upstream", "origin", "lexer"}, cs.Calls[2].Args)
+
+	assert.Equal(t, map[string]interface{}{
+		"title":         "Draft: Add the lexer",
+		"description":   "Lexes.",
+		"source_branch": "lexer",
+		"target_branch": "parser",
+	}, bodies["create"])
+	assert.Equal(t, "Parses.\n\n<!-- glab-stack -->\n**Stack** based on `main`:\n\n1. **!12** 👈 this merge request\n1. !13\n<!-- /glab-stack -->",
+		bodies["update12"]["description"])
+	assert.Equal(t, "Lexes.\n\n<!-- glab-stack -->\n**Stack** based on `main`:\n\n1. !12\n1. **!13** 👈 this merge request\n<!-- /glab-stack -->",
+		bodies["update13"]["description"])
+
+	assert.Equal(t, "✓ Created !13 Draft: Add the lexer (lexer → parser)\nhttps://gitlab.com/OWNER/REPO/-/merge_requests/13\n"+
+		"✓ Submitted a stack of 2 merge requests based on main\n", stdout.String())
+}
@@ -0,0 +1,194 @@
+package sync
+
+import (
+	"fmt"
+
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/commands/stack/stackutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/git"
+	"github.com/profclems/glab/pkg/iostreams"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type SyncOpts struct {
+	NoPush bool
+
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+	HTTPClient func() (*gitlab.Client, error)
+	Remotes    func() (glrepo.Remotes, error)
+	Branch     func() (string, error)
+}
+
+func NewCmdSync(f *cmdutils.Factory, runE func(*SyncOpts) error) *cobra.Command {
+	opts := &SyncOpts{
+		IO: f.IO,
+	}
+
+	var stackSyncCmd = &cobra.Command{
+		Use:   "sync [flags]",
+		Short: `Remove merged branches from the stack and retarget the rest`,
+		Long: heredoc.Doc(`
+			Remove the branches whose merge requests were merged from the current stack.
+
+			The branch after a merged branch is rebased onto the branch before it, and its merge
+			request is retargeted. The rest of the stack is then rebased and force-pushed, and
+			the stack navigation in the merge request descriptions is updated. Merged branches
+			are kept locally.
+		`),
+		Example: heredoc.Doc(`
+			$ glab stack sync
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+			opts.HTTPClient = f.HttpClient
+			opts.Remotes = f.Remotes
+			opts.Branch = f.Branch
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return syncRun(opts)
+		},
+	}
+
+	stackSyncCmd.Flags().BoolVar(&opts.NoPush, "no-push", false, "Do not push the rebased branches")
+
+	return stackSyncCmd
+}
+
+func syncRun(opts *SyncOpts) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+	current, err := opts.Branch()
+	if err != nil {
+		return err
+	}
+
+	s, err := stackutils.Load(current)
+	if err != nil {
+		return err
+	}
+	remote, err := stackutils.Remote(opts.Remotes, repo)
+	if err != nil {
+		return err
+	}
+
+	mrs, err := stackutils.OpenMRs(apiClient, repo, s)
+	if err != nil {
+		return err
+	}
+
+	c := opts.IO.Color()
+	out := opts.IO.StdOut
+
+	// previousParents maps the branches whose parent was merged to the last commit of that
+	// parent. It is saved with the stack, so that `glab stack rebase` leaves out the commits
+	// of the merged parent when the rebase below is interrupted, even once the merged
+	// branch is deleted.
+	previousParents := map[string]string{}
+	var merged []string
+	for _, branch := range append([]string{}, s.Branches...) {
+		if _, ok := mrs[branch]; ok {
+			continue
+		}
+		mr, err := stackutils.FindMR(apiClient, repo, branch, "merged")
+		if err != nil {
+			return err
+		}
+		if mr == nil {
+			continue
+		}
+
+		previous := mr.SHA
+		if head, err := git.BranchHead(branch); err == nil {
+			previous = head
+		}
+		for i, b := range s.Branches {
+			if b == branch && i+1 < len(s.Branches) {
+				previousParents[s.Branches[i+1]] = previous
+			}
+		}
+		s.Remove(branch)
+		merged = append(merged, branch)
+		fmt.Fprintf(out, "%s Removed %s from the stack, !%d was merged\n", c.GreenCheck(), branch, mr.IID)
+	}
+
+	for _, branch := range merged {
+		if err := stackutils.Forget(branch); err != nil {
+			return err
+		}
+	}
+	if len(s.Branches) == 0 {
+		fmt.Fprintf(out, "%s Every branch of the stack was merged\n", c.GreenCheck())
+		return nil
+	}
+	if err := stackutils.Save(s); err != nil {
+		return err
+	}
+	for _, branch := range s.Branches {
+		if previous, ok := previousParents[branch]; ok {
+			if err := stackutils.SavePreviousParent(branch, previous); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, branch := range s.Branches {
+		mr, ok := mrs[branch]
+		parent := s.Parent(branch)
+		if !ok || mr.TargetBranch == parent {
+			continue
+		}
+		mr, err = api.UpdateMR(apiClient, repo.FullName(), mr.IID, &gitlab.UpdateMergeRequestOptions{
+			TargetBranch: gitlab.String(parent),
+		})
+		if err != nil {
+			return cmdutils.WrapError(err, fmt.Sprintf("failed to retarget !%d", mrs[branch].IID))
+		}
+		mrs[branch] = mr
+		fmt.Fprintf(out, "%s Retargeted !%d to %s\n", c.GreenCheck(), mr.IID, parent)
+	}
+
+	if err := git.Fetch(remote.Name, []string{s.Base}, opts.IO.StdOut, opts.IO.StdErr); err != nil {
+		return fmt.Errorf("failed to fetch %s: %w", s.Base, err)
+	}
+	upstreamBase := fmt.Sprintf("%s/%s", remote.Name, s.Base)
+	if err := stackutils.RebaseStack(s, upstreamBase, opts.IO.StdOut, opts.IO.StdErr); err != nil {
+		return err
+	}
+
+	// the current branch may have been merged, in which case the stack is checked out
+	// at its new first branch
+	checkout := current
+	if !s.Contains(current) {
+		checkout = s.Branches[0]
+	}
+	if err := git.CheckoutBranch(checkout); err != nil {
+		return err
+	}
+
+	if !opts.NoPush {
+		if err := stackutils.PushStack(s, remote.Name, opts.IO.StdOut, opts.IO.StdErr); err != nil {
+			return err
+		}
+	}
+	if err := stackutils.UpdateNavigation(apiClient, repo, s, mrs); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "%s Synced the stack onto %s\n", c.GreenCheck(), upstreamBase)
+	return nil
+}
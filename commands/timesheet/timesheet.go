@@ -0,0 +1,315 @@
+package timesheet
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/commands/timetracking"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/profclems/glab/pkg/tableprinter"
+	"github.com/profclems/glab/pkg/utils"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+const dateFormat = "2006-01-02"
+
+// The dimensions time can be grouped by
+const (
+	ByUser      = "user"
+	ByIssue     = "issue"
+	ByMilestone = "milestone"
+)
+
+type TimesheetOpts struct {
+	From         string
+	To           string
+	By           []string
+	Users        []string
+	Group        string
+	OutputFormat string
+
+	// Now returns the current time and is used for the default date range
+	Now func() time.Time
+
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+	HTTPClient func() (*gitlab.Client, error)
+
+	from, to time.Time
+}
+
+func NewCmdTimesheet(f *cmdutils.Factory, runE func(*TimesheetOpts) error) *cobra.Command {
+	opts := &TimesheetOpts{
+		IO:  f.IO,
+		Now: time.Now,
+	}
+
+	var timesheetCmd = &cobra.Command{
+		Use:   "timesheet [flags]",
+		Short: `Report the time spent on issues and merge requests`,
+		Long: heredoc.Doc(`
+			Report the time logged on the issues and merge requests of a project or group
+			over a date range, totalled per user, issue or milestone.
+
+			The date range defaults to the current month. Both dates are inclusive.
+			Time logged on merge requests is reported under the merge request in the
+			issue column.
+		`),
+		Example: heredoc.Doc(`
+			$ glab timesheet
+			$ glab timesheet --from 2021-05-01 --to 2021-05-31 --by user,issue
+			$ glab timesheet --group my-group --by milestone --user alice --output csv > may.csv
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+			opts.HTTPClient = f.HttpClient
+
+			if len(opts.By) == 0 {
+				return &cmdutils.FlagError{Err: errors.New("--by requires at least one of user, issue, milestone")}
+			}
+			for _, by := range opts.By {
+				if by != ByUser && by != ByIssue && by != ByMilestone {
+					return &cmdutils.FlagError{Err: fmt.Errorf("invalid --by value %q. Must be one of user, issue, milestone", by)}
+				}
+			}
+			if opts.OutputFormat != "table" && opts.OutputFormat != "csv" {
+				return &cmdutils.FlagError{Err: fmt.Errorf("invalid output format %q. Must be table or csv", opts.OutputFormat)}
+			}
+			if err := opts.parseDates(); err != nil {
+				return &cmdutils.FlagError{Err: err}
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return timesheetRun(opts)
+		},
+	}
+
+	cmdutils.EnableRepoOverride(timesheetCmd, f)
+	timesheetCmd.Flags().StringVar(&opts.From, "from", "", "Start date in YYYY-MM-DD format (default: first day of the current month)")
+	timesheetCmd.Flags().StringVar(&opts.To, "to", "", "End date in YYYY-MM-DD format (default: today)")
+	timesheetCmd.Flags().StringSliceVarP(&opts.By, "by", "b", []string{ByUser}, "Total the time per user, issue or milestone. Combine them to break totals down, e.g. user,issue")
+	timesheetCmd.Flags().StringSliceVarP(&opts.Users, "user", "u", []string{}, "Only report time logged by these users")
+	timesheetCmd.Flags().StringVarP(&opts.Group, "group", "g", "", "Report the time of a group and its subgroups instead of a project")
+	timesheetCmd.Flags().StringVarP(&opts.OutputFormat, "output", "F", "table", "Format output as: table, csv")
+
+	return timesheetCmd
+}
+
+func (opts *TimesheetOpts) parseDates() error {
+	now := opts.Now()
+	opts.from = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	opts.to = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	var err error
+	if opts.From != "" {
+		opts.from, err = time.Parse(dateFormat, opts.From)
+		if err != nil {
+			return fmt.Errorf("invalid --from date %q. Use the YYYY-MM-DD format", opts.From)
+		}
+	}
+	if opts.To != "" {
+		opts.to, err = time.Parse(dateFormat, opts.To)
+		if err != nil {
+			return fmt.Errorf("invalid --to date %q. Use the YYYY-MM-DD format", opts.To)
+		}
+	}
+	if opts.to.Before(opts.from) {
+		return errors.New("--to must not be before --from")
+	}
+	return nil
+}
+
+func timesheetRun(opts *TimesheetOpts) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	fullPath := opts.Group
+	if fullPath == "" {
+		repo, err := opts.BaseRepo()
+		if err != nil {
+			return err
+		}
+		fullPath = repo.FullName()
+	}
+
+	timelogs, err := api.ListTimelogs(apiClient, fullPath, &api.ListTimelogsOptions{
+		Group:     opts.Group != "",
+		StartDate: opts.from,
+		EndDate:   opts.to,
+	})
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to get time logs")
+	}
+
+	if len(opts.Users) > 0 {
+		var filtered []*api.Timelog
+		for _, timelog := range timelogs {
+			if utils.PresentInStringSlice(opts.Users, timelog.User.Username) {
+				filtered = append(filtered, timelog)
+			}
+		}
+		timelogs = filtered
+	}
+
+	rows := Aggregate(timelogs, opts.By)
+	if opts.OutputFormat == "csv" {
+		return writeCSV(opts, rows)
+	}
+
+	if len(rows) == 0 {
+		fmt.Fprintf(opts.IO.StdErr, "No time logged on %s between %s and %s\n", fullPath, opts.from.Format(dateFormat), opts.to.Format(dateFormat))
+		return nil
+	}
+
+	c := opts.IO.Color()
+	fmt.Fprintf(opts.IO.StdOut, "Time logged on %s between %s and %s\n\n", c.Bold(fullPath), opts.from.Format(dateFormat), opts.to.Format(dateFormat))
+
+	table := tableprinter.NewTablePrinter()
+	var header []interface{}
+	for _, column := range columns(opts.By) {
+		header = append(header, strings.ToUpper(column))
+	}
+	table.AddRow(append(header, "TIME", "HOURS")...)
+
+	total := 0
+	for _, row := range rows {
+		var cells []interface{}
+		for _, value := range row.Values {
+			if value == "" {
+				value = "-"
+			}
+			cells = append(cells, value)
+		}
+		table.AddRow(append(cells, timetracking.FormatDuration(row.Seconds), hours(row.Seconds))...)
+		total += row.Seconds
+	}
+
+	footer := make([]interface{}, len(header))
+	footer[0] = c.Bold("Total")
+	for i := 1; i < len(footer); i++ {
+		footer[i] = ""
+	}
+	table.AddRow(append(footer, c.Bold(timetracking.FormatDuration(total)), c.Bold(hours(total)))...)
+
+	opts.IO.LogInfo(table.String())
+	return nil
+}
+
+func writeCSV(opts *TimesheetOpts, rows []Row) error {
+	w := csv.NewWriter(opts.IO.StdOut)
+	if err := w.Write(append(columns(opts.By), "seconds", "hours")); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		if err := w.Write(append(row.Values, fmt.Sprint(row.Seconds), hours(row.Seconds))); err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
+
+// Row is the time spent for one combination of the values time is grouped by
+type Row struct {
+	// Values holds a value for each column returned by columns()
+	Values  []string
+	Seconds int
+}
+
+// columns returns the column names for the dimensions time is grouped by.
+// Issues take two columns: the reference and the title.
+func columns(by []string) []string {
+	var cols []string
+	for _, b := range by {
+		if b == ByIssue {
+			cols = append(cols, "issue", "title")
+		} else {
+			cols = append(cols, b)
+		}
+	}
+	return cols
+}
+
+// Aggregate totals the time logs per unique combination of the by dimensions.
+// Rows are sorted by their values.
+func Aggregate(timelogs []*api.Timelog, by []string) []Row {
+	totals := map[string]*Row{}
+	for _, timelog := range timelogs {
+		var values []string
+		for _, b := range by {
+			switch b {
+			case ByUser:
+				values = append(values, timelog.User.Username)
+			case ByIssue:
+				item := issuable(timelog)
+				if item == nil {
+					values = append(values, "", "")
+				} else {
+					values = append(values, item.Reference, item.Title)
+				}
+			case ByMilestone:
+				milestone := ""
+				if item := issuable(timelog); item != nil && item.Milestone != nil {
+					milestone = item.Milestone.Title
+				}
+				values = append(values, milestone)
+			}
+		}
+
+		key := strings.Join(values, "\x00")
+		if row, ok := totals[key]; ok {
+			row.Seconds += timelog.TimeSpent
+		} else {
+			totals[key] = &Row{Values: values, Seconds: timelog.TimeSpent}
+		}
+	}
+
+	rows := make([]Row, 0, len(totals))
+	for _, row := range totals {
+		rows = append(rows, *row)
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		for k := range rows[i].Values {
+			if rows[i].Values[k] != rows[j].Values[k] {
+				return lessValue(rows[i].Values[k], rows[j].Values[k])
+			}
+		}
+		return false
+	})
+	return rows
+}
+
+// lessValue sorts empty values, such as time logged outside of a milestone, last
+func lessValue(a, b string) bool {
+	if a == "" || b == "" {
+		return b == ""
+	}
+	return a < b
+}
+
+func issuable(timelog *api.Timelog) *api.TimelogIssuable {
+	if timelog.Issue != nil {
+		return timelog.Issue
+	}
+	return timelog.MergeRequest
+}
+
+func hours(seconds int) string {
+	return fmt.Sprintf("%.2f", float64(seconds)/3600)
+}
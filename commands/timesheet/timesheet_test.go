@@ -0,0 +1,129 @@
+package timesheet
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/httpmock"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xanzy/go-gitlab"
+)
+
+const timelogsResponse = `{"data": {"namespace": {"timelogs": {
+	"pageInfo": {"hasNextPage": false, "endCursor": "abc"},
+	"nodes": [
+		{"spentAt": "2021-05-03T10:00:00Z", "timeSpent": 3600, "user": {"username": "alice"},
+		 "issue": {"iid": "12", "title": "Fix login", "reference": "#12", "milestone": {"title": "1.0"}}},
+		{"spentAt": "2021-05-04T10:00:00Z", "timeSpent": 5400, "user": {"username": "bob"},
+		 "issue": {"iid": "12", "title": "Fix login", "reference": "#12", "milestone": {"title": "1.0"}}},
+		{"spentAt": "2021-05-05T10:00:00Z", "timeSpent": 1800, "user": {"username": "alice"},
+		 "mergeRequest": {"iid": "3", "title": "Add docs", "reference": "!3"}},
+		{"spentAt": "2021-05-06T10:00:00Z", "timeSpent": -600, "user": {"username": "alice"},
+		 "issue": {"iid": "12", "title": "Fix login", "reference": "#12", "milestone": {"title": "1.0"}}}
+	]
+}}}}`
+
+func Test_Aggregate(t *testing.T) {
+	var resp struct {
+		Data struct {
+			Namespace struct {
+				Timelogs struct {
+					Nodes []*api.Timelog `json:"nodes"`
+				} `json:"timelogs"`
+			} `json:"namespace"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(timelogsResponse), &resp))
+	timelogs := resp.Data.Namespace.Timelogs.Nodes
+
+	assert.Equal(t, []Row{
+		{Values: []string{"alice"}, Seconds: 4800},
+		{Values: []string{"bob"}, Seconds: 5400},
+	}, Aggregate(timelogs, []string{ByUser}))
+
+	assert.Equal(t, []Row{
+		{Values: []string{"1.0"}, Seconds: 8400},
+		{Values: []string{""}, Seconds: 1800},
+	}, Aggregate(timelogs, []string{ByMilestone}))
+
+	assert.Equal(t, []Row{
+		{Values: []string{"alice", "!3", "Add docs"}, Seconds: 1800},
+		{Values: []string{"alice", "#12", "Fix login"}, Seconds: 3000},
+		{Values: []string{"bob", "#12", "Fix login"}, Seconds: 5400},
+	}, Aggregate(timelogs, []string{ByUser, ByIssue}))
+}
+
+func Test_timesheetRun(t *testing.T) {
+	reg := &httpmock.Mocker{MatchURL: httpmock.HostAndPath}
+	defer reg.Verify(t)
+
+	var variables map[string]interface{}
+	reg.RegisterResponder("POST", "https://gitlab.com/api/graphql",
+		func(req *http.Request) (*http.Response, error) {
+			b, err := ioutil.ReadAll(req.Body)
+			require.NoError(t, err)
+			var body struct {
+				Query     string                 `json:"query"`
+				Variables map[string]interface{} `json:"variables"`
+			}
+			require.NoError(t, json.Unmarshal(b, &body))
+			assert.Contains(t, body.Query, "project(fullPath: $fullPath)")
+			variables = body.Variables
+			return httpmock.NewStringResponse(200, timelogsResponse)(req)
+		})
+
+	io, _, stdout, _ := iostreams.Test()
+	opts := &TimesheetOpts{
+		By:           []string{ByIssue},
+		Users:        []string{"alice"},
+		OutputFormat: "csv",
+		Now: func() time.Time {
+			return time.Date(2021, time.May, 20, 15, 0, 0, 0, time.UTC)
+		},
+		IO: io,
+		HTTPClient: func() (*gitlab.Client, error) {
+			a, _ := api.TestClient(&http.Client{Transport: reg}, "", "gitlab.com", false)
+			return a.Lab(), nil
+		},
+		BaseRepo: func() (glrepo.Interface, error) {
+			return glrepo.FromFullName("owner/repo")
+		},
+	}
+	_, _ = opts.HTTPClient()
+	require.NoError(t, opts.parseDates())
+
+	err := timesheetRun(opts)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]interface{}{
+		"fullPath":  "owner/repo",
+		"full":      false,
+		"startDate": "2021-05-01",
+		"endDate":   "2021-05-20",
+	}, variables)
+	assert.Equal(t, heredoc.Doc(`
+		issue,title,seconds,hours
+		!3,Add docs,1800,0.50
+		#12,Fix login,3000,0.83
+	`), stdout.String())
+}
+
+func Test_parseDates(t *testing.T) {
+	opts := &TimesheetOpts{
+		From: "2021-05-10",
+		To:   "2021-05-01",
+		Now:  time.Now,
+	}
+	assert.EqualError(t, opts.parseDates(), "--to must not be before --from")
+
+	opts.To = "2021-05"
+	assert.EqualError(t, opts.parseDates(), `invalid --to date "2021-05". Use the YYYY-MM-DD format`)
+}
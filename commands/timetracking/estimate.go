@@ -0,0 +1,81 @@
+package timetracking
+
+import (
+	"fmt"
+
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type EstimateOpts struct {
+	Kind     *Kind
+	Duration string
+
+	IO         *iostreams.IOStreams
+	Item       func() (int, glrepo.Interface, error)
+	HTTPClient func() (*gitlab.Client, error)
+}
+
+func NewCmdEstimate(f *cmdutils.Factory, kind *Kind, runE func(*EstimateOpts) error) *cobra.Command {
+	opts := &EstimateOpts{
+		Kind: kind,
+		IO:   f.IO,
+	}
+
+	usage, args := idArgs(kind, 1)
+	var estimateCmd = &cobra.Command{
+		Use:   fmt.Sprintf("estimate %s <duration>", usage),
+		Short: fmt.Sprintf("Set the time estimate of %s %s", kind.Article, kind.Name),
+		Long: heredoc.Docf(`
+			Set the time estimate of %s %s, replacing the current estimate.
+
+			Use "glab %s time reset --estimate" to remove the estimate.
+		`, kind.Article, kind.Name, kind.Command),
+		Example: heredoc.Docf(`
+			$ glab %[1]s time estimate 42 3h
+			$ glab %[1]s time estimate 42 "1d 4h"
+		`, kind.Command),
+		Args: args,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.HTTPClient = f.HttpClient
+			opts.Duration = args[len(args)-1]
+
+			opts.Item = item(f, kind, args[:len(args)-1])
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return estimateRun(opts)
+		},
+	}
+
+	return estimateCmd
+}
+
+func estimateRun(opts *EstimateOpts) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	iid, repo, err := opts.Item()
+	if err != nil {
+		return err
+	}
+
+	stats, err := opts.Kind.setEstimate(apiClient, repo.FullName(), iid, opts.Duration)
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to set the time estimate")
+	}
+
+	c := opts.IO.Color()
+	fmt.Fprintf(opts.IO.StdOut, "%s Set time estimate of %s to %s\n", c.GreenCheck(), ref(opts.Kind, iid), stats.HumanTimeEstimate)
+	printTimeStats(opts.IO, stats)
+	return nil
+}
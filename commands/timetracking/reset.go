@@ -0,0 +1,97 @@
+package timetracking
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type ResetOpts struct {
+	Kind     *Kind
+	Estimate bool
+	Spent    bool
+
+	IO         *iostreams.IOStreams
+	Item       func() (int, glrepo.Interface, error)
+	HTTPClient func() (*gitlab.Client, error)
+}
+
+func NewCmdReset(f *cmdutils.Factory, kind *Kind, runE func(*ResetOpts) error) *cobra.Command {
+	opts := &ResetOpts{
+		Kind: kind,
+		IO:   f.IO,
+	}
+
+	usage, args := idArgs(kind, 0)
+	var resetCmd = &cobra.Command{
+		Use:   fmt.Sprintf("reset %s", usage),
+		Short: fmt.Sprintf("Reset the time estimate or time spent of %s %s", kind.Article, kind.Name),
+		Long: heredoc.Docf(`
+			Reset the time estimate or the time spent of %s %s.
+			Which one to reset must be given with --estimate, --spent or both.
+		`, kind.Article, kind.Name),
+		Example: heredoc.Docf(`
+			$ glab %[1]s time reset 42 --estimate
+			$ glab %[1]s time reset 42 --spent
+			$ glab %[1]s time reset 42 --estimate --spent
+		`, kind.Command),
+		Args: args,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.HTTPClient = f.HttpClient
+			opts.Item = item(f, kind, args)
+
+			if !opts.Estimate && !opts.Spent {
+				return &cmdutils.FlagError{Err: errors.New("specify what to reset with --estimate, --spent or both")}
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return resetRun(opts)
+		},
+	}
+
+	resetCmd.Flags().BoolVar(&opts.Estimate, "estimate", false, "Reset the time estimate")
+	resetCmd.Flags().BoolVar(&opts.Spent, "spent", false, "Reset the time spent")
+
+	return resetCmd
+}
+
+func resetRun(opts *ResetOpts) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	iid, repo, err := opts.Item()
+	if err != nil {
+		return err
+	}
+
+	c := opts.IO.Color()
+	var stats *gitlab.TimeStats
+	if opts.Estimate {
+		stats, err = opts.Kind.resetEstimate(apiClient, repo.FullName(), iid)
+		if err != nil {
+			return cmdutils.WrapError(err, "failed to reset the time estimate")
+		}
+		fmt.Fprintf(opts.IO.StdOut, "%s Reset the time estimate of %s\n", c.GreenCheck(), ref(opts.Kind, iid))
+	}
+	if opts.Spent {
+		stats, err = opts.Kind.resetSpent(apiClient, repo.FullName(), iid)
+		if err != nil {
+			return cmdutils.WrapError(err, "failed to reset the time spent")
+		}
+		fmt.Fprintf(opts.IO.StdOut, "%s Reset the time spent on %s\n", c.GreenCheck(), ref(opts.Kind, iid))
+	}
+	printTimeStats(opts.IO, stats)
+	return nil
+}
@@ -0,0 +1,91 @@
+package timetracking
+
+import (
+	"fmt"
+
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type SpendOpts struct {
+	Kind     *Kind
+	Duration string
+	Subtract bool
+
+	IO         *iostreams.IOStreams
+	Item       func() (int, glrepo.Interface, error)
+	HTTPClient func() (*gitlab.Client, error)
+}
+
+func NewCmdSpend(f *cmdutils.Factory, kind *Kind, runE func(*SpendOpts) error) *cobra.Command {
+	opts := &SpendOpts{
+		Kind: kind,
+		IO:   f.IO,
+	}
+
+	usage, args := idArgs(kind, 1)
+	var spendCmd = &cobra.Command{
+		Use:   fmt.Sprintf("spend %s <duration>", usage),
+		Short: fmt.Sprintf("Log time spent on %s %s", kind.Article, kind.Name),
+		Long: heredoc.Docf(`
+			Log time spent on %s %s. The duration is added to the time already spent,
+			or subtracted from it with --subtract.
+		`, kind.Article, kind.Name),
+		Example: heredoc.Docf(`
+			$ glab %[1]s time spend 42 1h30m
+			$ glab %[1]s time spend 42 30m --subtract
+		`, kind.Command),
+		Args: args,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.HTTPClient = f.HttpClient
+			opts.Duration = args[len(args)-1]
+			opts.Item = item(f, kind, args[:len(args)-1])
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return spendRun(opts)
+		},
+	}
+
+	spendCmd.Flags().BoolVar(&opts.Subtract, "subtract", false, "Subtract the duration from the time spent")
+
+	return spendCmd
+}
+
+func spendRun(opts *SpendOpts) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	iid, repo, err := opts.Item()
+	if err != nil {
+		return err
+	}
+
+	duration := opts.Duration
+	if opts.Subtract {
+		duration = "-" + duration
+	}
+
+	stats, err := opts.Kind.addSpent(apiClient, repo.FullName(), iid, duration)
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to log time spent")
+	}
+
+	c := opts.IO.Color()
+	if opts.Subtract {
+		fmt.Fprintf(opts.IO.StdOut, "%s Subtracted %s from the time spent on %s\n", c.GreenCheck(), opts.Duration, ref(opts.Kind, iid))
+	} else {
+		fmt.Fprintf(opts.IO.StdOut, "%s Added %s to the time spent on %s\n", c.GreenCheck(), opts.Duration, ref(opts.Kind, iid))
+	}
+	printTimeStats(opts.IO, stats)
+	return nil
+}
@@ -0,0 +1,170 @@
+package timetracking
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/commands/issue/issueutils"
+	"github.com/profclems/glab/commands/mr/mrutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+// Kind holds what differs between tracking time on issues and on merge requests
+type Kind struct {
+	// Name is used in help texts and messages, e.g. "issue"
+	Name string
+	// Article is the indefinite article to use with Name, e.g. "an"
+	Article string
+	// Command is the name of the parent command, e.g. "mr"
+	Command string
+	// Prefix is put in front of the IID when referencing the item, e.g. "#"
+	Prefix string
+	// IDOptional is true when the item can be determined without an ID,
+	// such as a merge request from the current branch
+	IDOptional bool
+
+	resolve       func(f *cmdutils.Factory, apiClient *gitlab.Client, args []string) (int, glrepo.Interface, error)
+	setEstimate   func(client *gitlab.Client, repo string, iid int, duration string) (*gitlab.TimeStats, error)
+	addSpent      func(client *gitlab.Client, repo string, iid int, duration string) (*gitlab.TimeStats, error)
+	resetEstimate func(client *gitlab.Client, repo string, iid int) (*gitlab.TimeStats, error)
+	resetSpent    func(client *gitlab.Client, repo string, iid int) (*gitlab.TimeStats, error)
+}
+
+// Issue tracks time on issues
+var Issue = &Kind{
+	Name:    "issue",
+	Article: "an",
+	Command: "issue",
+	Prefix:  "#",
+	resolve: func(f *cmdutils.Factory, apiClient *gitlab.Client, args []string) (int, glrepo.Interface, error) {
+		issue, repo, err := issueutils.IssueFromArg(apiClient, f.BaseRepo, args[0])
+		if err != nil {
+			return 0, nil, err
+		}
+		return issue.IID, repo, nil
+	},
+	setEstimate: func(client *gitlab.Client, repo string, iid int, duration string) (*gitlab.TimeStats, error) {
+		return api.SetIssueTimeEstimate(client, repo, iid, &gitlab.SetTimeEstimateOptions{Duration: gitlab.String(duration)})
+	},
+	addSpent: func(client *gitlab.Client, repo string, iid int, duration string) (*gitlab.TimeStats, error) {
+		return api.AddIssueTimeSpent(client, repo, iid, &gitlab.AddSpentTimeOptions{Duration: gitlab.String(duration)})
+	},
+	resetEstimate: func(client *gitlab.Client, repo string, iid int) (*gitlab.TimeStats, error) {
+		return api.ResetIssueTimeEstimate(client, repo, iid)
+	},
+	resetSpent: func(client *gitlab.Client, repo string, iid int) (*gitlab.TimeStats, error) {
+		return api.ResetIssueTimeSpent(client, repo, iid)
+	},
+}
+
+// MergeRequest tracks time on merge requests
+var MergeRequest = &Kind{
+	Name:       "merge request",
+	Article:    "a",
+	Command:    "mr",
+	Prefix:     "!",
+	IDOptional: true,
+	resolve: func(f *cmdutils.Factory, _ *gitlab.Client, args []string) (int, glrepo.Interface, error) {
+		mr, repo, err := mrutils.MRFromArgs(f, args, "any")
+		if err != nil {
+			return 0, nil, err
+		}
+		return mr.IID, repo, nil
+	},
+	setEstimate: func(client *gitlab.Client, repo string, iid int, duration string) (*gitlab.TimeStats, error) {
+		return api.SetMRTimeEstimate(client, repo, iid, &gitlab.SetTimeEstimateOptions{Duration: gitlab.String(duration)})
+	},
+	addSpent: func(client *gitlab.Client, repo string, iid int, duration string) (*gitlab.TimeStats, error) {
+		return api.AddMRTimeSpent(client, repo, iid, &gitlab.AddSpentTimeOptions{Duration: gitlab.String(duration)})
+	},
+	resetEstimate: func(client *gitlab.Client, repo string, iid int) (*gitlab.TimeStats, error) {
+		return api.ResetMRTimeEstimate(client, repo, iid)
+	},
+	resetSpent: func(client *gitlab.Client, repo string, iid int) (*gitlab.TimeStats, error) {
+		return api.ResetMRTimeSpent(client, repo, iid)
+	},
+}
+
+// NewCmdTime returns the "time" command of the issue or merge request command
+func NewCmdTime(f *cmdutils.Factory, kind *Kind) *cobra.Command {
+	var timeCmd = &cobra.Command{
+		Use:   "time <command> [flags]",
+		Short: fmt.Sprintf("Track time spent on %ss", kind.Name),
+		Long: heredoc.Docf(`
+			Set the time estimate of %[1]ss and log the time spent on them.
+
+			Durations use the GitLab format, e.g. "1h 30m", "2d" or "1w 2d". A day is 8 hours
+			and a week 5 days.
+		`, kind.Name),
+	}
+
+	timeCmd.AddCommand(NewCmdEstimate(f, kind, nil))
+	timeCmd.AddCommand(NewCmdSpend(f, kind, nil))
+	timeCmd.AddCommand(NewCmdReset(f, kind, nil))
+	return timeCmd
+}
+
+// idArgs returns the usage and the argument validator for a command which takes an
+// issue or merge request ID followed by extra arguments
+func idArgs(kind *Kind, extra int) (string, cobra.PositionalArgs) {
+	if kind.IDOptional {
+		return "[<id> | <branch>]", cobra.RangeArgs(extra, extra+1)
+	}
+	return "<id>", cobra.ExactArgs(extra + 1)
+}
+
+// item returns a function which resolves the issue or merge request from the arguments
+func item(f *cmdutils.Factory, kind *Kind, args []string) func() (int, glrepo.Interface, error) {
+	return func() (int, glrepo.Interface, error) {
+		apiClient, err := f.HttpClient()
+		if err != nil {
+			return 0, nil, err
+		}
+		return kind.resolve(f, apiClient, args)
+	}
+}
+
+func printTimeStats(streams *iostreams.IOStreams, stats *gitlab.TimeStats) {
+	c := streams.Color()
+	estimate := stats.HumanTimeEstimate
+	if estimate == "" {
+		estimate = "none"
+	}
+	spent := stats.HumanTotalTimeSpent
+	if spent == "" {
+		spent = "none"
+	}
+	fmt.Fprintf(streams.StdOut, "%s %s  %s %s\n", c.Gray("Estimate:"), estimate, c.Gray("Spent:"), spent)
+}
+
+func ref(kind *Kind, iid int) string {
+	return kind.Prefix + fmt.Sprint(iid)
+}
+
+// FormatDuration formats seconds as hours and minutes, e.g. "12h 30m". Unlike the
+// GitLab format, hours are not converted to days so totals can be compared easily.
+func FormatDuration(seconds int) string {
+	sign := ""
+	if seconds < 0 {
+		sign = "-"
+		seconds = -seconds
+	}
+	minutes := seconds / 60
+	h, m := minutes/60, minutes%60
+
+	var parts []string
+	if h > 0 {
+		parts = append(parts, fmt.Sprintf("%dh", h))
+	}
+	if m > 0 || h == 0 {
+		parts = append(parts, fmt.Sprintf("%dm", m))
+	}
+	return sign + strings.Join(parts, " ")
+}
@@ -0,0 +1,130 @@
+package timetracking
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/httpmock"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xanzy/go-gitlab"
+)
+
+func Test_FormatDuration(t *testing.T) {
+	tests := map[int]string{
+		0:      "0m",
+		59:     "0m",
+		1800:   "30m",
+		3600:   "1h",
+		45000:  "12h 30m",
+		-5400:  "-1h 30m",
+		180000: "50h",
+	}
+	for seconds, want := range tests {
+		assert.Equal(t, want, FormatDuration(seconds), seconds)
+	}
+}
+
+func Test_spendRun(t *testing.T) {
+	reg := &httpmock.Mocker{}
+	defer reg.Verify(t)
+
+	var duration string
+	reg.RegisterResponder("POST", "/api/v4/projects/owner/repo/merge_requests/3/add_spent_time",
+		func(req *http.Request) (*http.Response, error) {
+			b, err := ioutil.ReadAll(req.Body)
+			require.NoError(t, err)
+			var body map[string]string
+			require.NoError(t, json.Unmarshal(b, &body))
+			duration = body["duration"]
+			return httpmock.NewStringResponse(201, `{
+				"human_time_estimate": "2h",
+				"human_total_time_spent": "1h",
+				"time_estimate": 7200,
+				"total_time_spent": 3600
+			}`)(req)
+		})
+
+	io, _, stdout, _ := iostreams.Test()
+	opts := &SpendOpts{
+		Kind:     MergeRequest,
+		Duration: "30m",
+		Subtract: true,
+		IO:       io,
+		Item: func() (int, glrepo.Interface, error) {
+			repo, err := glrepo.FromFullName("owner/repo")
+			return 3, repo, err
+		},
+		HTTPClient: func() (*gitlab.Client, error) {
+			a, _ := api.TestClient(&http.Client{Transport: reg}, "", "gitlab.com", false)
+			return a.Lab(), nil
+		},
+	}
+	_, _ = opts.HTTPClient()
+
+	err := spendRun(opts)
+	require.NoError(t, err)
+
+	assert.Equal(t, "-30m", duration)
+	assert.Equal(t, heredoc.Doc(`
+		✓ Subtracted 30m from the time spent on !3
+		Estimate: 2h  Spent: 1h
+	`), stdout.String())
+}
+
+func Test_resetRun(t *testing.T) {
+	reg := &httpmock.Mocker{}
+	defer reg.Verify(t)
+
+	reg.RegisterResponder("POST", "/api/v4/projects/owner/repo/issues/12/reset_spent_time",
+		httpmock.NewStringResponse(200, `{"human_time_estimate": "2h", "time_estimate": 7200}`))
+
+	io, _, stdout, _ := iostreams.Test()
+	opts := &ResetOpts{
+		Kind:  Issue,
+		Spent: true,
+		IO:    io,
+		Item: func() (int, glrepo.Interface, error) {
+			repo, err := glrepo.FromFullName("owner/repo")
+			return 12, repo, err
+		},
+		HTTPClient: func() (*gitlab.Client, error) {
+			a, _ := api.TestClient(&http.Client{Transport: reg}, "", "gitlab.com", false)
+			return a.Lab(), nil
+		},
+	}
+	_, _ = opts.HTTPClient()
+
+	err := resetRun(opts)
+	require.NoError(t, err)
+
+	assert.Equal(t, heredoc.Doc(`
+		✓ Reset the time spent on #12
+		Estimate: 2h  Spent: none
+	`), stdout.String())
+}
+
+func Test_NewCmdReset(t *testing.T) {
+	io, _, _, _ := iostreams.Test()
+	f := &cmdutils.Factory{IO: io}
+
+	var gotOpts *ResetOpts
+	cmd := NewCmdReset(f, Issue, func(opts *ResetOpts) error {
+		gotOpts = opts
+		return nil
+	})
+	cmd.SetArgs([]string{"12"})
+	cmd.SetOut(io.StdOut)
+	cmd.SetErr(io.StdErr)
+
+	_, err := cmd.ExecuteC()
+	assert.EqualError(t, err, "specify what to reset with --estimate, --spent or both")
+	assert.Nil(t, gotOpts)
+}
@@ -0,0 +1,136 @@
+package create
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/commands/token/tokenutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type CreateOpts struct {
+	HTTPClient func() (*gitlab.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+
+	Name        string
+	Project     bool
+	Group       string
+	Scopes      []string
+	AccessLevel string
+	ExpiresAt   string
+
+	accessLevel gitlab.AccessLevelValue
+	expiresAt   *gitlab.ISOTime
+}
+
+func NewCmdCreate(f *cmdutils.Factory, runE func(*CreateOpts) error) *cobra.Command {
+	opts := &CreateOpts{
+		IO: f.IO,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "create <name> [flags]",
+		Short: `Create an access token`,
+		Long: heredoc.Doc(`
+			Create a personal, project or group access token.
+
+			The token is printed to stdout and cannot be retrieved again later.
+			Creating personal access tokens through the API requires administrator access.
+
+			The expiry can be a date in the YYYY-MM-DD format or a period from now such as 90d or 12w.
+		`),
+		Aliases: []string{"new"},
+		Example: heredoc.Doc(`
+			$ glab token create ci-deploy --project --scope read_repository --scope read_registry --expires-at 90d
+			$ glab token create release-bot -g mygroup -s api -a maintainer -e 2022-12-31
+			$ glab token create local-dev --scope api --expires-at 30d
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var err error
+
+			opts.HTTPClient = f.HttpClient
+			opts.BaseRepo = f.BaseRepo
+			opts.Name = args[0]
+
+			if opts.Project && opts.Group != "" {
+				return &cmdutils.FlagError{Err: errors.New("--project and --group are mutually exclusive")}
+			}
+			if len(opts.Scopes) == 0 {
+				return &cmdutils.FlagError{Err: errors.New("at least one --scope is required")}
+			}
+			if opts.AccessLevel != "" {
+				if !opts.Project && opts.Group == "" {
+					return &cmdutils.FlagError{Err: errors.New("--access-level is only valid for project and group access tokens")}
+				}
+				opts.accessLevel, err = cmdutils.ParseAccessLevel(opts.AccessLevel)
+				if err != nil {
+					return &cmdutils.FlagError{Err: err}
+				}
+			}
+			opts.expiresAt, err = tokenutils.ParseExpiresAt(opts.ExpiresAt, time.Now())
+			if err != nil {
+				return &cmdutils.FlagError{Err: err}
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return createRun(opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.Project, "project", "p", false, "Create a project access token instead of a personal access token")
+	cmd.Flags().StringVarP(&opts.Group, "group", "g", "", "Create an access token for a group instead of a personal access token")
+	cmd.Flags().StringSliceVarP(&opts.Scopes, "scope", "s", nil, "Scopes of the token, e.g. api, read_api, read_repository, write_repository, read_registry")
+	cmd.Flags().StringVarP(&opts.AccessLevel, "access-level", "a", "", "Access level of a project or group access token. Defaults to maintainer")
+	cmd.Flags().StringVarP(&opts.ExpiresAt, "expires-at", "e", "", "Expiry date in the YYYY-MM-DD format or a period from now such as 90d")
+
+	return cmd
+}
+
+func createRun(opts *CreateOpts) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	target, err := tokenutils.ResolveTarget(opts.Project, opts.Group, opts.BaseRepo)
+	if err != nil {
+		return err
+	}
+
+	token, err := tokenutils.CreateToken(apiClient, target, tokenutils.CreateOptions{
+		Name:        opts.Name,
+		Scopes:      opts.Scopes,
+		AccessLevel: opts.accessLevel,
+		ExpiresAt:   opts.expiresAt,
+	})
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to create access token on %s", target))
+	}
+
+	expiry := "never"
+	if token.ExpiresAt != nil {
+		expiry = token.ExpiresAt.String()
+	}
+
+	c := opts.IO.Color()
+	opts.IO.Logf("%s Created access token %q (ID %d) on %s with scopes %s, expires %s\n",
+		c.GreenCheck(), token.Name, token.ID, target, strings.Join(token.Scopes, ","), expiry)
+	if opts.IO.IsErrTTY {
+		opts.IO.Logf("%s Make sure to save the token now. You will not be able to access it again.\n", c.WarnIcon())
+	}
+	fmt.Fprintln(opts.IO.StdOut, token.Value)
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package expiring
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/commands/token/tokenutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/profclems/glab/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type ExpiringOpts struct {
+	HTTPClient func() (*gitlab.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+
+	Project bool
+	Group   string
+	Within  string
+
+	within time.Duration
+	now    func() time.Time
+}
+
+func NewCmdExpiring(f *cmdutils.Factory, runE func(*ExpiringOpts) error) *cobra.Command {
+	opts := &ExpiringOpts{
+		IO:  f.IO,
+		now: time.Now,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "expiring [flags]",
+		Short: `Report access tokens which expire soon`,
+		Long: heredoc.Doc(`
+			List the active access tokens which expire within the given period.
+
+			The period accepts days (d), weeks (w) or any Go duration, e.g. 30d, 2w or 72h.
+		`),
+		Example: heredoc.Doc(`
+			$ glab token expiring
+			$ glab token expiring --within 2w --project
+			$ glab token expiring -g mygroup --within 90d
+		`),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var err error
+
+			opts.HTTPClient = f.HttpClient
+			opts.BaseRepo = f.BaseRepo
+
+			if opts.Project && opts.Group != "" {
+				return &cmdutils.FlagError{Err: errors.New("--project and --group are mutually exclusive")}
+			}
+			opts.within, err = utils.ParseDuration(opts.Within)
+			if err != nil {
+				return &cmdutils.FlagError{Err: err}
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return expiringRun(opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.Project, "project", "p", false, "Report on the project access tokens instead of your personal access tokens")
+	cmd.Flags().StringVarP(&opts.Group, "group", "g", "", "Report on the access tokens of a group instead of your personal access tokens")
+	cmd.Flags().StringVarP(&opts.Within, "within", "w", "30d", "Report tokens expiring within this period")
+
+	return cmd
+}
+
+func expiringRun(opts *ExpiringOpts) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	target, err := tokenutils.ResolveTarget(opts.Project, opts.Group, opts.BaseRepo)
+	if err != nil {
+		return err
+	}
+
+	tokens, err := tokenutils.ListTokens(apiClient, target, false)
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to list access tokens")
+	}
+
+	now := opts.now()
+	deadline := now.Add(opts.within)
+
+	var expiring []*tokenutils.Token
+	for _, t := range tokens {
+		if t.ExpiresAt != nil && time.Time(*t.ExpiresAt).Before(deadline) {
+			expiring = append(expiring, t)
+		}
+	}
+
+	if len(expiring) == 0 {
+		fmt.Fprintf(opts.IO.StdOut, "No access tokens on %s expire within %s\n", target, opts.Within)
+		return nil
+	}
+
+	sort.SliceStable(expiring, func(i, j int) bool {
+		return time.Time(*expiring[i].ExpiresAt).Before(time.Time(*expiring[j].ExpiresAt))
+	})
+
+	fmt.Fprintf(opts.IO.StdOut, "%s on %s expiring within %s\n\n%s\n",
+		utils.Pluralize(len(expiring), "access token"), target, opts.Within, tokenutils.DisplayTokens(opts.IO, expiring, now))
+	return nil
+}
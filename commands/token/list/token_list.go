@@ -0,0 +1,90 @@
+package list
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/commands/token/tokenutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/profclems/glab/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type ListOpts struct {
+	HTTPClient func() (*gitlab.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+
+	Project bool
+	Group   string
+	All     bool
+}
+
+func NewCmdList(f *cmdutils.Factory, runE func(*ListOpts) error) *cobra.Command {
+	opts := &ListOpts{
+		IO: f.IO,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list [flags]",
+		Short:   `List access tokens`,
+		Long:    ``,
+		Aliases: []string{"ls"},
+		Example: heredoc.Doc(`
+			$ glab token list
+			$ glab token list --project --all
+			$ glab token list -g mygroup
+		`),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.HTTPClient = f.HttpClient
+			opts.BaseRepo = f.BaseRepo
+
+			if opts.Project && opts.Group != "" {
+				return &cmdutils.FlagError{Err: fmt.Errorf("--project and --group are mutually exclusive")}
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return listRun(opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.Project, "project", "p", false, "List the access tokens of the project instead of your personal access tokens")
+	cmd.Flags().StringVarP(&opts.Group, "group", "g", "", "List the access tokens of a group instead of your personal access tokens")
+	cmd.Flags().BoolVarP(&opts.All, "all", "a", false, "Include revoked and expired tokens")
+
+	return cmd
+}
+
+func listRun(opts *ListOpts) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	target, err := tokenutils.ResolveTarget(opts.Project, opts.Group, opts.BaseRepo)
+	if err != nil {
+		return err
+	}
+
+	tokens, err := tokenutils.ListTokens(apiClient, target, opts.All)
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to list access tokens")
+	}
+
+	if len(tokens) == 0 {
+		fmt.Fprintf(opts.IO.StdOut, "No access tokens found on %s\n", target)
+		return nil
+	}
+
+	fmt.Fprintf(opts.IO.StdOut, "Showing %s on %s\n\n%s\n",
+		utils.Pluralize(len(tokens), "access token"), target, tokenutils.DisplayTokens(opts.IO, tokens, time.Now()))
+	return nil
+}
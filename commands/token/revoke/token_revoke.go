@@ -0,0 +1,106 @@
+package revoke
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/commands/token/tokenutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/profclems/glab/pkg/prompt"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type RevokeOpts struct {
+	HTTPClient func() (*gitlab.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+
+	Token       string
+	Project     bool
+	Group       string
+	ForceRevoke bool
+}
+
+func NewCmdRevoke(f *cmdutils.Factory, runE func(*RevokeOpts) error) *cobra.Command {
+	opts := &RevokeOpts{
+		IO: f.IO,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "revoke <id|name> [flags]",
+		Short:   `Revoke an access token`,
+		Long:    `Revoke a personal, project or group access token by its ID or name.`,
+		Aliases: []string{"delete", "rm"},
+		Example: heredoc.Doc(`
+			$ glab token revoke 1234
+			$ glab token revoke ci-deploy --project --yes
+			$ glab token revoke release-bot -g mygroup
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.HTTPClient = f.HttpClient
+			opts.BaseRepo = f.BaseRepo
+			opts.Token = args[0]
+
+			if opts.Project && opts.Group != "" {
+				return &cmdutils.FlagError{Err: errors.New("--project and --group are mutually exclusive")}
+			}
+			if !opts.ForceRevoke && !opts.IO.PromptEnabled() {
+				return &cmdutils.FlagError{Err: errors.New("--yes or -y flag is required when not running interactively")}
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return revokeRun(opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.Project, "project", "p", false, "Revoke a project access token instead of a personal access token")
+	cmd.Flags().StringVarP(&opts.Group, "group", "g", "", "Revoke an access token of a group instead of a personal access token")
+	cmd.Flags().BoolVarP(&opts.ForceRevoke, "yes", "y", false, "Skip the confirmation prompt")
+
+	return cmd
+}
+
+func revokeRun(opts *RevokeOpts) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	target, err := tokenutils.ResolveTarget(opts.Project, opts.Group, opts.BaseRepo)
+	if err != nil {
+		return err
+	}
+
+	token, err := tokenutils.FindToken(apiClient, target, opts.Token)
+	if err != nil {
+		return err
+	}
+
+	if !opts.ForceRevoke && opts.IO.PromptEnabled() {
+		err = prompt.Confirm(&opts.ForceRevoke, fmt.Sprintf("Revoke access token %q (ID %d) on %s?", token.Name, token.ID, target), false)
+		if err != nil {
+			return cmdutils.WrapError(err, "could not prompt")
+		}
+	}
+
+	if !opts.ForceRevoke {
+		return cmdutils.CancelError()
+	}
+
+	err = tokenutils.RevokeToken(apiClient, target, token.ID)
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to revoke access token %q", token.Name))
+	}
+
+	c := opts.IO.Color()
+	fmt.Fprintf(opts.IO.StdOut, "%s Revoked access token %q (ID %d) on %s\n", c.RedCheck(), token.Name, token.ID, target)
+	return nil
+}
@@ -0,0 +1,134 @@
+package rotate
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/commands/token/tokenutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/profclems/glab/pkg/prompt"
+	"github.com/spf13/cobra"
+	"github.com/xanzy/go-gitlab"
+)
+
+type RotateOpts struct {
+	HTTPClient func() (*gitlab.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+
+	Token       string
+	Project     bool
+	Group       string
+	ExpiresAt   string
+	ForceRotate bool
+
+	expiresAt *gitlab.ISOTime
+}
+
+func NewCmdRotate(f *cmdutils.Factory, runE func(*RotateOpts) error) *cobra.Command {
+	opts := &RotateOpts{
+		IO: f.IO,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "rotate <id|name> [flags]",
+		Short: `Rotate an access token`,
+		Long: heredoc.Doc(`
+			Revoke an access token and create a new one with the same name, scopes and access level.
+			Anything still using the old token stops working at once.
+
+			The new token is printed to stdout and cannot be retrieved again later.
+			Without --expires-at, GitLab sets the expiry of the new token to one week from now.
+			Rotation requires GitLab 16.0 or later.
+		`),
+		Example: heredoc.Doc(`
+			$ glab token rotate 1234 --expires-at 90d
+			$ glab token rotate ci-deploy --project --expires-at 2022-12-31
+			$ glab token rotate release-bot -g mygroup -e 30d --yes | glab variable update RELEASE_TOKEN -g mygroup
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var err error
+
+			opts.HTTPClient = f.HttpClient
+			opts.BaseRepo = f.BaseRepo
+			opts.Token = args[0]
+
+			if opts.Project && opts.Group != "" {
+				return &cmdutils.FlagError{Err: errors.New("--project and --group are mutually exclusive")}
+			}
+			opts.expiresAt, err = tokenutils.ParseExpiresAt(opts.ExpiresAt, time.Now())
+			if err != nil {
+				return &cmdutils.FlagError{Err: err}
+			}
+			if !opts.ForceRotate && !opts.IO.PromptEnabled() {
+				return &cmdutils.FlagError{Err: errors.New("--yes or -y flag is required when not running interactively")}
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return rotateRun(opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.Project, "project", "p", false, "Rotate a project access token instead of a personal access token")
+	cmd.Flags().StringVarP(&opts.Group, "group", "g", "", "Rotate an access token of a group instead of a personal access token")
+	cmd.Flags().StringVarP(&opts.ExpiresAt, "expires-at", "e", "", "Expiry date of the new token in the YYYY-MM-DD format or a period from now such as 90d")
+	cmd.Flags().BoolVarP(&opts.ForceRotate, "yes", "y", false, "Skip the confirmation prompt")
+
+	return cmd
+}
+
+func rotateRun(opts *RotateOpts) error {
+	apiClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	target, err := tokenutils.ResolveTarget(opts.Project, opts.Group, opts.BaseRepo)
+	if err != nil {
+		return err
+	}
+
+	token, err := tokenutils.FindToken(apiClient, target, opts.Token)
+	if err != nil {
+		return err
+	}
+
+	if !opts.ForceRotate && opts.IO.PromptEnabled() {
+		err = prompt.Confirm(&opts.ForceRotate, fmt.Sprintf("Rotate access token %q (ID %d) on %s? The current token is revoked", token.Name, token.ID, target), false)
+		if err != nil {
+			return cmdutils.WrapError(err, "could not prompt")
+		}
+	}
+
+	if !opts.ForceRotate {
+		return cmdutils.CancelError()
+	}
+
+	rotated, err := tokenutils.RotateToken(apiClient, target, token.ID, opts.expiresAt)
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to rotate access token %q", token.Name))
+	}
+
+	expiry := "never"
+	if rotated.ExpiresAt != nil {
+		expiry = rotated.ExpiresAt.String()
+	}
+
+	c := opts.IO.Color()
+	opts.IO.Logf("%s Rotated access token %q on %s. The new token has ID %d and expires %s\n",
+		c.GreenCheck(), token.Name, target, rotated.ID, expiry)
+	if opts.IO.IsErrTTY {
+		opts.IO.Logf("%s Make sure to save the token now. You will not be able to access it again.\n", c.WarnIcon())
+	}
+	fmt.Fprintln(opts.IO.StdOut, rotated.Value)
+
+	return nil
+}
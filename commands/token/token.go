@@ -0,0 +1,38 @@
+package token
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/profclems/glab/commands/cmdutils"
+	tokenCreateCmd "github.com/profclems/glab/commands/token/create"
+	tokenExpiringCmd "github.com/profclems/glab/commands/token/expiring"
+	tokenListCmd "github.com/profclems/glab/commands/token/list"
+	tokenRevokeCmd "github.com/profclems/glab/commands/token/revoke"
+	tokenRotateCmd "github.com/profclems/glab/commands/token/rotate"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdToken(f *cmdutils.Factory) *cobra.Command {
+	var tokenCmd = &cobra.Command{
+		Use:   "token <command> [flags]",
+		Short: `Manage personal, project and group access tokens`,
+		Long: heredoc.Doc(`
+			Manage access tokens.
+			Commands act on your personal access tokens unless --project or --group is given.
+		`),
+		Example: heredoc.Doc(`
+			$ glab token list
+			$ glab token create ci-deploy --project --scope read_repository --expires-at 90d
+			$ glab token rotate release-bot -g mygroup --expires-at 30d
+			$ glab token expiring --within 2w
+		`),
+	}
+
+	cmdutils.EnableRepoOverride(tokenCmd, f)
+
+	tokenCmd.AddCommand(tokenListCmd.NewCmdList(f, nil))
+	tokenCmd.AddCommand(tokenCreateCmd.NewCmdCreate(f, nil))
+	tokenCmd.AddCommand(tokenRevokeCmd.NewCmdRevoke(f, nil))
+	tokenCmd.AddCommand(tokenRotateCmd.NewCmdRotate(f, nil))
+	tokenCmd.AddCommand(tokenExpiringCmd.NewCmdExpiring(f, nil))
+	return tokenCmd
+}
@@ -0,0 +1,341 @@
+package tokenutils
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/commands/cmdutils"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/iostreams"
+	"github.com/profclems/glab/pkg/tableprinter"
+	"github.com/profclems/glab/pkg/utils"
+	"github.com/xanzy/go-gitlab"
+)
+
+// Token is a personal, project or group access token
+type Token struct {
+	ID          int
+	Name        string
+	Scopes      []string
+	AccessLevel gitlab.AccessLevelValue
+	Active      bool
+	Revoked     bool
+	CreatedAt   *time.Time
+	ExpiresAt   *gitlab.ISOTime
+
+	// Value is only set when the token has just been created or rotated
+	Value string
+}
+
+// Target is the owner of the access tokens: the current user, a project or a group
+type Target struct {
+	Repo  glrepo.Interface
+	Group string
+}
+
+// IsPersonal reports whether the target is the personal access tokens of the current user
+func (t Target) IsPersonal() bool {
+	return t.Repo == nil && t.Group == ""
+}
+
+func (t Target) String() string {
+	switch {
+	case t.Group != "":
+		return "group " + t.Group
+	case t.Repo != nil:
+		return "project " + t.Repo.FullName()
+	default:
+		return "your account"
+	}
+}
+
+// ResolveTarget returns the group when one is given, the base repository when project is set
+// and the current user otherwise
+func ResolveTarget(project bool, group string, baseRepo func() (glrepo.Interface, error)) (Target, error) {
+	if group != "" {
+		return Target{Group: group}, nil
+	}
+	if project {
+		repo, err := baseRepo()
+		if err != nil {
+			return Target{}, err
+		}
+		return Target{Repo: repo}, nil
+	}
+	return Target{}, nil
+}
+
+// ListTokens returns all tokens of the target. Revoked and expired tokens are only included when all is set.
+func ListTokens(client *gitlab.Client, target Target, all bool) ([]*Token, error) {
+	var tokens []*Token
+
+	switch {
+	case target.Group != "":
+		opts := &gitlab.ListOptions{PerPage: 100}
+		for opts.Page = 1; ; opts.Page++ {
+			groupTokens, err := api.ListGroupAccessTokens(client, target.Group, opts)
+			if err != nil {
+				return nil, err
+			}
+			for _, t := range groupTokens {
+				tokens = append(tokens, &Token{
+					ID:          t.ID,
+					Name:        t.Name,
+					Scopes:      t.Scopes,
+					AccessLevel: t.AccessLevel,
+					Active:      t.Active,
+					Revoked:     t.Revoked,
+					CreatedAt:   t.CreatedAt,
+					ExpiresAt:   t.ExpiresAt,
+				})
+			}
+			if len(groupTokens) < opts.PerPage {
+				break
+			}
+		}
+	case target.Repo != nil:
+		opts := &gitlab.ListProjectAccessTokensOptions{PerPage: 100}
+		for opts.Page = 1; ; opts.Page++ {
+			projectTokens, err := api.ListProjectAccessTokens(client, target.Repo.FullName(), opts)
+			if err != nil {
+				return nil, err
+			}
+			for _, t := range projectTokens {
+				tokens = append(tokens, &Token{
+					ID:          t.ID,
+					Name:        t.Name,
+					Scopes:      t.Scopes,
+					AccessLevel: t.AccessLevel,
+					Active:      t.Active,
+					Revoked:     t.Revoked,
+					CreatedAt:   t.CreatedAt,
+					ExpiresAt:   t.ExpiresAt,
+				})
+			}
+			if len(projectTokens) < opts.PerPage {
+				break
+			}
+		}
+	default:
+		user, err := api.CurrentUser(client)
+		if err != nil {
+			return nil, err
+		}
+		// administrators see the tokens of every user unless filtered
+		opts := &api.ListPersonalAccessTokensOptions{UserID: gitlab.Int(user.ID)}
+		opts.PerPage = 100
+		if !all {
+			opts.State = gitlab.String("active")
+		}
+		for opts.Page = 1; ; opts.Page++ {
+			personalTokens, err := api.ListPersonalAccessTokens(client, opts)
+			if err != nil {
+				return nil, err
+			}
+			for _, t := range personalTokens {
+				tokens = append(tokens, personalToken(t))
+			}
+			if len(personalTokens) < opts.PerPage {
+				break
+			}
+		}
+	}
+
+	if all {
+		return tokens, nil
+	}
+
+	active := tokens[:0]
+	for _, t := range tokens {
+		if t.Active && !t.Revoked {
+			active = append(active, t)
+		}
+	}
+	return active, nil
+}
+
+func personalToken(t *gitlab.PersonalAccessToken) *Token {
+	return &Token{
+		ID:        t.ID,
+		Name:      t.Name,
+		Scopes:    t.Scopes,
+		Active:    t.Active,
+		Revoked:   t.Revoked,
+		CreatedAt: t.CreatedAt,
+		ExpiresAt: t.ExpiresAt,
+		Value:     t.Token,
+	}
+}
+
+// FindToken returns the active token of the target with the given ID or name
+func FindToken(client *gitlab.Client, target Target, idOrName string) (*Token, error) {
+	tokens, err := ListTokens(client, target, false)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int, len(tokens))
+	names := make([]string, len(tokens))
+	for i, t := range tokens {
+		ids[i], names[i] = t.ID, t.Name
+	}
+	i, err := cmdutils.FindByIDOrName("active token", target, idOrName, ids, names)
+	if err != nil {
+		return nil, err
+	}
+	return tokens[i], nil
+}
+
+// CreateOptions are the attributes of a new token
+type CreateOptions struct {
+	Name        string
+	Scopes      []string
+	AccessLevel gitlab.AccessLevelValue
+	ExpiresAt   *gitlab.ISOTime
+}
+
+// CreateToken creates a token on the target
+func CreateToken(client *gitlab.Client, target Target, opts CreateOptions) (*Token, error) {
+	switch {
+	case target.Group != "":
+		t, err := api.CreateGroupAccessToken(client, target.Group, &api.CreateGroupAccessTokenOptions{
+			Name:        gitlab.String(opts.Name),
+			Scopes:      opts.Scopes,
+			AccessLevel: gitlab.AccessLevel(opts.AccessLevel),
+			ExpiresAt:   opts.ExpiresAt,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &Token{ID: t.ID, Name: t.Name, Scopes: t.Scopes, AccessLevel: t.AccessLevel, Active: t.Active,
+			CreatedAt: t.CreatedAt, ExpiresAt: t.ExpiresAt, Value: t.Token}, nil
+	case target.Repo != nil:
+		t, err := api.CreateProjectAccessToken(client, target.Repo.FullName(), &gitlab.CreateProjectAccessTokenOptions{
+			Name:        gitlab.String(opts.Name),
+			Scopes:      opts.Scopes,
+			AccessLevel: gitlab.AccessLevel(opts.AccessLevel),
+			ExpiresAt:   opts.ExpiresAt,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &Token{ID: t.ID, Name: t.Name, Scopes: t.Scopes, AccessLevel: t.AccessLevel, Active: t.Active,
+			CreatedAt: t.CreatedAt, ExpiresAt: t.ExpiresAt, Value: t.Token}, nil
+	default:
+		user, err := api.CurrentUser(client)
+		if err != nil {
+			return nil, err
+		}
+		t, err := api.CreatePersonalAccessToken(client, user.ID, &gitlab.CreatePersonalAccessTokenOptions{
+			Name:      gitlab.String(opts.Name),
+			Scopes:    opts.Scopes,
+			ExpiresAt: opts.ExpiresAt,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return personalToken(t), nil
+	}
+}
+
+// RevokeToken revokes a token of the target
+func RevokeToken(client *gitlab.Client, target Target, tokenID int) error {
+	switch {
+	case target.Group != "":
+		return api.RevokeGroupAccessToken(client, target.Group, tokenID)
+	case target.Repo != nil:
+		return api.RevokeProjectAccessToken(client, target.Repo.FullName(), tokenID)
+	default:
+		return api.RevokePersonalAccessToken(client, tokenID)
+	}
+}
+
+// RotateToken revokes a token of the target and creates a new one with the same attributes
+func RotateToken(client *gitlab.Client, target Target, tokenID int, expiresAt *gitlab.ISOTime) (*Token, error) {
+	opts := &api.RotateAccessTokenOptions{ExpiresAt: expiresAt}
+
+	switch {
+	case target.Group != "":
+		t, err := api.RotateGroupAccessToken(client, target.Group, tokenID, opts)
+		if err != nil {
+			return nil, err
+		}
+		return &Token{ID: t.ID, Name: t.Name, Scopes: t.Scopes, AccessLevel: t.AccessLevel, Active: t.Active,
+			CreatedAt: t.CreatedAt, ExpiresAt: t.ExpiresAt, Value: t.Token}, nil
+	case target.Repo != nil:
+		t, err := api.RotateProjectAccessToken(client, target.Repo.FullName(), tokenID, opts)
+		if err != nil {
+			return nil, err
+		}
+		return &Token{ID: t.ID, Name: t.Name, Scopes: t.Scopes, AccessLevel: t.AccessLevel, Active: t.Active,
+			CreatedAt: t.CreatedAt, ExpiresAt: t.ExpiresAt, Value: t.Token}, nil
+	default:
+		t, err := api.RotatePersonalAccessToken(client, tokenID, opts)
+		if err != nil {
+			return nil, err
+		}
+		return personalToken(t), nil
+	}
+}
+
+// ParseExpiresAt parses an expiry date in the YYYY-MM-DD format or a period relative to now, e.g. 90d
+func ParseExpiresAt(value string, now time.Time) (*gitlab.ISOTime, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := cmdutils.ParseExpiresAt(value)
+	if err != nil {
+		d, durationErr := utils.ParseDuration(value)
+		if durationErr != nil || d <= 0 {
+			return nil, fmt.Errorf("invalid expiry %q: must be a date in the YYYY-MM-DD format or a period such as 30d", value)
+		}
+		t = now.Add(d).Truncate(24 * time.Hour)
+	}
+	isoTime := gitlab.ISOTime(t)
+	return &isoTime, nil
+}
+
+// DisplayTokens renders a table of tokens with their scopes and expiry relative to now
+func DisplayTokens(streams *iostreams.IOStreams, tokens []*Token, now time.Time) string {
+	c := streams.Color()
+	table := tableprinter.NewTablePrinter()
+	table.AddRow("ID", "NAME", "SCOPES", "ACCESS LEVEL", "EXPIRES", "")
+
+	for _, t := range tokens {
+		accessLevel := ""
+		if t.AccessLevel != 0 {
+			accessLevel = cmdutils.AccessLevelName(t.AccessLevel)
+		}
+
+		expires := "never"
+		var state string
+		if t.ExpiresAt != nil {
+			expires = t.ExpiresAt.String()
+			state = ExpiryState(c, time.Time(*t.ExpiresAt), now)
+		}
+		if t.Revoked {
+			state = c.Gray("revoked")
+		}
+
+		table.AddRow(t.ID, t.Name, strings.Join(t.Scopes, ","), accessLevel, expires, state)
+	}
+
+	return table.Render()
+}
+
+// ExpiryState describes when a token or a membership expires relative to now
+func ExpiryState(c *iostreams.ColorPalette, expiresAt, now time.Time) string {
+	days := int(expiresAt.Sub(now).Hours() / 24)
+	switch {
+	case expiresAt.Before(now):
+		return c.Red("expired")
+	case days == 0:
+		return c.Red("expires today")
+	case days <= 30:
+		return c.Yellow("in " + utils.Pluralize(days, "day"))
+	default:
+		return c.Gray("in " + utils.Pluralize(days, "day"))
+	}
+}
@@ -0,0 +1,85 @@
+package tokenutils
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/profclems/glab/api"
+	"github.com/profclems/glab/internal/glrepo"
+	"github.com/profclems/glab/pkg/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseExpiresAt(t *testing.T) {
+	now := time.Date(2021, 11, 1, 15, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{input: "2022-01-31", want: "2022-01-31"},
+		{input: "30d", want: "2021-12-01"},
+		{input: "2w", want: "2021-11-15"},
+		{input: "-3d", wantErr: true},
+		{input: "31/01/2022", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseExpiresAt(tt.input, now)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got.String())
+		})
+	}
+
+	got, err := ParseExpiresAt("", now)
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+}
+
+func Test_FindToken(t *testing.T) {
+	reg := &httpmock.Mocker{}
+	defer reg.Verify(t)
+
+	tokensResponse := `[
+		{"id": 1, "name": "ci", "scopes": ["api"], "active": false, "revoked": true},
+		{"id": 2, "name": "ci", "scopes": ["api"], "active": true, "revoked": false},
+		{"id": 3, "name": "deploy", "scopes": ["read_repository"], "active": true, "revoked": false},
+		{"id": 4, "name": "deploy", "scopes": ["read_registry"], "active": true, "revoked": false}
+	]`
+	stubTokens := func() {
+		reg.RegisterResponder("GET", "/api/v4/projects/owner/repo/access_tokens",
+			httpmock.NewStringResponse(200, tokensResponse))
+	}
+
+	// the first client is discarded as it does not pick up the mocked transport
+	_, _ = api.TestClient(&http.Client{Transport: reg}, "", "gitlab.com", false)
+	a, _ := api.TestClient(&http.Client{Transport: reg}, "", "gitlab.com", false)
+	repo, _ := glrepo.FromFullName("owner/repo")
+	target := Target{Repo: repo}
+
+	stubTokens()
+	token, err := FindToken(a.Lab(), target, "ci")
+	require.NoError(t, err)
+	assert.Equal(t, 2, token.ID)
+
+	stubTokens()
+	token, err = FindToken(a.Lab(), target, "4")
+	require.NoError(t, err)
+	assert.Equal(t, "deploy", token.Name)
+
+	stubTokens()
+	_, err = FindToken(a.Lab(), target, "deploy")
+	assert.EqualError(t, err, `2 active tokens are named "deploy" on project owner/repo. Specify one by ID instead: 3, 4`)
+
+	stubTokens()
+	_, err = FindToken(a.Lab(), target, "1")
+	assert.EqualError(t, err, `no active token "1" found on project owner/repo`)
+}
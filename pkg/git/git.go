@@ -494,3 +494,281 @@ func RunCmd(args []string) (err error) {
 	err = run.PrepareCmd(gitCmd).Run()
 	return
 }
+
+// BranchConfigValues returns the value of the `branch.BRANCH.KEY` config of every branch
+// which has it set, keyed by branch name
+func BranchConfigValues(key string) (map[string]string, error) {
+	configCmd := GitCommand("config", "--get-regexp", fmt.Sprintf(`^branch\..*\.%s$`, regexp.QuoteMeta(key)))
+	output, err := run.PrepareCmd(configCmd).Output()
+	values := map[string]string{}
+	if err != nil {
+		var cmdErr *run.CmdError
+		if errors.As(err, &cmdErr) && cmdErr.Stderr.Len() == 0 {
+			// no branch has the key set
+			return values, nil
+		}
+		return nil, err
+	}
+	for _, line := range outputLines(output) {
+		parts := strings.SplitN(line, " ", 2)
+		if len(parts) < 2 {
+			continue
+		}
+		branch := strings.TrimSuffix(strings.TrimPrefix(parts[0], "branch."), "."+key)
+		values[branch] = parts[1]
+	}
+	return values, nil
+}
+
+// SetBranchConfig sets the `branch.BRANCH.KEY` config
+func SetBranchConfig(branch, key, value string) error {
+	configCmd := GitCommand("config", fmt.Sprintf("branch.%s.%s", branch, key), value)
+	return run.PrepareCmd(configCmd).Run()
+}
+
+// UnsetBranchConfig removes the `branch.BRANCH.KEY` config
+func UnsetBranchConfig(branch, key string) error {
+	configCmd := GitCommand("config", "--unset", fmt.Sprintf("branch.%s.%s", branch, key))
+	return run.PrepareCmd(configCmd).Run()
+}
+
+// Fetch updates the remote tracking branches of the given refs
+func Fetch(remote string, refs []string, cmdOut, cmdErr io.Writer) error {
+	fetchCmd := GitCommand(append([]string{"fetch", remote}, refs...)...)
+	fetchCmd.Stdout = cmdOut
+	fetchCmd.Stderr = cmdErr
+	return run.PrepareCmd(fetchCmd).Run()
+}
+
+// Rebase rebases the branch onto upstream. Commits which the branch got from an earlier
+// version of upstream are found with the upstream reflog and left out.
+func Rebase(upstream, branch string, cmdOut, cmdErr io.Writer) error {
+	rebaseCmd := GitCommand("rebase", "--fork-point", upstream, branch)
+	rebaseCmd.Stdout = cmdOut
+	rebaseCmd.Stderr = cmdErr
+	return run.PrepareCmd(rebaseCmd).Run()
+}
+
+// RebaseOnto moves the commits of the branch which are not in upstream onto newBase
+func RebaseOnto(newBase, upstream, branch string, cmdOut, cmdErr io.Writer) error {
+	rebaseCmd := GitCommand("rebase", "--onto", newBase, upstream, branch)
+	rebaseCmd.Stdout = cmdOut
+	rebaseCmd.Stderr = cmdErr
+	return run.PrepareCmd(rebaseCmd).Run()
+}
+
+// ForcePush publishes a branch to a remote, overwriting the remote branch if it was not
+// changed since it was last fetched, and sets it as the upstream of the branch
+func ForcePush(remote string, branch string, cmdOut, cmdErr io.Writer) error {
+	pushCmd := GitCommand("push", "--force-with-lease", "--set-upstream", remote, branch)
+	pushCmd.Stdout = cmdOut
+	pushCmd.Stderr = cmdErr
+	return run.PrepareCmd(pushCmd).Run()
+}
+
+// PushWithLease publishes the local branch to remoteBranch, overwriting it only if it still
+// points to the commit expect
+func PushWithLease(remote, branch, remoteBranch, expect string, cmdOut, cmdErr io.Writer) error {
+	ref := "refs/heads/" + remoteBranch
+	pushCmd := GitCommand("push", fmt.Sprintf("--force-with-lease=%s:%s", ref, expect), remote, fmt.Sprintf("%s:%s", branch, ref))
+	pushCmd.Stdout = cmdOut
+	pushCmd.Stderr = cmdErr
+	return run.PrepareCmd(pushCmd).Run()
+}
+
+// Merge merges the commit into the current branch with the default commit message
+func Merge(commit string, cmdOut, cmdErr io.Writer) error {
+	mergeCmd := GitCommand("merge", "--no-edit", commit)
+	mergeCmd.Stdout = cmdOut
+	mergeCmd.Stderr = cmdErr
+	return run.PrepareCmd(mergeCmd).Run()
+}
+
+// ContinueRebase resumes a rebase stopped by conflicts, keeping the commit messages
+func ContinueRebase(cmdOut, cmdErr io.Writer) error {
+	rebaseCmd := GitCommand("-c", "core.editor=true", "rebase", "--continue")
+	rebaseCmd.Stdout = cmdOut
+	rebaseCmd.Stderr = cmdErr
+	return run.PrepareCmd(rebaseCmd).Run()
+}
+
+// ContinueMerge commits a merge stopped by conflicts with the default commit message
+func ContinueMerge(cmdOut, cmdErr io.Writer) error {
+	commitCmd := GitCommand("commit", "--no-edit")
+	commitCmd.Stdout = cmdOut
+	commitCmd.Stderr = cmdErr
+	return run.PrepareCmd(commitCmd).Run()
+}
+
+func AbortRebase() error {
+	abortCmd := GitCommand("rebase", "--abort")
+	return run.PrepareCmd(abortCmd).Run()
+}
+
+func AbortMerge() error {
+	abortCmd := GitCommand("merge", "--abort")
+	return run.PrepareCmd(abortCmd).Run()
+}
+
+// ConflictedFiles returns the paths of the files which have unmerged changes
+func ConflictedFiles() ([]string, error) {
+	diffCmd := GitCommand("diff", "--name-only", "--diff-filter=U")
+	output, err := run.PrepareCmd(diffCmd).Output()
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, file := range outputLines(output) {
+		if file != "" {
+			files = append(files, file)
+		}
+	}
+	return files, nil
+}
+
+// MergeTool runs `git mergetool` on the conflicted files. The merge tool configured in
+// git is used when tool is empty.
+func MergeTool(tool string, files []string, cmdIn io.Reader, cmdOut, cmdErr io.Writer) error {
+	args := []string{"mergetool", "--no-prompt"}
+	if tool != "" {
+		args = append(args, "--tool="+tool)
+	}
+	mergetoolCmd := GitCommand(append(append(args, "--"), files...)...)
+	mergetoolCmd.Stdin = cmdIn
+	mergetoolCmd.Stdout = cmdOut
+	mergetoolCmd.Stderr = cmdErr
+	return run.PrepareCmd(mergetoolCmd).Run()
+}
+
+// Add stages the files
+func Add(files ...string) error {
+	addCmd := GitCommand(append([]string{"add", "--"}, files...)...)
+	return run.PrepareCmd(addCmd).Run()
+}
+
+type Worktree struct {
+	Path   string
+	Head   string
+	Branch string
+	// Prunable is set when the directory of the worktree no longer exists
+	Prunable bool
+}
+
+// ListWorktrees returns the worktrees of the repository, starting with the main worktree
+func ListWorktrees() ([]*Worktree, error) {
+	listCmd := GitCommand("worktree", "list", "--porcelain")
+	output, err := run.PrepareCmd(listCmd).Output()
+	if err != nil {
+		return nil, err
+	}
+	return parseWorktrees(outputLines(output)), nil
+}
+
+func parseWorktrees(lines []string) []*Worktree {
+	var worktrees []*Worktree
+	var current *Worktree
+	for _, line := range lines {
+		parts := strings.SplitN(line, " ", 2)
+		value := ""
+		if len(parts) > 1 {
+			value = parts[1]
+		}
+		switch parts[0] {
+		case "worktree":
+			current = &Worktree{Path: value}
+			worktrees = append(worktrees, current)
+		case "HEAD":
+			if current != nil {
+				current.Head = value
+			}
+		case "branch":
+			if current != nil {
+				current.Branch = strings.TrimPrefix(value, "refs/heads/")
+			}
+		case "prunable":
+			if current != nil {
+				current.Prunable = true
+			}
+		}
+	}
+	return worktrees
+}
+
+// AddWorktree creates a worktree at path with branch checked out. When startPoint is
+// set, the branch is created at it.
+func AddWorktree(path, branch, startPoint string, cmdOut, cmdErr io.Writer) error {
+	args := []string{"worktree", "add"}
+	if startPoint != "" {
+		args = append(args, "-b", branch, path, startPoint)
+	} else {
+		args = append(args, path, branch)
+	}
+	addCmd := GitCommand(args...)
+	addCmd.Stdout = cmdOut
+	addCmd.Stderr = cmdErr
+	return run.PrepareCmd(addCmd).Run()
+}
+
+// RemoveWorktree deletes a worktree. Worktrees with uncommitted changes are only removed with force.
+func RemoveWorktree(path string, force bool) error {
+	args := []string{"worktree", "remove"}
+	if force {
+		args = append(args, "--force")
+	}
+	removeCmd := GitCommand(append(args, path)...)
+	return run.PrepareCmd(removeCmd).Run()
+}
+
+// PruneWorktrees cleans up the administrative files of worktrees whose directories were deleted
+func PruneWorktrees() error {
+	pruneCmd := GitCommand("worktree", "prune")
+	return run.PrepareCmd(pruneCmd).Run()
+}
+
+// BranchHead returns the commit hash a local branch points to
+func BranchHead(branch string) (string, error) {
+	revCmd := GitCommand("rev-parse", "--verify", "refs/heads/"+branch)
+	output, err := run.PrepareCmd(revCmd).Output()
+	if err != nil {
+		return "", err
+	}
+	return firstLine(output), nil
+}
+
+// HasCommit reports whether the revision names a commit of the repository
+func HasCommit(rev string) bool {
+	revCmd := GitCommand("rev-parse", "--verify", "--quiet", rev+"^{commit}")
+	_, err := run.PrepareCmd(revCmd).Output()
+	return err == nil
+}
+
+// MergeBase returns the best common ancestor of the two commits
+func MergeBase(a, b string) (string, error) {
+	mergeBaseCmd := GitCommand("merge-base", a, b)
+	output, err := run.PrepareCmd(mergeBaseCmd).Output()
+	if err != nil {
+		return "", err
+	}
+	return firstLine(output), nil
+}
+
+// IsAncestor reports whether the commit ancestor is reachable from the commit descendant
+func IsAncestor(ancestor, descendant string) (bool, error) {
+	mergeBaseCmd := GitCommand("merge-base", "--is-ancestor", ancestor, descendant)
+	err := run.PrepareCmd(mergeBaseCmd).Run()
+	if err == nil {
+		return true, nil
+	}
+	var cmdErr *run.CmdError
+	if errors.As(err, &cmdErr) && cmdErr.Stderr.Len() == 0 {
+		// exits with 1 and no output when it is not an ancestor
+		return false, nil
+	}
+	return false, err
+}
+
+// SetBranchHead moves a local branch which is not checked out to the commit
+func SetBranchHead(branch, commit string) error {
+	branchCmd := GitCommand("branch", "--force", branch, commit)
+	return run.PrepareCmd(branchCmd).Run()
+}
@@ -305,3 +305,26 @@ func TestGetRemoteURL(t *testing.T) {
 		})
 	}
 }
+
+func Test_parseWorktrees(t *testing.T) {
+	lines := []string{
+		"worktree /src/repo",
+		"HEAD 1111111111111111111111111111111111111111",
+		"branch refs/heads/main",
+		"",
+		"worktree /src/repo-mr-12",
+		"HEAD 2222222222222222222222222222222222222222",
+		"detached",
+		"",
+		"worktree /src/repo-mr-13",
+		"HEAD 3333333333333333333333333333333333333333",
+		"branch refs/heads/feature/lexer",
+		"prunable gitdir file points to non-existent location",
+	}
+
+	assert.Equal(t, []*Worktree{
+		{Path: "/src/repo", Head: "1111111111111111111111111111111111111111", Branch: "main"},
+		{Path: "/src/repo-mr-12", Head: "2222222222222222222222222222222222222222"},
+		{Path: "/src/repo-mr-13", Head: "3333333333333333333333333333333333333333", Branch: "feature/lexer", Prunable: true},
+	}, parseWorktrees(lines))
+}
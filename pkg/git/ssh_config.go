@@ -2,9 +2,11 @@ package git
 
 import (
 	"bufio"
+	"fmt"
 	"io"
 	"net/url"
 	"os"
+	"path"
 	"path/filepath"
 	"regexp"
 	"strings"
@@ -44,6 +46,8 @@ type sshParser struct {
 
 	aliasMap SSHAliasMap
 	hosts    []string
+	// patterns lists every Host pattern declared in the parsed files
+	patterns map[string]bool
 
 	open func(string) (io.Reader, error)
 	glob func(string) ([]string, error)
@@ -81,6 +85,12 @@ func (p *sshParser) read(fileName string) error {
 		switch keyword {
 		case "host":
 			p.hosts = strings.Fields(arguments)
+			for _, host := range p.hosts {
+				if p.patterns == nil {
+					p.patterns = make(map[string]bool)
+				}
+				p.patterns[host] = true
+			}
 		case "hostname":
 			for _, host := range p.hosts {
 				for _, name := range strings.Fields(arguments) {
@@ -136,15 +146,26 @@ func (p *sshParser) absolutePath(parentFile, path string) string {
 	return filepath.Join(p.homeDir, ".ssh", path)
 }
 
-// ParseSSHConfig constructs a map of SSH hostname aliases based on user and
-// system configuration files
-func ParseSSHConfig() SSHAliasMap {
+// hasHost reports whether a Host pattern other than the catch-all "*" matches the host
+func (p *sshParser) hasHost(host string) bool {
+	for pattern := range p.patterns {
+		if pattern == "*" || strings.HasPrefix(pattern, "!") {
+			continue
+		}
+		if ok, _ := path.Match(pattern, host); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func parseSSHConfigFiles() *sshParser {
 	configFiles := []string{
 		"/etc/ssh_config",
 		"/etc/ssh/ssh_config",
 	}
 
-	p := sshParser{}
+	p := &sshParser{}
 
 	if homeDir, err := homedir.Dir(); err == nil {
 		userConfig := filepath.Join(homeDir, ".ssh", "config")
@@ -155,7 +176,66 @@ func ParseSSHConfig() SSHAliasMap {
 	for _, file := range configFiles {
 		_ = p.read(file)
 	}
-	return p.aliasMap
+	return p
+}
+
+// ParseSSHConfig constructs a map of SSH hostname aliases based on user and
+// system configuration files
+func ParseSSHConfig() SSHAliasMap {
+	return parseSSHConfigFiles().aliasMap
+}
+
+// SSHConfigHasHost reports whether the user or system SSH configuration
+// already declares a Host entry matching the host
+func SSHConfigHasHost(host string) bool {
+	return parseSSHConfigFiles().hasHost(host)
+}
+
+// SSHConfigHostStanza returns a Host entry which makes SSH use the identity file for the host
+func SSHConfigHostStanza(host, identityFile string) string {
+	return fmt.Sprintf("Host %s\n  HostName %s\n  User git\n  IdentityFile %s\n  IdentitiesOnly yes\n", host, host, identityFile)
+}
+
+// AddSSHConfigHost appends a Host entry for the host to the user SSH configuration file,
+// creating the file when it does not exist
+func AddSSHConfigHost(host, identityFile string) (string, error) {
+	homeDir, err := homedir.Dir()
+	if err != nil {
+		return "", err
+	}
+
+	sshDir := filepath.Join(homeDir, ".ssh")
+	if err := os.MkdirAll(sshDir, 0700); err != nil {
+		return "", err
+	}
+
+	configFile := filepath.Join(sshDir, "config")
+	return configFile, appendSSHConfigHost(configFile, host, identityFile)
+}
+
+func appendSSHConfigHost(configFile, host, identityFile string) error {
+	f, err := os.OpenFile(configFile, os.O_APPEND|os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	// separate the new entry from existing content with an empty line
+	stat, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	prefix := ""
+	if stat.Size() > 0 {
+		prefix = "\n"
+		last := make([]byte, 1)
+		if _, err := f.ReadAt(last, stat.Size()-1); err == nil && last[0] != '\n' {
+			prefix = "\n\n"
+		}
+	}
+
+	_, err = f.WriteString(prefix + SSHConfigHostStanza(host, identityFile))
+	return err
 }
 
 func sshExpandTokens(text, host string) string {
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"net/url"
 	"path/filepath"
 	"reflect"
@@ -82,6 +83,10 @@ func Test_sshParser_read(t *testing.T) {
 	if got := p.aliasMap["s1"]; got != "site1.net" {
 		t.Errorf("expected alias %q to expand to %q, got %q", "s1", "site1.net", got)
 	}
+
+	eq(t, p.hasHost("gitlabopen"), true)
+	eq(t, p.hasHost("s2"), true)
+	eq(t, p.hasHost("gitlab.com"), false)
 }
 
 func Test_sshParser_absolutePath(t *testing.T) {
@@ -146,6 +151,32 @@ func Test_Translator(t *testing.T) {
 	}
 }
 
+func Test_appendSSHConfigHost(t *testing.T) {
+	configFile := filepath.Join(t.TempDir(), "config")
+
+	err := appendSSHConfigHost(configFile, "gitlab.com", "~/.ssh/id_ed25519_gitlab.com")
+	eq(t, err, nil)
+
+	err = appendSSHConfigHost(configFile, "gitlab.example.com", "~/.ssh/id_ed25519_gitlab.example.com")
+	eq(t, err, nil)
+
+	b, err := ioutil.ReadFile(configFile)
+	eq(t, err, nil)
+	eq(t, string(b), heredoc.Doc(`
+		Host gitlab.com
+		  HostName gitlab.com
+		  User git
+		  IdentityFile ~/.ssh/id_ed25519_gitlab.com
+		  IdentitiesOnly yes
+
+		Host gitlab.example.com
+		  HostName gitlab.example.com
+		  User git
+		  IdentityFile ~/.ssh/id_ed25519_gitlab.example.com
+		  IdentitiesOnly yes
+	`))
+}
+
 func eq(t *testing.T, got interface{}, expected interface{}) {
 	t.Helper()
 	if !reflect.DeepEqual(got, expected) {
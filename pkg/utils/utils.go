@@ -3,6 +3,7 @@ package utils
 import (
 	"fmt"
 	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
@@ -77,6 +78,11 @@ func Pluralize(num int, thing string) string {
 	if num == 1 {
 		return fmt.Sprintf("%d %s", num, thing)
 	}
+	for _, suffix := range []string{"s", "x", "z", "ch", "sh"} {
+		if strings.HasSuffix(thing, suffix) {
+			return fmt.Sprintf("%d %ses", num, thing)
+		}
+	}
 	return fmt.Sprintf("%d %ss", num, thing)
 }
 
@@ -118,6 +124,24 @@ func FmtDuration(d time.Duration) string {
 	return fmt.Sprintf("%02dm %02ds", m, s)
 }
 
+// ParseDuration is like time.ParseDuration but additionally accepts days and weeks
+// as a whole number followed by "d" or "w", e.g. "30d" or "2w".
+func ParseDuration(s string) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+	if n := len(s); n > 1 && (s[n-1] == 'd' || s[n-1] == 'w') {
+		num, err := strconv.Atoi(s[:n-1])
+		if err != nil || num < 0 {
+			return 0, fmt.Errorf("invalid duration %q", s)
+		}
+		unit := 24 * time.Hour
+		if s[n-1] == 'w' {
+			unit *= 7
+		}
+		return time.Duration(num) * unit, nil
+	}
+	return time.ParseDuration(s)
+}
+
 func Humanize(s string) string {
 	// Replaces - and _ with spaces.
 	replace := "_-"